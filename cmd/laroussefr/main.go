@@ -0,0 +1,47 @@
+// Command laroussefr looks up a word on Larousse and prints the result as JSON.
+// 
+// Usage:
+// 
+//	laroussefr [-dict definition|synonymes|traduction] [-from fr|en] [-to fr|en] word
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	
+	"github.com/serope/laroussefr/lookup"
+	"github.com/serope/laroussefr/traduction"
+)
+
+func main() {
+	dict := flag.String("dict", lookup.Definition, "dictionary: definition, synonymes or traduction")
+	from := flag.String("from", "fr", "source language for traduction: fr or en")
+	to := flag.String("to", "en", "target language for traduction: fr or en")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	word := strings.Join(flag.Args(), " ")
+	
+	data, err := lookup.JSON(word, *dict, language(*from), language(*to))
+	if data != nil {
+		fmt.Println(string(data))
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// language returns the traduction.Language for "fr" or "en", or -1 otherwise,
+// which traduction.New rejects.
+func language(str string) traduction.Language {
+	switch str {
+		case "fr": return traduction.Fr
+		case "en": return traduction.En
+	}
+	return -1
+}
@@ -0,0 +1,53 @@
+package laroussefr
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// frenchCollator sorts strings in French dictionary order. A Collator isn't
+// safe for concurrent use, hence the mutex.
+var frenchCollator = struct {
+	sync.Mutex
+	c *collate.Collator
+}{c: collate.New(language.French)}
+
+// SortFrench returns the non-empty strings in words sorted in French
+// dictionary order, without duplicates. words itself isn't modified.
+// 
+// The order is that of golang.org/x/text/collate for French. Accents and case
+// are ignored at first, e.g. "écrire" sorts between "eau" and "effet", and
+// "œuvre" right after "oeuf". Words which only differ by them are then ordered
+// with the unaccented one first, e.g. "cote", "coté", "côte", "côté". Hyphens,
+// apostrophes and spaces sort before letters, e.g. "à-côté" before "abri".
+func SortFrench(words []string) []string {
+	var out []string
+	seen := map[string]bool{}
+	for _, w := range words {
+		w = strings.TrimSpace(w)
+		if w != "" && !seen[w] {
+			seen[w] = true
+			out = append(out, w)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return CompareFrench(out[i], out[j]) < 0
+	})
+	return out
+}
+
+// CompareFrench compares a and b in the order of SortFrench, returning -1 if a
+// comes first, 1 if b does and 0 if they're equal.
+func CompareFrench(a, b string) int {
+	frenchCollator.Lock()
+	c := frenchCollator.c.CompareString(a, b)
+	frenchCollator.Unlock()
+	if c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
@@ -0,0 +1,101 @@
+package definition
+
+import (
+	"context"
+	"errors"
+
+	"github.com/serope/laroussefr/scrapeutil"
+)
+
+// NewBatch looks up each word in words, as New does, running the downloads
+// according to opts. The results and errors are in the same order as words.
+//
+// If ctx is done before every word is looked up, the words that were
+// finished keep their results and the others, including those cancelled
+// mid-download, have ctx.Err() as their error. A deadline on ctx thus caps the
+// whole batch, while opts.Timeout caps each word.
+// 
+// If opts.Checkpoint is set, each result is saved to it, keyed by its word, and
+// words which already have a saved result aren't looked up again.
+// 
+// If opts.FailFast is set, the first error other than ErrWordNotFound cancels
+// the words left, whose errors wrap scrapeutil.ErrAborted. Otherwise, each
+// word's error is independent of the others.
+func NewBatch(ctx context.Context, words []string, opts scrapeutil.BatchOptions) ([]Result, []error) {
+	res := make([]Result, len(words))
+	errs := scrapeutil.Batch(ctx, len(words), opts, func(ctx context.Context, i int) error {
+		return scrapeutil.Checkpointed(opts.Checkpoint, words[i], &res[i], func() error {
+			var err error
+			res[i], err = NewContext(ctx, words[i])
+			if err != nil && res[i].NotFound() {
+				return scrapeutil.SoftError(err)
+			}
+			return err
+		})
+	})
+	return res, errs
+}
+
+// Crawl downloads the pages in seeds, then the pages in their SeeAlso
+// carousels, and so on, until limit pages have been downloaded or there are
+// no new pages left. Each page is downloaded once, going by its page ID.
+// Pages that fail to download are skipped.
+//
+// If ctx is done before the crawl is over, e.g. because its deadline was
+// reached, the downloads in flight are cancelled and Crawl returns the results
+// found so far along with ctx.Err(). opts.Timeout still limits each page.
+// 
+// If opts.FailFast is set, a page which fails to download for any reason other
+// than ErrWordNotFound ends the crawl instead of being skipped, and Crawl
+// returns the results found so far along with that page's error.
+func Crawl(ctx context.Context, seeds []string, limit int, opts scrapeutil.BatchOptions) ([]Result, error) {
+	var results []Result
+	seenURLs := map[string]bool{}
+	seenIDs := map[int]bool{}
+	queue := []string{}
+	enqueue := func(urls []string) {
+		for _, u := range urls {
+			if !seenURLs[u] {
+				seenURLs[u] = true
+				queue = append(queue, u)
+			}
+		}
+	}
+	enqueue(seeds)
+	for len(queue) > 0 && len(results) < limit {
+		n := len(queue)
+		if n > limit-len(results) {
+			n = limit - len(results)
+		}
+		level := queue[:n]
+		queue = queue[n:]
+		res := make([]Result, len(level))
+		errs := scrapeutil.Batch(ctx, len(level), opts, func(ctx context.Context, i int) error {
+			var err error
+			res[i], err = NewFromFileOrURLContext(ctx, level[i])
+			if err != nil && res[i].NotFound() {
+				return scrapeutil.SoftError(err)
+			}
+			return err
+		})
+		if opts.FailFast && ctx.Err() == nil {
+			for i, err := range errs {
+				if err != nil && !res[i].NotFound() && !errors.Is(err, scrapeutil.ErrAborted) {
+					return results, err
+				}
+			}
+		}
+		for i, r := range res {
+			if errs[i] != nil || seenIDs[r.PageID] {
+				continue
+			}
+			seenIDs[r.PageID] = true
+			results = append(results, r)
+			enqueue(r.SeeAlso)
+		}
+		if ctx.Err() != nil {
+			return results, ctx.Err()
+		}
+	}
+	return results, nil
+}
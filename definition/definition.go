@@ -14,7 +14,8 @@
 // A list of definitions.
 // A definition may have a sample phrase following it, separated by a French
 // semicolon (" : "). It may also have a context specified in red font written
-// above or before the text.
+// above or before the text. The meaning and the sample phrases are also
+// available separately, as Sens and Exemples.
 //
 // EXPRESSIONS
 // 
@@ -43,8 +44,14 @@
 package definition
 
 import (
+	"bytes"
+	"context"
+	"encoding/gob"
 	"fmt"
+	"reflect"
 	"strings"
+	"time"
+	"unicode"
 	
 	"github.com/serope/laroussefr"
 	"github.com/serope/laroussefr/scrapeutil"
@@ -52,24 +59,60 @@ import (
 	"github.com/serope/laroussefr/definition/parse"
 	
 	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
 	"github.com/yhat/scrape"
 )
 
-// ErrWordNotFound is returned by New or NewFromFileOrURL if the requested word
-// isn't found.
-var ErrWordNotFound error = laroussefr.ErrWordNotFound
+// ErrWordNotFound is laroussefr.ErrWordNotFound. The error returned by New or
+// NewFromFileOrURL if the requested word isn't found wraps it, so
+// errors.Is(err, ErrWordNotFound) detects a missing word in any package.
+var ErrWordNotFound = laroussefr.ErrWordNotFound
+
+// IncludeOrphanRelations controls whether relations with no corresponding
+// definition, such as the synonyms on the page for "aguiche", are included in
+// a Result's Relations. Their Texte is empty. It's false by default.
+var IncludeOrphanRelations = false
+
+// IncludeRawHTML controls whether each Definition's RawHTML field is set to
+// the HTML of the node it was scraped from, which is useful for reporting
+// fields that were scraped wrong. It's false by default to save memory.
+var IncludeRawHTML = false
 
 // Type Result represents a page from Larousse's French dictionary.
+// 
+// Errors holds the errors of the sections which failed to parse, from
+// DÉFINITIONS onwards. Such sections are left empty, but the rest of the Result
+// is still filled in, so one malformed citation doesn't lose a whole page.
+// Callers who want all or nothing should check that Errors is empty. A page
+// whose ID or header can't be parsed is still an error.
+// 
+// FetchedAt is when the page was downloaded, and LastModified is the time in
+// the response's Last-Modified header, if Larousse sent one. For a page read
+// from a file, both are the file's modification time.
+// 
+// Candidates holds the entries listed by a disambiguation page, which Larousse
+// shows instead of a word's page when a query matches several homographs with
+// pages of their own (see IsDisambiguation). Such a Result has no Header or
+// sections, and its PageID is 0 unless the page has one. Candidates is nil for
+// any other page.
+// 
+// RedirectTo is the entry that a redirect-only page refers to with an arrow
+// ("→"), e.g. "clé" for "clef". It's empty for other pages.
 type Result struct {
-	PageID      int
-	Header      Header
-	Definitions []Definition
-	Expressions []Expression
-	Relations   []Relation // synonymes et contraires
-	Homonymes   []Homonyme
-	Difficultes []Difficulte
-	Citations   []Citation
-	SeeAlso     []string
+	PageID       int
+	Header       Header
+	Definitions  []Definition
+	Expressions  []Expression
+	Relations    []Relation // synonymes et contraires
+	Homonymes    []Homonyme
+	Difficultes  []Difficulte
+	Citations    []Citation
+	SeeAlso      []string
+	Errors       []error `json:"-"`
+	FetchedAt    time.Time
+	LastModified time.Time
+	Candidates   []Candidate
+	RedirectTo   Redirect
 }
 
 // equals compares r and q. If they're equal, an empty string and true are
@@ -100,6 +143,69 @@ func (r Result) equals(q Result) (string, bool) {
 	return "", true
 }
 
+// Equal returns true if every field of r and q is equal. FetchedAt and
+// LastModified are compared with time.Time's Equal method, which ignores their
+// locations, and Errors are compared by their messages. Since Errors isn't
+// encoded by ToGob, a Result decoded by FromGob equals the encoded one only if
+// the latter has no Errors.
+func (r Result) Equal(q Result) bool {
+	if !r.FetchedAt.Equal(q.FetchedAt) || !r.LastModified.Equal(q.LastModified) {
+		return false
+	}
+	if len(r.Errors) != len(q.Errors) {
+		return false
+	}
+	for i := range r.Errors {
+		if r.Errors[i].Error() != q.Errors[i].Error() {
+			return false
+		}
+	}
+	r.FetchedAt, r.LastModified, r.Errors = time.Time{}, time.Time{}, nil
+	q.FetchedAt, q.LastModified, q.Errors = time.Time{}, time.Time{}, nil
+	return reflect.DeepEqual(r, q)
+}
+
+// ToGob encodes r with encoding/gob, which is more compact and faster to
+// decode than JSON, e.g. for caching Results in a binary store. It's decoded
+// by FromGob.
+// 
+// Errors isn't encoded, as described by GobEncode.
+func (r Result) ToGob() ([]byte, error) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(r)
+	if err != nil {
+		return nil, laroussefr.NewError("ToGob", "", err.Error())
+	}
+	return buf.Bytes(), nil
+}
+
+// FromGob decodes a Result encoded by ToGob.
+func FromGob(data []byte) (Result, error) {
+	var r Result
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&r)
+	if err != nil {
+		return Result{}, laroussefr.NewKindError("FromGob", "", err.Error(), laroussefr.ErrInvalidInput)
+	}
+	return r, nil
+}
+
+// gobResult is a Result without its GobEncode and GobDecode methods.
+type gobResult Result
+
+// GobEncode implements gob.GobEncoder. Errors isn't encoded, as with JSON,
+// since gob can't encode arbitrary errors, so a decoded Result's Errors is nil.
+func (r Result) GobEncode() ([]byte, error) {
+	r.Errors = nil
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(gobResult(r))
+	return buf.Bytes(), err
+}
+
+// GobDecode implements gob.GobDecoder.
+func (r *Result) GobDecode(data []byte) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode((*gobResult)(r))
+}
+
 // equalPageIDs returns true if p and q have the same page ID.
 func (r Result) equalPageIDs(q Result) (string, bool) {
 	if r.PageID != q.PageID {
@@ -236,34 +342,355 @@ func (r Result) equalSeeAlsoIDs(q Result) (string, bool) {
 	return "", true
 }
 
+// IsEmpty returns true if r has no content, i.e. no header text and no items
+// in any section. PageID, SeeAlso and Candidates aren't considered, so the
+// Result returned with ErrWordNotFound is empty even if it has search
+// suggestions.
+func (r Result) IsEmpty() bool {
+	return r.Header.Texte == "" && len(r.Definitions) == 0 &&
+		len(r.Expressions) == 0 && len(r.Relations) == 0 &&
+		len(r.Homonymes) == 0 && len(r.Difficultes) == 0 &&
+		len(r.Citations) == 0
+}
+
+// Links returns every Larousse link referenced in r, i.e. its conjugation link,
+// its citations' author links, the page it redirects to, its candidates on a
+// disambiguation page and its SeeAlso URLs, as absolute URLs without
+// duplicates. Relations aren't included, since Larousse shows synonyms and
+// antonyms as plain text.
+func (r Result) Links() []string {
+	urls := []string{r.Header.InfinitiveURL}
+	for _, c := range r.Citations {
+		urls = append(urls, c.AuteurURL)
+	}
+	urls = append(urls, r.RedirectTo.URL)
+	for _, c := range r.Candidates {
+		urls = append(urls, c.URL)
+	}
+	urls = append(urls, r.SeeAlso...)
+	return laroussefr.UniqueLinks(urls)
+}
+
+// OrphanRelations returns the relations in r which have no corresponding
+// definition, i.e. the ones with an empty Texte. These are only scraped if
+// IncludeOrphanRelations is true.
+func (r Result) OrphanRelations() []Relation {
+	var out []Relation
+	for _, rel := range r.Relations {
+		if rel.Texte == "" {
+			out = append(out, rel)
+		}
+	}
+	return out
+}
+
+// ID returns r's PageID. It implements laroussefr.Page.
+func (r Result) ID() int {
+	return r.PageID
+}
+
+// SeeAlsoURLs returns r's SeeAlso. It implements laroussefr.Page.
+func (r Result) SeeAlsoURLs() []string {
+	return r.SeeAlso
+}
+
+// NotFound returns true if r was returned with ErrWordNotFound. It implements
+// laroussefr.Page.
+func (r Result) NotFound() bool {
+	return r.PageID == laroussefr.NotFoundPageID
+}
+
+// HasAudio returns true if r's Header has an audio clip.
+func (r Result) HasAudio() bool {
+	return r.Header.Audio != ""
+}
+
+// AudioURLs returns the URLs of every audio clip in r's Header without
+// duplicates, starting with its Audio. Definitions and Expressions have no
+// audio of their own.
+func (r Result) AudioURLs() []string {
+	urls := append([]string{r.Header.Audio}, r.Header.AudioURLs...)
+	return laroussefr.UniqueLinks(urls)
+}
+
+// AssetCount returns the number of distinct audio clips in r, i.e. the number
+// of files that downloading all of its audio would take.
+func (r Result) AssetCount() (audioCount int) {
+	return len(r.AudioURLs())
+}
+
+// Examples returns the example phrases of r's Definitions followed by those of
+// its Expressions, in page order.
+func (r Result) Examples() []string {
+	var out []string
+	for _, d := range r.Definitions {
+		out = append(out, d.Exemples...)
+	}
+	for _, e := range r.Expressions {
+		out = append(out, e.Exemples...)
+	}
+	return out
+}
+
+// DefinitionTexts returns the text of each of r's Definitions in order, for a
+// plain numbered list, i.e. the n-th definition's text is at index n-1. Runs
+// of whitespace are collapsed into single spaces.
+// 
+// If examples is false, the example phrases are left out, i.e. each
+// definition's Sens is used instead of its Texte. If contexts is true, each
+// text is prefixed with the definition's red contexts, e.g. "Électricité.
+// Familier. Batterie d'accumulateurs."
+func (r Result) DefinitionTexts(examples, contexts bool) []string {
+	var out []string
+	for _, d := range r.Definitions {
+		text := d.Texte
+		if !examples && d.Sens != "" {
+			text = d.Sens
+		}
+		if contexts {
+			for _, red := range []string{d.RedSmall, d.RedBig} {
+				red = strings.TrimSpace(red)
+				if red == "" {
+					continue
+				}
+				if !strings.ContainsAny(red[len(red)-1:], ".!?") {
+					red += "."
+				}
+				text = red + " " + text
+			}
+		}
+		out = append(out, strings.Join(strings.Fields(text), " "))
+	}
+	return out
+}
+
+// IsVerb returns true if r is the page of a verb (see Header.IsVerb).
+func (r Result) IsVerb() bool {
+	return r.Header.IsVerb()
+}
+
+// IsDisambiguation returns true if r is a disambiguation page, which lists
+// r.Candidates to choose from instead of a word.
+func (r Result) IsDisambiguation() bool {
+	return len(r.Candidates) > 0
+}
+
+// IsRedirect returns true if r is a redirect-only page, whose definitions are
+// nothing but a reference to r.RedirectTo.
+func (r Result) IsRedirect() bool {
+	return r.RedirectTo.Word != ""
+}
+
+// PartsOfSpeech returns the grammatical categories in r's Header's Type, as
+// described by laroussefr.PartsOfSpeech, e.g. ["adjectif"].
+func (r Result) PartsOfSpeech() []string {
+	return laroussefr.PartsOfSpeech(r.Header.Type)
+}
+
+// Lemma returns r's headword, which is the first form in its Header's Texte,
+// e.g. "vert" for "vert, verte", normalized with laroussefr.NormalizeLemma.
+// For a redirect-only page, the word it redirects to is returned instead, e.g.
+// "clé" for "clef". It's empty if Texte is.
+func (r Result) Lemma() string {
+	if r.IsRedirect() {
+		return laroussefr.NormalizeLemma(r.RedirectTo.Word)
+	}
+	forms := r.Header.forms()
+	if len(forms) == 0 {
+		return ""
+	}
+	return laroussefr.NormalizeLemma(forms[0])
+}
+
+// DiffItems returns r's content for laroussefr.DiffResults: its header and each
+// item of each section, without audio URLs, citation IDs or links.
+func (r Result) DiffItems() []laroussefr.DiffItem {
+	h := r.Header
+	out := []laroussefr.DiffItem{laroussefr.NewDiffItem("Header", h.Texte, h.Phonetic, h.Type)}
+	for _, d := range r.Definitions {
+		out = append(out, laroussefr.NewDiffItem("Definitions", d.RedBig, d.RedSmall, d.Texte))
+	}
+	for _, e := range r.Expressions {
+		out = append(out, laroussefr.NewDiffItem("Expressions", e.RedBig, e.RedSmall, e.Texte))
+	}
+	for _, rel := range r.Relations {
+		syn := laroussefr.DiffList("synonymes", rel.Synonymes)
+		con := laroussefr.DiffList("contraires", rel.Contraires)
+		out = append(out, laroussefr.NewDiffItem("Relations", rel.Texte, syn, con))
+	}
+	for _, hom := range r.Homonymes {
+		out = append(out, laroussefr.NewDiffItem("Homonymes", hom.Texte, hom.Type))
+	}
+	for _, d := range r.Difficultes {
+		out = append(out, laroussefr.NewDiffItem("Difficultes", d.Type, d.Texte))
+	}
+	for _, c := range r.Citations {
+		out = append(out, laroussefr.NewDiffItem("Citations", c.Auteur, c.InfoAuteur, c.Texte, c.Info))
+	}
+	return out
+}
+
+// Fingerprint returns a hash of r's content, which can be stored and compared
+// with a later scrape of the same page to detect whether its entry changed.
+// 
+// Only the text of the header and each section is hashed. PageID, audio URLs,
+// citation IDs and SeeAlso are left out, since they can change without the
+// entry itself changing.
+func (r Result) Fingerprint() string {
+	strs := []string{"Header", r.Header.Texte, r.Header.Type}
+	strs = append(strs, "Definitions")
+	for _, d := range r.Definitions {
+		strs = append(strs, d.Texte, d.RedBig, d.RedSmall)
+	}
+	strs = append(strs, "Expressions")
+	for _, e := range r.Expressions {
+		strs = append(strs, e.Texte, e.RedBig, e.RedSmall)
+	}
+	strs = append(strs, "Relations")
+	for _, rel := range r.Relations {
+		strs = append(strs, rel.Texte, "Synonymes")
+		strs = append(strs, rel.Synonymes...)
+		strs = append(strs, "Contraires")
+		strs = append(strs, rel.Contraires...)
+	}
+	strs = append(strs, "Homonymes")
+	for _, h := range r.Homonymes {
+		strs = append(strs, h.Texte, h.Type)
+	}
+	strs = append(strs, "Difficultes")
+	for _, d := range r.Difficultes {
+		strs = append(strs, d.Type, d.Texte)
+	}
+	strs = append(strs, "Citations")
+	for _, c := range r.Citations {
+		strs = append(strs, c.Auteur, c.InfoAuteur, c.Texte, c.Info)
+	}
+	return laroussefr.HashStrings(strs)
+}
+
 // Type Header represents the header area of a page.
+// 
+// Phonetic is the IPA pronunciation text shown in square brackets, if any. When
+// Texte has several forms, e.g. "vert, verte", Phonetic usually lists one
+// pronunciation per form (see FormPhonetics).
+// 
+// InfinitiveURL is the URL of the verb's conjugation page, if the header links
+// to one.
+// 
+// AudioURLs holds the URL of every audio clip in the header, starting with
+// Audio's, for headwords with more than one pronunciation, e.g. European and
+// Canadian French.
+// 
+// AudioClips holds the same clips as AudioURLs, each with the region label
+// Larousse shows after it, if any, e.g. "Québec", so that users can pick an
+// accent.
 type Header struct {
-	Texte  string
-	Audio  string
-	Type   string
+	Texte          string
+	Phonetic       string
+	Audio          string
+	Type           string
+	InfinitiveURL  string
+	AudioURLs      []string
+	AudioClips     []laroussefr.AudioClip
 }
 
 // equals returns true if h and i are identical.
 func (h Header) equals(i Header) (string, bool) {
 	switch {
 	case h.Texte != i.Texte: return fmt.Sprintf("Texte: h:%s\ni:%s", h.Texte, i.Texte), false
+	case h.Phonetic != i.Phonetic: return fmt.Sprintf("Phonetic: h:%s\ni:%s", h.Phonetic, i.Phonetic), false
 	case h.Audio != i.Audio: return fmt.Sprintf("Audio: h:%s\ni:%s", h.Audio, i.Audio), false
 	case h.Type != i.Type:   return fmt.Sprintf("Type: h:%s\ni:%s", h.Type, i.Type), false
+	case h.InfinitiveURL != i.InfinitiveURL: return fmt.Sprintf("InfinitiveURL: h:%s\ni:%s", h.InfinitiveURL, i.InfinitiveURL), false
 	}
 	return "", true
 }
 
+// FormPhonetics returns a map of each form in h's Texte, e.g. "vert" and
+// "verte" in "vert, verte", to its pronunciation in Phonetic. If there are
+// fewer pronunciations than forms, the last pronunciation is used for the
+// remaining forms. The map is empty if Phonetic is.
+func (h Header) FormPhonetics() map[string]string {
+	out := make(map[string]string)
+	parts := laroussefr.SplitPhonetic(h.Phonetic)
+	if len(parts) == 0 {
+		return out
+	}
+	for i, form := range h.forms() {
+		if i >= len(parts) {
+			i = len(parts)-1
+		}
+		out[form] = parts[i]
+	}
+	return out
+}
+
+// Type FormPhonetic is an inflected form of a word and its pronunciation.
+type FormPhonetic struct {
+	Form     string
+	Phonetic string
+}
+
+// FormsWithPhonetics returns each form in h's Texte, in order, along with its
+// pronunciation in Phonetic, e.g. "vert" with "[vεr]" and "verte" with "[vεrt]"
+// for "vert, verte". Unlike FormPhonetics, no pronunciation is guessed: if
+// there are fewer pronunciations than forms, the Phonetic of the remaining
+// forms is empty.
+func (h Header) FormsWithPhonetics() []FormPhonetic {
+	var out []FormPhonetic
+	parts := laroussefr.SplitPhonetic(h.Phonetic)
+	for i, form := range h.forms() {
+		fp := FormPhonetic{Form: form}
+		if i < len(parts) {
+			fp.Phonetic = parts[i]
+		}
+		out = append(out, fp)
+	}
+	return out
+}
+
+// forms returns the comma-separated forms in h's Texte.
+func (h Header) forms() []string {
+	var out []string
+	for _, form := range strings.Split(h.Texte, ",") {
+		form = strings.TrimSpace(form)
+		if form != "" {
+			out = append(out, form)
+		}
+	}
+	return out
+}
+
+// IsVerb returns true if h's Type is a verb, e.g. "verbe transitif".
+func (h Header) IsVerb() bool {
+	return laroussefr.IsVerbType(h.Type)
+}
+
 // Type Relation represents an item from a page's SYNONYMES ET CONTRAIRES
 // section.
 // 
-// Texte is often, but not always, equivalent to the Texte of an item from
-// DÉFINITIONS or EXPRESSIONS.
+// Texte is often, but not always, the start of the Texte of an item from
+// DÉFINITIONS or EXPRESSIONS. Its trailing spaces, periods, commas and
+// ellipses are trimmed, so that it can be compared with that item using
+// strings.HasPrefix.
 type Relation struct {
 	Texte      string
 	Synonymes  []string
 	Contraires []string
 }
 
+// SortedSynonymes returns r's Synonymes in French alphabetical order, without
+// duplicates, as sorted by laroussefr.SortFrench. r.Synonymes keeps Larousse's
+// order.
+func (r Relation) SortedSynonymes() []string {
+	return laroussefr.SortFrench(r.Synonymes)
+}
+
+// SortedContraires is like SortedSynonymes, but for r's Contraires.
+func (r Relation) SortedContraires() []string {
+	return laroussefr.SortFrench(r.Contraires)
+}
+
 // equals returns true if r and q are identical.
 func (r Relation) equals(q Relation) (string, bool) {
 	if r.Texte != q.Texte {
@@ -326,10 +753,23 @@ func (r Relation) equalContraires(q Relation) (string, bool) {
 // 
 // RedSmall is more specific context written in red text preceding the
 // definition text.
+// 
+// Exemples holds the example phrases in blue font, which are also part of
+// Texte.
+// 
+// Sens is the meaning part of Texte, i.e. the text before the first example
+// phrase, without the colon separating them or any red context. It equals the
+// black text of Texte if there are no Exemples.
+// 
+// RawHTML is the HTML of the definition's <li> node if IncludeRawHTML is true.
+// Otherwise, it's empty.
 type Definition struct {
 	Texte    string
 	RedBig   string
 	RedSmall string
+	Exemples []string
+	Sens     string
+	RawHTML  string
 }
 
 // equals returns true if d and e are identical.
@@ -342,6 +782,29 @@ func (d Definition) equals(e Definition) (string, bool) {
 	return "", true
 }
 
+// Type DefinitionGroup is a run of consecutive definitions sharing the same
+// context (RedBig). Context is empty for definitions without one.
+type DefinitionGroup struct {
+	Context string
+	Defs    []Definition
+}
+
+// DefinitionGroups returns r's Definitions clustered into runs of consecutive
+// definitions with the same RedBig, in page order, so that a shared context
+// can be rendered once as a heading over its numbered definitions.
+func (r Result) DefinitionGroups() []DefinitionGroup {
+	var out []DefinitionGroup
+	for _, d := range r.Definitions {
+		last := len(out)-1
+		if last >= 0 && out[last].Context == d.RedBig {
+			out[last].Defs = append(out[last].Defs, d)
+			continue
+		}
+		out = append(out, DefinitionGroup{d.RedBig, []Definition{d}})
+	}
+	return out
+}
+
 // Type Expression represents an item from a page's EXPRESSIONS section.
 // 
 // Texte is the expression text.
@@ -351,10 +814,20 @@ func (d Definition) equals(e Definition) (string, bool) {
 // 
 // RedSmall is more specific context written in red text preceding the
 // definition text.
+// 
+// Exemples holds the expression's example phrases, which are also part of
+// Texte.
+// 
+// RelatedDefinitionIndex is the index in the Result's Definitions of the
+// definition the expression refers to, either with a link to it or by its
+// number, e.g. "(voir sens 2)", so that expressions can be grouped under their
+// sense. It's -1 if there's no such reference.
 type Expression struct {
-	Texte    string
-	RedBig   string
-	RedSmall string
+	Texte                  string
+	RedBig                 string
+	RedSmall               string
+	Exemples               []string
+	RelatedDefinitionIndex int
 }
 
 // equals returns true if e and f are identical.
@@ -383,9 +856,17 @@ func (h Homonyme) equals(i Homonyme) (string, bool) {
 }
 
 // Type Difficulte represents an item from a page's DIFFICULTÉS section.
+// 
+// Texte is the full text of the note, including its examples.
+// 
+// Correct and Incorrect are the examples in Texte, split according to whether
+// they show the right or the wrong usage, e.g. ["un chariot"] and
+// ["un charriot"] for "on écrit un chariot et non un charriot".
 type Difficulte struct {
-	Type  string
-	Texte string
+	Type      string
+	Texte     string
+	Correct   []string
+	Incorrect []string
 }
 
 // equals returns true if d and e are identical.
@@ -393,14 +874,139 @@ func (d Difficulte) equals(e Difficulte) (string, bool) {
 	switch {
 	case d.Type != e.Type:         return fmt.Sprintf("Type: d:%s\ne:%s", d.Type, e.Type), false
 	case d.Texte != e.Texte:       return fmt.Sprintf("Texte: d:%s\ne:%s", d.Texte, e.Texte), false
+	case strings.Join(d.Correct, "|") != strings.Join(e.Correct, "|"):
+		return fmt.Sprintf("Correct: d:%v\ne:%v", d.Correct, e.Correct), false
+	case strings.Join(d.Incorrect, "|") != strings.Join(e.Incorrect, "|"):
+		return fmt.Sprintf("Incorrect: d:%v\ne:%v", d.Incorrect, e.Incorrect), false
 	}
 	return "", true
 }
 
+// Type SpellingVariant is a spelling of a word, either one of the forms in its
+// header or a mistake given by its DIFFICULTÉS section, along with its
+// pronunciation and the difficulty which mentions it.
+// 
+// Form is the spelling, e.g. "chariot" or "un charriot". Correct is false if
+// Form is a mistake. Phonetic is the pronunciation of the header form which
+// Form is or which its correct counterpart contains, as given by
+// Header.FormPhonetics; a mistake is usually pronounced like the right
+// spelling. Type and Note are the Type and Texte of the Difficulte, which are
+// empty for a header form that no difficulty mentions.
+type SpellingVariant struct {
+	Form     string
+	Phonetic string
+	Correct  bool
+	Type     string
+	Note     string
+}
+
+// SpellingGuide returns the forms in r's Header followed by the mistakes in
+// its Difficultes, in order, each paired with its pronunciation and difficulty
+// note, as described by SpellingVariant. It's meant for showing a word's
+// common mistakes next to its right spelling. A header form is paired with the
+// first difficulty whose correct examples contain it, or whose Texte does if
+// there's none.
+func (r Result) SpellingGuide() []SpellingVariant {
+	phonetics := r.Header.FormPhonetics()
+	forms := r.Header.forms()
+	var out []SpellingVariant
+	for _, form := range forms {
+		v := SpellingVariant{Form: form, Phonetic: phonetics[form], Correct: true}
+		if d, ok := r.difficulteAbout(form); ok {
+			v.Type, v.Note = d.Type, d.Texte
+		}
+		out = append(out, v)
+	}
+	for _, d := range r.Difficultes {
+		for i, mistake := range d.Incorrect {
+			counterparts := d.Correct
+			if i < len(d.Correct) {
+				counterparts = d.Correct[i:i+1]
+			}
+			v := SpellingVariant{Form: mistake, Type: d.Type, Note: d.Texte}
+			for _, form := range forms {
+				for _, c := range counterparts {
+					if v.Phonetic == "" && containsWord(c, form) {
+						v.Phonetic = phonetics[form]
+					}
+				}
+			}
+			if v.Phonetic == "" && len(forms) == 1 {
+				v.Phonetic = phonetics[forms[0]]
+			}
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// difficulteAbout returns the first of r's Difficultes whose correct examples
+// contain form, or else whose Texte does, and true. If there's none, false is
+// returned.
+func (r Result) difficulteAbout(form string) (Difficulte, bool) {
+	for _, d := range r.Difficultes {
+		for _, c := range d.Correct {
+			if containsWord(c, form) {
+				return d, true
+			}
+		}
+	}
+	for _, d := range r.Difficultes {
+		if containsWord(d.Texte, form) {
+			return d, true
+		}
+	}
+	return Difficulte{}, false
+}
+
+// containsWord returns true if text contains word as a whole word, ignoring
+// case, e.g. "Chariot s'écrit..." contains "chariot" but "charriot" doesn't.
+func containsWord(text, word string) bool {
+	isSeparator := func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '-'
+	}
+	want := strings.FieldsFunc(strings.ToLower(word), isSeparator)
+	got := strings.FieldsFunc(strings.ToLower(text), isSeparator)
+	for i := 0; i+len(want) <= len(got) && len(want) > 0; i++ {
+		if strings.Join(got[i:i+len(want)], " ") == strings.Join(want, " ") {
+			return true
+		}
+	}
+	return false
+}
+
+// Type Candidate represents an entry listed by a disambiguation page.
+// 
+// Word is the entry's headword as shown in the list, e.g. "vers", and Type is
+// the grammatical type shown next to it, e.g. "préposition", which tells
+// homographs apart. It's empty if the list doesn't show one. URL is the
+// absolute URL of the entry's page, and PageID is its ID.
+type Candidate struct {
+	Word   string
+	Type   string
+	URL    string
+	PageID int
+}
+
+// Type Redirect is the entry referred to by a redirect-only page, i.e. one
+// whose definitions only point to another word with an arrow ("→").
+// 
+// Word is the referred word, e.g. "clé", and URL is the absolute URL of its
+// page, resolved against laroussefr.BaseURL like SeeAlso. If the page doesn't
+// link to it, URL is the one New would download.
+type Redirect struct {
+	Word string
+	URL  string
+}
+
 // Type Citation represents an item from a page's CITATIONS section.
+// 
+// AuteurURL is the URL of the author's encyclopedia page, if the author's name
+// is a link. Otherwise, it's empty.
 type Citation struct {
 	ID         int
 	Auteur     string
+	AuteurURL  string
 	InfoAuteur string
 	Texte      string
 	Info       string
@@ -411,6 +1017,7 @@ func (c Citation) equals(d Citation) (string, bool) {
 	switch {
 	case c.ID != d.ID:                 return fmt.Sprintf("ID: c:%d\nd:%d", c.ID, d.ID), false
 	case c.Auteur != d.Auteur:         return fmt.Sprintf("Auteur: c:%s\nd:%s", c.Auteur, d.Auteur), false
+	case c.AuteurURL != d.AuteurURL:   return fmt.Sprintf("AuteurURL: c:%s\nd:%s", c.AuteurURL, d.AuteurURL), false
 	case c.InfoAuteur != d.InfoAuteur: return fmt.Sprintf("InfoAuteur: c:%s\nd:%s", c.InfoAuteur, d.InfoAuteur), false
 	case c.Texte != d.Texte:           return fmt.Sprintf("Texte: c:%s\nd:%s", c.Texte, d.Texte), false
 	case c.Info != d.Info:             return fmt.Sprintf("Info: c:%s\nd:%s", c.Info, d.Info), false
@@ -418,6 +1025,14 @@ func (c Citation) equals(d Citation) (string, bool) {
 	return "", true
 }
 
+// Permalink returns a link to c on the page at pageURL, which c was scraped
+// from, using c's ID as the fragment, e.g.
+// "https://www.larousse.fr/dictionnaires/francais/arbre/4974#1066420". If c has
+// no ID, an empty string is returned.
+func (c Citation) Permalink(pageURL string) string {
+	return laroussefr.Permalink(pageURL, c.ID)
+}
+
 
 // New takes a French word and searches for its definition on Larousse.
 // 
@@ -425,47 +1040,176 @@ func (c Citation) equals(d Citation) (string, bool) {
 // provides search suggestions for this nonexistent word, they will be put into
 // the returned Result's SeeAlso slice.
 func New(word string) (Result, error) {
+	return NewContext(context.Background(), word)
+}
+
+// NewContext is like New, but the download is cancelled when ctx is done.
+func NewContext(ctx context.Context, word string) (Result, error) {
 	if word == "" {
-		return Result{}, laroussefr.NewError("New", word, "Empty string")
+		return Result{}, laroussefr.NewKindError("New", word, "Empty string", laroussefr.ErrInvalidInput)
 	}
-	if strings.ContainsRune(word, ' ') {
-		word = strings.ReplaceAll(word, " ", "-")
+	return NewFromFileOrURLContext(ctx, wordURL(word))
+}
+
+// wordURL returns the URL of word's page.
+func wordURL(word string) string {
+	return laroussefr.WordURL("francais", word)
+}
+
+// NewHeaderOnly looks up word like New, but only scrapes the page's Header,
+// skipping the DÉFINITIONS section and everything after it. This is much
+// cheaper when only the word's forms, type and audio are needed.
+// 
+// If the word doesn't exist, an error ErrWordNotFound is returned.
+func NewHeaderOnly(word string) (Header, error) {
+	return NewHeaderOnlyContext(context.Background(), word)
+}
+
+// NewHeaderOnlyContext is like NewHeaderOnly, but the download is cancelled
+// when ctx is done.
+func NewHeaderOnlyContext(ctx context.Context, word string) (Header, error) {
+	if word == "" {
+		return Header{}, laroussefr.NewKindError("NewHeaderOnly", word, "Empty string", laroussefr.ErrInvalidInput)
 	}
-	url := "https://www.larousse.fr/dictionnaires/francais/" + word
-	return NewFromFileOrURL(url)
+	return HeaderFromFileOrURLContext(ctx, wordURL(word))
+}
+
+// HeaderFromFileOrURL is like NewFromFileOrURL, but only scrapes the page's
+// Header.
+func HeaderFromFileOrURL(in string) (Header, error) {
+	return HeaderFromFileOrURLContext(context.Background(), in)
+}
+
+// HeaderFromFileOrURLContext is like HeaderFromFileOrURL, but if in is a URL,
+// the download is cancelled when ctx is done.
+func HeaderFromFileOrURLContext(ctx context.Context, in string) (Header, error) {
+	if !scrapeutil.FileExists(in) {
+		ok, message := isURL(in)
+		if !ok {
+			return Header{}, laroussefr.NewKindError("HeaderFromFileOrURL", in, "Bad URL: " + message, laroussefr.ErrInvalidInput)
+		}
+		in = laroussefr.NormalizeURL(in)
+	}
+	
+	doc, _, err := scrapeutil.HTMLRootInfo(ctx, in)
+	if err != nil {
+		return Header{}, laroussefr.WrapError("HeaderFromFileOrURL", in, "Download step: ", err)
+	}
+	
+	if laroussefr.IsWordNotFoundPage(doc) {
+		return Header{}, laroussefr.NewKindError("HeaderFromFileOrURL", in, "ErrWordNotFound", laroussefr.ErrWordNotFound)
+	}
+	
+	head, err := findHeader(doc)
+	if err != nil {
+		return Header{}, laroussefr.NewKindError("HeaderFromFileOrURL", in, err.Error(), laroussefr.ErrParse)
+	}
+	return head, nil
 }
 
 // NewFromFileOrURL scrapes a French definition page given as either an HTML
 // filepath or a URL.
 // 
 // If the result is a "word not found" page, an error ErrWordNotFound is
-// returned. If the page provides search suggestions, they will be put into the
-// returned Result's SeeAlso slice.
+// returned along with an empty Result whose PageID is
+// laroussefr.NotFoundPageID. If the page provides search suggestions, they will
+// be put into the returned Result's SeeAlso slice.
 func NewFromFileOrURL(in string) (Result, error) {
+	return NewFromFileOrURLContext(context.Background(), in)
+}
+
+// NewFromFileOrURLContext is like NewFromFileOrURL, but if in is a URL, the
+// download is cancelled when ctx is done.
+func NewFromFileOrURLContext(ctx context.Context, in string) (Result, error) {
+	res, _, err := newWithDoc(ctx, in)
+	return res, err
+}
+
+// NewFromHTML is like NewFromFileOrURL, but scrapes a page given as its HTML,
+// e.g. markup copied from a browser's developer tools, without reading a file
+// or downloading anything.
+func NewFromHTML(s string) (Result, error) {
+	doc, err := scrapeutil.HTMLRootFromString(s)
+	if err != nil {
+		return Result{}, laroussefr.WrapError("NewFromHTML", "", "", err)
+	}
+	return ParseDocument(doc)
+}
+
+// ParseDocument scrapes a page which has already been downloaded and parsed,
+// given as its root node, e.g. one returned by scrapeutil.HTMLRoot. Nothing is
+// read or downloaded, which makes it suitable for benchmarking and testing the
+// scrapers on their own. doc isn't modified.
+// 
+// As with NewFromFileOrURL, an error ErrWordNotFound is returned for a "word
+// not found" page.
+func ParseDocument(doc *html.Node) (Result, error) {
+	if laroussefr.IsWordNotFoundPage(doc) {
+		return notFoundResult(doc), laroussefr.NewKindError("ParseDocument", "", "ErrWordNotFound", laroussefr.ErrWordNotFound)
+	}
+	res, err := newResultFromRoot(doc)
+	if err != nil {
+		return Result{}, laroussefr.NewKindError("ParseDocument", "", "Scrape step: " + err.Error(), laroussefr.ErrParse)
+	}
+	return res, nil
+}
+
+// NewWithDoc is like NewFromFileOrURL, but also returns the page's parsed root
+// node, so that data which Result doesn't model can be scraped from it without
+// downloading and parsing the page again. The node is nil if the page couldn't
+// be downloaded. Mutating it is unsupported.
+func NewWithDoc(in string) (Result, *html.Node, error) {
+	return newWithDoc(context.Background(), in)
+}
+
+// newWithDoc implements NewFromFileOrURLContext and NewWithDoc.
+func newWithDoc(ctx context.Context, in string) (Result, *html.Node, error) {
 	if !scrapeutil.FileExists(in) {
 		ok, message := isURL(in)
 		if !ok {
-			return Result{}, laroussefr.NewError("NewFromFileOrURL", in, "Bad URL: " + message)
+			return Result{}, nil, laroussefr.NewKindError("NewFromFileOrURL", in, "Bad URL: " + message, laroussefr.ErrInvalidInput)
 		}
+		in = laroussefr.NormalizeURL(in)
 	}
 	
-	doc, err := scrapeutil.HTMLRoot(in)
+	doc, info, err := scrapeutil.HTMLRootInfo(ctx, in)
 	if err != nil {
-		return Result{}, laroussefr.NewError("NewFromFileOrURL", in, "Download step: " + err.Error())
+		return Result{}, nil, laroussefr.WrapError("NewFromFileOrURL", in, "Download step: ", err)
 	}
 	
 	if laroussefr.IsWordNotFoundPage(doc) {
-		ErrWordNotFound = laroussefr.NewError("NewFromFileOrURL", in, "ErrWordNotFound")
-		var res Result
-		res.SeeAlso = laroussefr.GetSearchSuggestions(doc)
-		return res, ErrWordNotFound
+		res := notFoundResult(doc)
+		res.setPageInfo(info)
+		return res, doc, laroussefr.NewKindError("NewFromFileOrURL", in, "ErrWordNotFound", laroussefr.ErrWordNotFound)
 	}
 	
 	res, err := newResultFromRoot(doc)
+	res.setPageInfo(info)
+	for i := 0; err != nil && i < scrapeutil.RetriesFor(ctx, in); i++ {
+		res, doc, err = retryFromURL(ctx, in)
+	}
 	if err != nil {
-		return Result{}, laroussefr.NewError("NewFromFileOrURL", in, "Scrape step: " + err.Error())
+		return Result{}, doc, laroussefr.NewKindError("NewFromFileOrURL", in, "Scrape step: " + err.Error(), laroussefr.ErrParse)
 	}
-	return res, err
+	return res, doc, err
+}
+
+// retryFromURL downloads in again and scrapes it, after a previous attempt
+// failed on what may have been a truncated page.
+func retryFromURL(ctx context.Context, in string) (Result, *html.Node, error) {
+	doc, info, err := scrapeutil.HTMLRootInfo(ctx, in)
+	if err != nil {
+		return Result{}, nil, laroussefr.WrapError("retryFromURL", in, "", err)
+	}
+	res, err := newResultFromRoot(doc)
+	res.setPageInfo(info)
+	return res, doc, err
+}
+
+// setPageInfo sets r's FetchedAt and LastModified from info.
+func (r *Result) setPageInfo(info scrapeutil.PageInfo) {
+	r.FetchedAt = info.FetchedAt
+	r.LastModified = info.LastModified
 }
 
 // isURL verifies if str is a valid URL to a French dictionary page on Larousse.
@@ -477,7 +1221,7 @@ func isURL(str string) (bool, string) {
 		return false, message
 	}
 	
-	substr := "larousse.fr/dictionnaires/francais/"
+	substr := "/dictionnaires/francais/"
 	if !strings.Contains(str, substr) {
 		return false, fmt.Sprintf("Must contain \"%s\"", substr)
 	}
@@ -488,8 +1232,21 @@ func isURL(str string) (bool, string) {
 	return true, ""
 }
 
+// notFoundResult returns the Result for a "word not found" page, which is empty
+// apart from its search suggestions.
+func notFoundResult(doc *html.Node) Result {
+	return Result{
+		PageID:  laroussefr.NotFoundPageID,
+		SeeAlso: laroussefr.GetSearchSuggestions(doc),
+	}
+}
+
 // newPageFromRoot returns a new Result from an HTML root.
 func newResultFromRoot(doc *html.Node) (Result, error) {
+	if isDisambiguationPage(doc) {
+		return disambiguationResult(doc)
+	}
+	
 	pageID, err := laroussefr.GetPageID(doc)
 	if err != nil {
 		return Result{}, laroussefr.NewError("newResultFromRoot", "", err.Error())
@@ -500,43 +1257,117 @@ func newResultFromRoot(doc *html.Node) (Result, error) {
 		return Result{}, laroussefr.NewError("newResultFromRoot", "", err.Error())
 	}
 	
-	defs, err := findDefinitions(doc)
-	if err != nil {
-		return Result{}, laroussefr.NewError("newResultFromRoot", "", err.Error())
+	// the remaining sections are best-effort: a section which fails to parse
+	// is left empty and its error is added to Errors
+	res := Result{PageID: pageID, Header: head}
+	collect := func(section string, err error) {
+		if err != nil {
+			res.Errors = append(res.Errors, laroussefr.WrapError("newResultFromRoot", section, "", err))
+		}
 	}
 	
-	exprs, err := findExpressions(doc)
-	if err != nil {
-		return Result{}, laroussefr.NewError("newResultFromRoot", "", err.Error())
+	res.Definitions, err = findDefinitions(doc)
+	collect("Definitions", err)
+	res.Expressions, err = findExpressions(doc)
+	collect("Expressions", err)
+	res.Relations, err = findRelations(doc)
+	collect("Relations", err)
+	res.Homonymes, err = findHomonymes(doc)
+	collect("Homonymes", err)
+	res.Difficultes, err = findDifficultes(doc)
+	collect("Difficultes", err)
+	res.Citations, err = findCitations(doc)
+	collect("Citations", err)
+	res.RedirectTo = findRedirect(doc)
+	res.SeeAlso, err = laroussefr.GetSimilarWords(doc)
+	collect("SeeAlso", err)
+	return res, nil
+}
+
+// findRedirect returns the entry a redirect-only page refers to, i.e. one
+// without expressions whose definitions are all arrows ("→") to the same
+// word. Otherwise, an empty Redirect is returned.
+func findRedirect(doc *html.Node) Redirect {
+	if _, ok := scrape.Find(doc, match.ExpressionNode); ok {
+		return Redirect{}
 	}
-	
-	rels, err := findRelations(doc)
-	if err != nil {
-		return Result{}, laroussefr.NewError("newResultFromRoot", "", err.Error())
+	var out Redirect
+	isArrow := func(r rune) bool { return r == '→' || unicode.IsSpace(r) }
+	for _, n := range scrape.FindAll(doc, match.DefinitionNode) {
+		renvoi, ok := scrape.Find(n, scrape.ByClass("Renvois"))
+		if !ok {
+			return Redirect{}
+		}
+		word := scrape.Text(renvoi)
+		rest := strings.Replace(scrape.Text(n), word, "", 1)
+		if word == "" || strings.TrimFunc(rest, isArrow) != "" || out.Word != "" && word != out.Word {
+			return Redirect{}
+		}
+		out.Word = word
+		if a, ok := scrape.Find(renvoi, scrape.ByTag(atom.A)); ok && scrape.Attr(a, "href") != "" {
+			out.URL = laroussefr.AbsoluteURL(scrape.Attr(a, "href"))
+		}
 	}
-	
-	homs, err := findHomonymes(doc)
-	if err != nil {
-		return Result{}, laroussefr.NewError("newResultFromRoot", "", err.Error())
+	if out.Word != "" && out.URL == "" {
+		out.URL = wordURL(out.Word)
 	}
-	
-	diffis, err := findDifficultes(doc)
+	return out
+}
+
+// isDisambiguationPage returns true if doc is the root of a disambiguation
+// page, i.e. one which lists candidate entries and has no header of its own.
+func isDisambiguationPage(doc *html.Node) bool {
+	if _, ok := scrape.Find(doc, match.HeaderTexteNode); ok {
+		return false
+	}
+	_, ok := scrape.Find(doc, match.CandidateNode)
+	return ok
+}
+
+// disambiguationResult returns the Result for a disambiguation page, which is
+// empty apart from its Candidates, its SeeAlso list and its page ID, if any.
+func disambiguationResult(doc *html.Node) (Result, error) {
+	candidates, err := findCandidates(doc)
 	if err != nil {
-		return Result{}, laroussefr.NewError("newResultFromRoot", "", err.Error())
+		return Result{}, laroussefr.NewError("disambiguationResult", "", err.Error())
 	}
-	
-	cits, err := findCitations(doc)
+	pageID, err := laroussefr.GetPageID(doc)
 	if err != nil {
-		return Result{}, laroussefr.NewError("newResultFromRoot", "", err.Error())
+		pageID = 0
 	}
-	
 	seeAlso, err := laroussefr.GetSimilarWords(doc)
 	if err != nil {
-		return Result{}, laroussefr.NewError("newResultFromRoot", "", err.Error())
+		return Result{}, laroussefr.NewError("disambiguationResult", "", err.Error())
 	}
-	
-	res := Result{pageID, head, defs, exprs, rels, homs, diffis, cits, seeAlso}
-	return res, nil
+	return Result{PageID: pageID, SeeAlso: seeAlso, Candidates: candidates}, nil
+}
+
+// findCandidates returns the entries listed by a disambiguation page, without
+// duplicates.
+func findCandidates(doc *html.Node) ([]Candidate, error) {
+	var out []Candidate
+	seen := make(map[int]bool)
+	for _, n := range scrape.FindAll(doc, match.CandidateNode) {
+		a, ok := scrape.Find(n, scrape.ByTag(atom.A))
+		if !ok {
+			continue
+		}
+		u := laroussefr.AbsoluteURL(scrape.Attr(a, "href"))
+		pageID, err := laroussefr.GetPageIDFromURL(u)
+		if err != nil {
+			return nil, laroussefr.NewError("findCandidates", u, err.Error())
+		}
+		if seen[pageID] {
+			continue
+		}
+		seen[pageID] = true
+		c := Candidate{Word: laroussefr.CleanWordText(scrape.Text(a)), URL: u, PageID: pageID}
+		if typ, ok := scrape.Find(n, scrape.ByClass("CatgramHomographe")); ok {
+			c.Type = scrape.Text(typ)
+		}
+		out = append(out, c)
+	}
+	return out, nil
 }
 
 // findHeader returns a word's Header.
@@ -546,14 +1377,17 @@ func findHeader(doc *html.Node) (Header, error) {
 		return Header{}, laroussefr.NewError("findHeader", "", err.Error())
 	}
 	
-	audio, err := findHeaderAudio(doc)
-	if err != nil {
-		return Header{}, laroussefr.NewError("findHeader", "", err.Error())
+	audioClips := findHeaderAudioClips(doc)
+	audioURLs := laroussefr.AudioClipURLs(audioClips)
+	var audio string
+	if len(audioURLs) > 0 {
+		audio = audioURLs[0]
 	}
-	
 	typ:= findHeaderType(doc)
+	infinitiveURL := findHeaderInfinitiveURL(doc)
+	phonetic := findHeaderPhonetic(doc)
 	
-	head := Header{texte, audio, typ}
+	head := Header{texte, phonetic, audio, typ, infinitiveURL, audioURLs, audioClips}
 	return head, nil
 }
 
@@ -571,17 +1405,20 @@ func findHeaderTexte(doc *html.Node) (string, error) {
 		}
 		out += scrape.Text(n)
 	}
-	return out, nil
+	return laroussefr.CleanWordText(out), nil
 }
 
-// findHeaderAudio returns a word's audio URL.
-func findHeaderAudio(doc *html.Node) (string, error) {
-	n, ok := scrape.Find(doc, match.HeaderAudioNode)
+// findHeaderAudioClips returns a word's audio clips with their region labels,
+// if any, without duplicates, in the order they appear. The first one is the
+// word's Audio.
+// 
+// Note: This field could be empty (see page for "auto").
+func findHeaderAudioClips(doc *html.Node) []laroussefr.AudioClip {
+	adresse, ok := scrape.Find(doc, scrape.ByClass("AdresseDefinition"))
 	if !ok {
-		return "", laroussefr.NewError("findHeaderAudio", "", "failed to find audio node")
+		return nil
 	}
-	url := laroussefr.GetAudioURL(n)
-	return url, nil
+	return laroussefr.GetAudioClips(scrape.FindAll(adresse, match.HeaderAudioNode))
 }
 
 // findHeaderType returns a word's Type as a string.
@@ -595,6 +1432,30 @@ func findHeaderType(doc *html.Node) string {
 	return ""
 }
 
+// findHeaderPhonetic returns a word's pronunciation, or "" if the header
+// doesn't show one.
+func findHeaderPhonetic(doc *html.Node) string {
+	n, ok := scrape.Find(doc, scrape.ByClass("header-article"))
+	if !ok {
+		return ""
+	}
+	var out string
+	for _, p := range scrape.FindAll(n, scrape.ByClass("Phonetique")) {
+		out += scrape.Text(p)
+	}
+	return out
+}
+
+// findHeaderInfinitiveURL returns the URL of a verb's conjugation page, which
+// is linked next to its Type.
+func findHeaderInfinitiveURL(doc *html.Node) string {
+	n, ok := scrape.Find(doc, scrape.ByClass("CatgramDefinition"))
+	if !ok {
+		return ""
+	}
+	return laroussefr.GetConjugaisonURL(n)
+}
+
 // findDefinitions returns a word's DÉFINITIONS list.
 func findDefinitions(doc *html.Node) ([]Definition, error) {
 	var out []Definition
@@ -604,7 +1465,10 @@ func findDefinitions(doc *html.Node) ([]Definition, error) {
 		if err != nil {
 			return nil, laroussefr.NewError("findDefinitions", "", err.Error())
 		}
-		def := Definition{arr[0], arr[1], arr[2]}
+		def := Definition{arr[0], arr[1], arr[2], parse.Exemples(n), parse.Sens(n), ""}
+		if IncludeRawHTML {
+			def.RawHTML = laroussefr.RenderHTML(n)
+		}
 		out = append(out, def)
 	}
 	return out, nil
@@ -613,22 +1477,47 @@ func findDefinitions(doc *html.Node) ([]Definition, error) {
 // findExpressions returns a word's EXPRESSIONS list.
 func findExpressions(doc *html.Node) ([]Expression, error) {
 	var out []Expression
+	defNodes := scrape.FindAll(doc, match.DefinitionNode)
 	nodes := scrape.FindAll(doc, match.ExpressionNode)
 	for _, n := range nodes {
 		textes, redBig, redSmall, err := parse.ExpressionNode(n)
 		if err != nil {
 			return nil, laroussefr.NewError("findExpressions", "", err.Error())
 		}
-		exp := Expression{textes, redBig, redSmall}
+		exp := Expression{textes, redBig, redSmall, parse.Exemples(n), relatedDefinitionIndex(n, defNodes)}
 		out = append(out, exp)
 	}
 	return out, nil
 }
 
+// relatedDefinitionIndex takes an EXPRESSIONS node and the page's DÉFINITIONS
+// nodes, and returns the index of the definition the expression refers to,
+// found by the ID its link targets or else by the sense number it mentions. If
+// there's none, -1 is returned.
+func relatedDefinitionIndex(n *html.Node, defNodes []*html.Node) int {
+	id, num := parse.ExpressionReference(n)
+	if id != "" {
+		for i, def := range defNodes {
+			_, ok := scrape.Find(def, func(m *html.Node) bool {
+				return scrape.Attr(m, "id") == id
+			})
+			if ok {
+				return i
+			}
+		}
+	}
+	if num >= 1 && num <= len(defNodes) {
+		return num - 1
+	}
+	return -1
+}
+
 // findRelations returns a word's SYNONYMES ET CONTRAIRES list.
 func findRelations(doc *html.Node) ([]Relation, error) {
 	var out []Relation
-	nodes := scrape.FindAll(doc, match.RelationNode)
+	nodes := scrape.FindAll(doc, func(n *html.Node) bool {
+		return match.RelationNode(n) || IncludeOrphanRelations && match.OrphanRelationNode(n)
+	})
 	
 	for _, n := range nodes {
 		texte, syns, conts, err := parse.RelationNode(n)
@@ -663,11 +1552,11 @@ func findDifficultes(doc *html.Node) ([]Difficulte, error) {
 	diffNodes := scrape.FindAll(doc, match.DifficulteNode)
 	
 	for _, n := range diffNodes {
-		categorie, texte, err := parse.DifficulteNode(n)
+		categorie, texte, correct, incorrect, err := parse.DifficulteNode(n)
 		if err != nil {
 			return nil, laroussefr.NewError("findDifficultes", "", err.Error())
 		}
-		diff := Difficulte{categorie, texte}
+		diff := Difficulte{categorie, texte, correct, incorrect}
 		out = append(out, diff)
 	}
 	return out, nil
@@ -683,7 +1572,7 @@ func findCitations(doc *html.Node) ([]Citation, error) {
 		if err != nil {
 			return nil, laroussefr.NewError("findCitations", "", err.Error())
 		}
-		cit := Citation{id, arr[0], arr[1], arr[2], arr[3]}
+		cit := Citation{id, arr[0], arr[1], arr[2], arr[3], arr[4]}
 		out = append(out, cit)
 	}
 	return out, nil
@@ -2,9 +2,23 @@
 package definition
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
 	"testing"
+	
+	"github.com/serope/laroussefr"
+	"github.com/serope/laroussefr/scrapeutil"
+	
+	"github.com/yhat/scrape"
 )
 
 // TestNewBad tests New on bad args.
@@ -78,14 +92,638 @@ func TestNew(t *testing.T) {
 	}
 }
 
+// TestNewFromFileOrURLRetry tests that a page which arrives truncated on the
+// first download is fetched again.
+func TestNewFromFileOrURLRetry(t *testing.T) {
+	page, err := ioutil.ReadFile("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	
+	var downloads int
+	client := scrapeutil.Client
+	defer func() { scrapeutil.Client = client }()
+	scrapeutil.Client = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		downloads++
+		body := page
+		if downloads == 1 {
+			body = page[:len(page)/8]
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewReader(body)),
+			Header:     make(http.Header),
+			Request:    req,
+		}, nil
+	})}
+	
+	got, err := NewFromFileOrURL("https://www.larousse.fr/dictionnaires/francais/arbre/4974")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if downloads != 2 {
+		t.Fatalf("downloads: want 2, got %d", downloads)
+	}
+	if got.PageID != 4974 {
+		t.Fatalf("PageID: want 4974, got %d", got.PageID)
+	}
+}
+
+// TestNewBatchCheckpoint tests that a restarted batch doesn't download the
+// words which were finished before.
+func TestNewBatchCheckpoint(t *testing.T) {
+	page, err := ioutil.ReadFile("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	
+	var downloads int
+	client := scrapeutil.Client
+	defer func() { scrapeutil.Client = client }()
+	scrapeutil.Client = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		downloads++
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewReader(page)),
+			Header:     make(http.Header),
+			Request:    req,
+		}, nil
+	})}
+	
+	opts := scrapeutil.BatchOptions{Checkpoint: scrapeutil.DirCheckpoint(t.TempDir())}
+	for run := 1; run <= 2; run++ {
+		res, errs := NewBatch(context.Background(), []string{"arbre"}, opts)
+		if errs[0] != nil {
+			t.Fatal(errs[0])
+		}
+		if res[0].PageID != 4974 || len(res[0].Definitions) != 3 {
+			t.Fatalf("run %d: got %+v", run, res[0])
+		}
+	}
+	if downloads != 1 {
+		t.Fatalf("downloads: want 1, got %d", downloads)
+	}
+}
+
+// TestNewWithDoc tests that NewWithDoc returns the page's root node.
+func TestNewWithDoc(t *testing.T) {
+	res, doc, err := NewWithDoc("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.PageID != 4974 || doc == nil {
+		t.Fatalf("want page 4974 and a root node, got %d and %v", res.PageID, doc)
+	}
+	n, ok := scrape.Find(doc, scrape.ByClass("CatgramDefinition"))
+	if !ok || scrape.Text(n) != "nom masculin" {
+		t.Fatal("can't find CatgramDefinition in root node")
+	}
+}
+
+// TestHeaderFromFileOrURL tests that the header is scraped on its own.
+func TestHeaderFromFileOrURL(t *testing.T) {
+	got, err := HeaderFromFileOrURL("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := NewFromFileOrURL("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	message, ok := res.Header.equals(got)
+	if !ok {
+		t.Fatal(message)
+	}
+	
+	_, err = HeaderFromFileOrURL("testdata/notfound.html")
+	if !errors.Is(err, ErrWordNotFound) {
+		t.Fatalf("want ErrWordNotFound, got %v", err)
+	}
+}
+
+// TestNewEscaping tests that New and NewFromFileOrURL download the same URL
+// whether or not the word in the URL is already percent-encoded.
+func TestNewEscaping(t *testing.T) {
+	page, err := ioutil.ReadFile("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	
+	var got string
+	client := scrapeutil.Client
+	defer func() { scrapeutil.Client = client }()
+	scrapeutil.Client = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		got = req.URL.String()
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewReader(page)),
+			Header:     make(http.Header),
+			Request:    req,
+		}, nil
+	})}
+	
+	cases := map[string][]string {
+		"aujourd'hui": {
+			"https://www.larousse.fr/dictionnaires/francais/aujourd'hui",
+			"https://www.larousse.fr/dictionnaires/francais/aujourd%27hui",
+		},
+		"été": {
+			"https://www.larousse.fr/dictionnaires/francais/été",
+			"https://www.larousse.fr/dictionnaires/francais/%C3%A9t%C3%A9",
+		},
+		"pomme de terre": {
+			"https://www.larousse.fr/dictionnaires/francais/pomme de terre",
+			"https://www.larousse.fr/dictionnaires/francais/pomme%20de%20terre",
+			"https://www.larousse.fr/dictionnaires/francais/pomme-de-terre",
+		},
+	}
+	for word, urls := range cases {
+		_, err := New(word)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := got
+		for _, u := range urls {
+			_, err := NewFromFileOrURL(u)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Fatalf("%s: New downloaded %s, NewFromFileOrURL(%s) downloaded %s", word, want, u, got)
+			}
+		}
+	}
+}
+
+// TestSectionErrors tests that a malformed citation doesn't stop the rest of
+// the page from being scraped.
+func TestSectionErrors(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/arbre-citation.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Errors) != 1 || len(res.Citations) != 0 {
+		t.Fatalf("want 1 error and no citations, got %v and %d", res.Errors, len(res.Citations))
+	}
+	if len(res.Definitions) != 3 || len(res.Expressions) != 2 || len(res.Difficultes) != 1 {
+		t.Fatalf("other sections missing: %+v", res)
+	}
+	
+	res, err = NewFromFileOrURL("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Errors) != 0 {
+		t.Fatalf("want no errors, got %v", res.Errors)
+	}
+}
+
+// TestNewFromFileOrURLRawData tests that a page scrapes the same whether or
+// not its newlines and tabs are removed before parsing.
+func TestNewFromFileOrURLRawData(t *testing.T) {
+	want, err := NewFromFileOrURL("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	
+	defer func() { scrapeutil.CleanPageData = true }()
+	scrapeutil.CleanPageData = false
+	got, err := NewFromFileOrURL("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	
+	message, ok := want.equals(got)
+	if !ok {
+		t.Fatal(message)
+	}
+}
+
+// TestCitationAuteurURL tests that a linked author's URL is scraped.
+func TestCitationAuteurURL(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Citation{
+		ID:         1234,
+		Auteur:     "Victor Hugo",
+		AuteurURL:  "https://larousse.fr/encyclopedie/personnage/Victor_Hugo/124321",
+		InfoAuteur: "Besançon 1802-Paris 1885",
+		Texte:      "L'arbre est la vie.",
+		Info:       "Les Contemplations",
+	}
+	message, ok := want.equals(res.Citations[0])
+	if !ok {
+		t.Fatal(message)
+	}
+}
+
+// TestLinks tests that Links gathers conjugation links, author links,
+// redirects, candidates and SeeAlso URLs.
+func TestLinks(t *testing.T) {
+	cases := map[string][]string{
+		"testdata/arbre.html": {
+			"https://larousse.fr/encyclopedie/personnage/Victor_Hugo/124321",
+			"https://larousse.fr/dictionnaires/francais/arbrisseau/4978",
+			"https://larousse.fr/dictionnaires/francais/arbuste/4983",
+		},
+		"testdata/manger.html": {"https://larousse.fr/conjugaison/francais/manger/6184"},
+		"testdata/clef.html":   {"https://larousse.fr/dictionnaires/francais/cl%C3%A9/16460"},
+		"testdata/vers.html": {
+			"https://larousse.fr/dictionnaires/francais/vers/81583",
+			"https://larousse.fr/dictionnaires/francais/vers/81584",
+			"https://larousse.fr/dictionnaires/francais/vert/81664",
+			"https://larousse.fr/dictionnaires/francais/versant/81590",
+		},
+	}
+	for path, want := range cases {
+		res, err := NewFromFileOrURL(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := res.Links()
+		if strings.Join(got, "\n") != strings.Join(want, "\n") {
+			t.Errorf("%s: want %q, got %q", path, want, got)
+		}
+	}
+}
+
+// TestOrphanRelations tests a page whose synonyms have no corresponding
+// definition.
+func TestOrphanRelations(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/aguiche.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Relations) != 0 {
+		t.Fatalf("len(Relations): want 0 by default, got %d", len(res.Relations))
+	}
+	
+	defer func() { IncludeOrphanRelations = false }()
+	IncludeOrphanRelations = true
+	res, err = NewFromFileOrURL("testdata/aguiche.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Relation{"", []string{"appât", "leurre"}, nil}
+	orphans := res.OrphanRelations()
+	if len(orphans) != 1 || len(res.Relations) != 1 {
+		t.Fatalf("want 1 orphan relation, got %+v", res.Relations)
+	}
+	message, ok := want.equals(orphans[0])
+	if !ok {
+		t.Fatal(message)
+	}
+	
+	res, err = NewFromFileOrURL("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Relations) != 1 || len(res.OrphanRelations()) != 0 {
+		t.Fatalf("arbre: want 1 relation and no orphans, got %+v", res.Relations)
+	}
+}
+
+// TestDifficulteExamples tests that a difficulty's examples are split into
+// correct and incorrect ones.
+func TestDifficulteExamples(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/chariot.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Difficulte{
+		Type:      "Orthographe",
+		Texte:     "Chariot s'écrit avec un seul r, contrairement à charrette : on écrit un chariot et non un charriot .",
+		Correct:   []string{"un chariot"},
+		Incorrect: []string{"un charriot"},
+	}
+	message, ok := want.equals(res.Difficultes[0])
+	if !ok {
+		t.Fatal(message)
+	}
+}
+
+// TestSpellingGuide tests that header forms and the mistakes in DIFFICULTÉS are
+// paired with their pronunciations and notes.
+func TestSpellingGuide(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/chariot.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	note := res.Difficultes[0].Texte
+	want := []SpellingVariant{
+		{Form: "chariot", Phonetic: "[ʃarjo]", Correct: true, Type: "Orthographe", Note: note},
+		{Form: "un charriot", Phonetic: "[ʃarjo]", Type: "Orthographe", Note: note},
+	}
+	if got := res.SpellingGuide(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("chariot: want %+v, got %+v", want, got)
+	}
+	
+	res = Result{
+		Header:      Header{Texte: "vert, verte", Phonetic: "[vεr, vεrt]"},
+		Difficultes: []Difficulte{{Type: "Orthographe", Texte: "On écrit verte et non vairte.", Correct: []string{"verte"}, Incorrect: []string{"vairte"}}},
+	}
+	want = []SpellingVariant{
+		{Form: "vert", Phonetic: "[vεr]", Correct: true},
+		{Form: "verte", Phonetic: "[vεrt]", Correct: true, Type: "Orthographe", Note: "On écrit verte et non vairte."},
+		{Form: "vairte", Phonetic: "[vεrt]", Type: "Orthographe", Note: "On écrit verte et non vairte."},
+	}
+	if got := res.SpellingGuide(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("vert: want %+v, got %+v", want, got)
+	}
+}
+
+// TestLemma tests that a Result's lemma is the first form of its headword.
+func TestLemma(t *testing.T) {
+	for path, want := range map[string]string{"testdata/vert.html": "vert", "testdata/chariot.html": "chariot"} {
+		res, err := NewFromFileOrURL(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := res.Lemma(); got != want {
+			t.Errorf("%s: want %q, got %q", path, want, got)
+		}
+	}
+	res := Result{Header: Header{Texte: "Frisbee™,  frisbees"}}
+	if got := res.Lemma(); got != "Frisbee" {
+		t.Errorf("trademark: want \"Frisbee\", got %q", got)
+	}
+}
+
+// TestRelatedDefinitionIndex tests that an expression referring to a
+// definition by link or by sense number is linked to it.
+func TestRelatedDefinitionIndex(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/pied.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []int{-1, 0, 1}
+	if len(res.Expressions) != len(want) {
+		t.Fatalf("want %d expressions, got %d", len(want), len(res.Expressions))
+	}
+	for i, e := range res.Expressions {
+		if e.RelatedDefinitionIndex != want[i] {
+			t.Errorf("Expressions[%d]: want %d, got %d", i, want[i], e.RelatedDefinitionIndex)
+		}
+	}
+	
+	res, err = NewFromFileOrURL("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, e := range res.Expressions {
+		if e.RelatedDefinitionIndex != -1 {
+			t.Errorf("arbre: Expressions[%d]: want -1, got %d", i, e.RelatedDefinitionIndex)
+		}
+	}
+}
+
+// TestPartsOfSpeech tests that a Result's parts of speech come from its
+// header's Type.
+func TestPartsOfSpeech(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/vert.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := res.PartsOfSpeech(); !reflect.DeepEqual(got, []string{"adjectif"}) {
+		t.Errorf("vert: want [adjectif], got %v", got)
+	}
+	if got := (Result{}).PartsOfSpeech(); got != nil {
+		t.Errorf("empty: want nil, got %v", got)
+	}
+}
+
+// TestDisambiguation tests that a page listing several entries is scraped into
+// its Candidates.
+func TestDisambiguation(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/vers.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Candidate{
+		{"vers", "nom masculin", "https://larousse.fr/dictionnaires/francais/vers/81583", 81583},
+		{"vers", "préposition", "https://larousse.fr/dictionnaires/francais/vers/81584", 81584},
+		{"vert, verte", "adjectif", "https://larousse.fr/dictionnaires/francais/vert/81664", 81664},
+	}
+	if !res.IsDisambiguation() || !reflect.DeepEqual(res.Candidates, want) {
+		t.Fatalf("want %+v, got %+v", want, res.Candidates)
+	}
+	if res.PageID != 0 || res.Header.Texte != "" || res.NotFound() {
+		t.Errorf("want an empty page with ID 0, got %+v", res)
+	}
+	
+	res, err = NewFromFileOrURL("testdata/vert.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.IsDisambiguation() {
+		t.Errorf("vert: want no candidates, got %+v", res.Candidates)
+	}
+}
+
+// TestRedirect tests that a page whose only definition is an arrow to another
+// word is detected as a redirect, and that other pages aren't.
+func TestRedirect(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/clef.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Redirect{"clé", "https://larousse.fr/dictionnaires/francais/cl%C3%A9/16460"}
+	if !res.IsRedirect() || res.RedirectTo != want || res.Lemma() != "clé" {
+		t.Fatalf("want a redirect to %+v, got %+v", want, res.RedirectTo)
+	}
+	
+	for _, path := range []string{"testdata/arbre.html", "testdata/pied.html"} {
+		res, err := NewFromFileOrURL(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.IsRedirect() {
+			t.Errorf("%s: want no redirect, got %+v", path, res.RedirectTo)
+		}
+	}
+}
+
+// TestRelationTexte tests that a relation's trailing punctuation is trimmed so
+// that it matches the start of its definition.
+func TestRelationTexte(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/chariot.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rel := res.Relations[0]
+	if rel.Texte != "Voiture à quatre roues pour le transport" {
+		t.Fatalf("Texte: got %q", rel.Texte)
+	}
+	if !strings.HasPrefix(res.Definitions[0].Texte, rel.Texte) {
+		t.Fatalf("%q isn't a prefix of %q", rel.Texte, res.Definitions[0].Texte)
+	}
+}
+
+// TestDefinitionGroups tests that consecutive definitions sharing a context
+// are grouped together.
+func TestDefinitionGroups(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/pile.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups := res.DefinitionGroups()
+	wantContexts := []string{"", "Électricité", "Travaux publics", ""}
+	wantLens := []int{1, 2, 1, 1}
+	if len(groups) != len(wantContexts) {
+		t.Fatalf("len(groups): want %d, got %d", len(wantContexts), len(groups))
+	}
+	for i, g := range groups {
+		if g.Context != wantContexts[i] || len(g.Defs) != wantLens[i] {
+			t.Fatalf("groups[%d]: want %q with %d definitions, got %q with %d", i, wantContexts[i], wantLens[i], g.Context, len(g.Defs))
+		}
+	}
+	if groups[1].Defs[1].RedSmall != "Familier." {
+		t.Fatalf("groups[1].Defs[1].RedSmall: want Familier., got %q", groups[1].Defs[1].RedSmall)
+	}
+}
+
+// TestExpressionLongTexte tests that an expression whose explanation spans
+// several nodes is scraped in full.
+func TestExpressionLongTexte(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/main.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"Avoir la main heureuse, réussir souvent dans ce que l'on entreprend : Il a la main heureuse au jeu. Se dit aussi de quelqu'un qui fait un bon choix.",
+		"En venir aux mains, se battre.",
+	}
+	if len(res.Expressions) != len(want) {
+		t.Fatalf("len(Expressions): want %d, got %d", len(want), len(res.Expressions))
+	}
+	for i, e := range res.Expressions {
+		if e.Texte != want[i] {
+			t.Fatalf("Expressions[%d].Texte\nwant: %s\ngot:  %s", i, want[i], e.Texte)
+		}
+	}
+}
+
+// TestHTML tests Result.HTML against a golden file.
+func TestHTML(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/pile.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := res.HTML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := ioutil.ReadFile("testdata/pile.golden.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Fatalf("want:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+// TestHTMLEscape tests that Result.HTML escapes scraped text and URLs.
+func TestHTMLEscape(t *testing.T) {
+	res := Result{Header: Header{Texte: "<script>alert(1)</script>", Audio: "javascript:alert(1)"}}
+	got, err := res.HTML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(got, "<script>") || strings.Contains(got, "javascript:") {
+		t.Fatalf("unescaped output:\n%s", got)
+	}
+	if !strings.Contains(got, "&lt;script&gt;alert(1)&lt;/script&gt;") {
+		t.Fatalf("missing escaped text:\n%s", got)
+	}
+}
+
+// TestErrorKinds tests that each kind of failure can be told apart with
+// errors.Is.
+func TestErrorKinds(t *testing.T) {
+	_, err := NewFromFileOrURL("testdata/notfound.html")
+	if !errors.Is(err, laroussefr.ErrWordNotFound) || errors.Is(err, laroussefr.ErrNetwork) {
+		t.Fatalf("not found: want ErrWordNotFound, got %v", err)
+	}
+	_, err = New("")
+	if !errors.Is(err, laroussefr.ErrInvalidInput) {
+		t.Fatalf("empty word: want ErrInvalidInput, got %v", err)
+	}
+	_, err = NewFromHTML("<html><body><p>nothing</p></body></html>")
+	if !errors.Is(err, laroussefr.ErrParse) || errors.Is(err, laroussefr.ErrWordNotFound) {
+		t.Fatalf("unknown markup: want ErrParse, got %v", err)
+	}
+	
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+	defer func(old string) { laroussefr.BaseURL = old }(laroussefr.BaseURL)
+	laroussefr.BaseURL = server.URL
+	_, err = New("arbre")
+	if !errors.Is(err, laroussefr.ErrNetwork) || errors.Is(err, laroussefr.ErrParse) {
+		t.Fatalf("server error: want ErrNetwork, got %v", err)
+	}
+}
+
+// TestNotFoundResult tests the Result returned with ErrWordNotFound.
+func TestNotFoundResult(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/notfound.html")
+	if !errors.Is(err, ErrWordNotFound) {
+		t.Fatalf("want ErrWordNotFound, got %v", err)
+	}
+	if res.PageID != laroussefr.NotFoundPageID || !res.IsEmpty() || len(res.SeeAlso) != 2 {
+		t.Fatalf("want an empty Result with 2 suggestions, got %+v", res)
+	}
+	
+	res, err = NewFromFileOrURL("testdata/pile.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.IsEmpty() {
+		t.Fatal("IsEmpty: want false, got true")
+	}
+}
+
+// TestIncludeRawHTML tests that the source HTML is kept only if
+// IncludeRawHTML is true.
+func TestIncludeRawHTML(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/pile.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Definitions[0].RawHTML != "" {
+		t.Fatalf("RawHTML: want empty by default, got %s", res.Definitions[0].RawHTML)
+	}
+	
+	defer func() { IncludeRawHTML = false }()
+	IncludeRawHTML = true
+	res, err = NewFromFileOrURL("testdata/pile.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(res.Definitions[1].RawHTML, `<li class="DivisionDefinition"><p class="RubriqueDefinition">Électricité</p>`) {
+		t.Fatalf("RawHTML: got %s", res.Definitions[1].RawHTML)
+	}
+}
+
+// roundTripFunc is an http.RoundTripper which serves responses from a function
+// instead of the network.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
 // getCorrectResult returns the expected Result of a test word.
 func getCorrectResult(word string) (Result, error) {
 	var str string
 	switch word {
 		case "arbre":        str = `{"PageID": 4974,"Header": {"Texte": "arbre","Audio": "https://voix.larousse.fr/francais/36338fra2.mp3","Type": "nom masculin"},"Definitions": [{"Texte": "Végétal vivace, ligneux, rameux, atteignant au moins 7 m de hauteur et ne portant de branches durables qu'à une certaine distance du sol.","RedBig": "","RedSmall": ""},{"Texte": "Figure arborescente servant à représenter schématiquement les filiations entre les éléments d'un ensemble : Arbre généalogique.","RedBig": "","RedSmall": ""},{"Texte": "Nom donné à divers dépôts métalliques présentant la forme d'arborisations.","RedBig": "Chimie","RedSmall": ""},{"Texte": "Ensemble connexe de branches reliant tous les nœuds d'un réseau sans former de boucle.","RedBig": "Électricité","RedSmall": ""},{"Texte": "Représentation d'un programme, ou d'un algorithme, sous la forme d'un graphe faisant apparaître les diverses séquences de calcul, leurs enchaînements et les aiguillages entre ces séquences.","RedBig": "Informatique","RedSmall": ""},{"Texte": "Représentation graphique de la structure en constituants d'une phrase.","RedBig": "Linguistique","RedSmall": ""},{"Texte": "En théorie des graphes, graphe connexe et dont la condition d'unicité des chemins depuis la source implique l'absence de cycle et de circuit.","RedBig": "Mathématiques","RedSmall": ""},{"Texte": "Pièce de révolution utilisée pour transmettre un mouvement de rotation.","RedBig": "Mécanique","RedSmall": ""}],"Expressions": [{"Texte": "Abattre, couper l'arbre pour avoir le fruit, supprimer une source de profit pour obtenir immédiatement un seul avantage.","RedBig": "","RedSmall": ""},{"Texte": "Arbre à palabres, en Afrique, arbre sous lequel se réunissent les anciens du village.","RedBig": "","RedSmall": ""},{"Texte": "Arbre de Noël, sapin que l'on orne et illumine à l'occasion de la fête de Noël ; distribution de cadeaux faite vers Noël par une entreprise ou une association aux enfants du personnel ou des adhérents ; ensemble des vannes, raccords, etc., qui constituent la tête d'un puits de pétrole.","RedBig": "","RedSmall": ""},{"Texte": "Arbre de vie, nom usuel de divers thuyas ; représentation symbolique d'un arbre figurant la médiation entre le ciel et la terre, thème fréquent de l'iconographie orientale ; partie centrale blanche du cervelet, qui se découpe en forme d'arborisations sur la partie corticale grise.","RedBig": "","RedSmall": ""},{"Texte": "Faire l'arbre fourchu, l'arbre droit, se tenir en équilibre sur la tête, les jambes en l'air écartées ou jointes.","RedBig": "","RedSmall": ""},{"Texte": "L'arbre cache la forêt, les détails empêchent d'appréhender l'ensemble.","RedBig": "","RedSmall": ""},{"Texte": "Monter, grimper à l'arbre, être la dupe d'une mystification, marcher ; se mettre en colère.","RedBig": "","RedSmall": "Familier."},{"Texte": "Arbre de roue, pièce transmettant le mouvement du différentiel à une roue motrice. Arbre primaire, secondaire, intermédiaire, pièces de la boîte de vitesses d'une automobile qui portent les différents engrenages.","RedBig": "Automobile","RedSmall": ""},{"Texte": "Arbre à pain, nom usuel de l'artocarpus. Arbre à perruque, nom usuel du fustet. Arbre de Judée, nom usuel du gainier. Arbre de soie, nom usuel du julibrissin. Arbre-poison, arbre de mort, noms usuels du mancenillier.","RedBig": "Botanique","RedSmall": ""},{"Texte": "Arbre creux, pièce de transmission cylindrique alésée, recevant le couple fourni par un moteur et transmettant ce couple à l'essieu moteur, dont l'axe, logé dans son alésage, peut subir par rapport à lui, de petits déplacements verticaux et horizontaux.","RedBig": "Chemin de fer","RedSmall": ""},{"Texte": "Arbre de décision, méthode d'analyse et d'élaboration du processus de prise de décisions multiples et séquentielles. (On s'attache à déterminer la meilleure décision terminale avant de déterminer la première.)","RedBig": "Économie","RedSmall": ""},{"Texte": "Arbre électrique, dispositif assurant la synchronisation permanente de deux ou de plusieurs arbres mécaniques.","RedBig": "Électricité","RedSmall": ""},{"Texte": "Arbres de la Liberté, arbres plantés au début de la Révolution de 1789 et au printemps de 1848 pour symboliser la liberté conquise.","RedBig": "Histoire","RedSmall": ""},{"Texte": "Test de l'arbre, test projectif dans lequel on demande au sujet de dessiner un arbre. (L'interprétation de ce test est fondée sur l'hypothèse que l'arbre est la représentation symbolique du corps vécu du dessinateur.)","RedBig": "Psychologie","RedSmall": ""},{"Texte": "Arbre de Jessé, arbre généalogique de Jésus, établi par l'iconographie chrétienne à partir des données bibliques. Arbre de la croix, la croix où Jésus-Christ fut attaché.","RedBig": "Religion","RedSmall": ""}],"Relations": null,"Homonymes": null,"Difficultes": null,"Citations": [{"ID": 5001147,"Auteur": "Jacques Bénigne Bossuet","InfoAuteur": "(Dijon 1627-Paris 1704)","Texte": "Est-ce là ce grand arbre qui portait son faîte jusqu'aux nues ? Il n'en reste plus qu'un tronc inutile. Est-ce là ce fleuve impétueux qui semblait devoir inonder toute la terre ? Je n'aperçois plus qu'un peu d'écume.","Info": "Sermon sur l'ambition"},{"ID": 5001548,"Auteur": "René Char","InfoAuteur": "(L'Isle-sur-la-Sorgue, Vaucluse, 1907-Paris 1988)","Texte": "Le fruit est aveugle. C'est l'arbre qui voit.","Info": "Feuillets d'Hypnos , Gallimard"},{"ID": 5001757,"Auteur": "Paul Claudel","InfoAuteur": "(Villeneuve-sur-Fère, Aisne, 1868-Paris 1955)","Texte": "L'arbre mort fait encore une bonne charpente.","Info": "L'Otage , II, 1, Sygne , Gallimard"},{"ID": 5002260,"Auteur": "René Descartes","InfoAuteur": "(La Haye, aujourd'hui Descartes, Indre-et-Loire, 1596-Stockholm 1650)","Texte": "Toute la philosophie est comme un arbre, dont les racines sont la métaphysique ; le tronc est la physique, et les branches qui sortent de ce tronc sont toutes les autres sciences, qui se réduisent à trois principales, à savoir la médecine, la mécanique et la morale ; j'entends la plus haute et la plus parfaite morale, qui présupposant une entière connaissance des autres sciences est le dernier degré de la sagesse.","Info": "Principes de la philosophie"},{"ID": 5002889,"Auteur": "Charles de Gaulle","InfoAuteur": "(Lille 1890-Colombey-les-Deux-Églises 1970)","Texte": "[…] Le vent redresse l'arbre après l'avoir penché.","Info": "Le Fil de l'épée , Plon"},{"ID": 5004553,"Auteur": "Pierre Louis , dit Pierre Louÿs","InfoAuteur": "(Gand 1870-Paris 1925)","Texte": "L'arbre est né pour se rompre et non pour se plier.","Info": "Poèmes , Crès"},{"ID": 5006156,"Auteur": "Jean Racine","InfoAuteur": "(La Ferté-Milon 1639-Paris 1699)","Texte": "Le ciel même peut-il réparer les ruines De cet arbre séché jusque dans ses racines !","Info": "Athalie , I, 1, Abner"},{"ID": 5006781,"Auteur": "Antoine de Saint-Exupéry","InfoAuteur": "(Lyon 1900-disparu en mission aérienne en 1944)","Texte": "Fruits et racines ont même commune mesure qui est l'arbre.","Info": "Citadelle , Gallimard"},{"ID": 5008305,"Auteur": "","InfoAuteur": "","Texte": "Déjà même la cognée se trouve à la racine des arbres ; tout arbre donc qui ne produit pas de bon fruit va être coupé et jeté au feu.","Info": "Évangile selon saint Luc , III, 9"},{"ID": 5008372,"Auteur": "","InfoAuteur": "","Texte": "La parole mauvaise est comme un arbre mauvais ; elle est à fleur de terre et n'a point de stabilité.","Info": "Coran , XIV, 31"},{"ID": 5009209,"Auteur": "Frédéric Mistral","InfoAuteur": "(Maillane, Bouches-du-Rhône, 1830-Maillane, Bouches-du-Rhône, 1914)","Texte": "Les arbres aux racines profondes sont ceux qui montent haut.","Info": "Les Îles d'or"},{"ID": 5009276,"Auteur": "Octavio Paz","InfoAuteur": "(Mexico 1914-Mexico 1998)","Texte": "L'arbre endormi profère des oracles verts.","Info": "Libertad bajo palabra , I, Condición de nube"}],"SeeAlso": ["https://larousse.fr/dictionnaires/francais/arbre-de-Noël/4975","https://larousse.fr/dictionnaires/francais/arbrier/4976","https://larousse.fr/dictionnaires/francais/arbrisseau/4977","https://larousse.fr/dictionnaires/francais/arbuste/4978","https://larousse.fr/dictionnaires/francais/arbustif/4979","https://larousse.fr/dictionnaires/francais/s_arboriser/4969","https://larousse.fr/dictionnaires/francais/arbouse/4970","https://larousse.fr/dictionnaires/francais/arbousier/4971","https://larousse.fr/dictionnaires/francais/arbovirose/4972","https://larousse.fr/dictionnaires/francais/arbovirus/4973"]}`
-		case "beau":         str = `{"PageID": 8514,"Header": {"Texte": "beau, belle, bel","Audio": "https://voix.larousse.fr/francais/65397fra2.mp3","Type": "adjectif"},"Definitions": [{"Texte": "Qui suscite un plaisir esthétique d'ordre visuel ou auditif : Une belle fleur. Ce piano a un beau son.","RedBig": "","RedSmall": ""},{"Texte": "Qui suscite un sentiment admiratif par sa supériorité intellectuelle, morale ou physique : Une belle démonstration. Il a eu un beau geste.","RedBig": "","RedSmall": ""},{"Texte": "Qui est agréable, qui cause du bien-être : Un bel après-midi. Faire un beau voyage.","RedBig": "","RedSmall": ""},{"Texte": "Qui convient bien, qui est satisfaisant : Nous sommes heureux de ce beau résultat.","RedBig": "","RedSmall": ""},{"Texte": "Qui est remarquable par sa grandeur, son importance : Il a amassé une belle fortune. C'est un beau dégoûtant !","RedBig": "","RedSmall": ""},{"Texte": "Qui est conforme à la bienséance : Ce n'est pas beau de se moquer des gens.","RedBig": "","RedSmall": "Familier."}],"Expressions": [{"Texte": "Au plus beau de quelque chose, au moment le plus important, le plus critique.","RedBig": "","RedSmall": ""},{"Texte": "Beau comme un dieu, comme l'amour ; belle comme un astre, comme le jour, d'une grande beauté.","RedBig": "","RedSmall": ""},{"Texte": "Ça, c'est le plus beau !, ça, c'est le comble !","RedBig": "","RedSmall": ""},{"Texte": "De belles paroles, de belles promesses, des paroles, des promesses qui ne sont pas dignes de foi.","RedBig": "","RedSmall": ""},{"Texte": "De la belle manière, de la belle façon, sans ménagement, de façon expéditive.","RedBig": "","RedSmall": ""},{"Texte": "De plus belle, de nouveau et avec une force accrue.","RedBig": "","RedSmall": ""},{"Texte": "En dire, en conter, en raconter, en apprendre, en entendre, en faire de belles, dire, raconter, apprendre, faire des choses qui attirent la réprobation, qui font scandale.","RedBig": "","RedSmall": "Familier."},{"Texte": "Il y a beau temps, il y a longtemps.","RedBig": "","RedSmall": "Littéraire."},{"Texte": "L'avoir belle de, être dans une situation favorable pour faire quelque chose.","RedBig": "","RedSmall": "Vieux."},{"Texte": "Le bel âge, les belles années, l'enfance, la jeunesse.","RedBig": "","RedSmall": ""},{"Texte": "Le plus beau, c'est que, ce qu'il y a de plus étonnant, c'est que.","RedBig": "","RedSmall": ""},{"Texte": "Les beaux jours, la belle saison, ceux qui suivent l'hiver.","RedBig": "","RedSmall": ""},{"Texte": "Me voilà beau, me voilà dans une situation délicate, difficile.","RedBig": "","RedSmall": ""},{"Texte": "Se faire beau (belle), se préparer, se parer, s'habiller avec soin.","RedBig": "","RedSmall": ""},{"Texte": "Un beau jour, un beau matin, un certain jour, un matin.","RedBig": "","RedSmall": ""},{"Texte": "Un bel âge, un âge avancé.","RedBig": "","RedSmall": ""}],"Relations": [{"Texte": "Qui suscite un plaisir esthétique d'ordre visuel ou auditif.","Synonymes": ["adorable","joli","plaisant","ravissant","séduisant","splendide","superbe"],"Contraires": ["abominable","affreux","chétif","difforme","disgracieux","hideux","inesthétique","informe","ingrat","laid","mal venu","malingre","mauvais","moche (familier)","vilain"]},{"Texte": "Qui suscite un sentiment admiratif par sa supériorité intellectuelle,...","Synonymes": ["admirable","bien","brillant","chic (familier)","digne","distingué","éblouissant","élégant","éminent","estimable","exquis","généreux","grand","haut","honorable","magnanime","noble","passionnant","profond","remarquable","sublime","supérieur"],"Contraires": ["abject","authentique","bas","commun","déplaisant","désagréable","détestable","effroyable","épouvantable","honteux","horrible","ignoble","infâme","médiocre","méprisable","minable","ordinaire","piètre","pitoyable","profond","quelconque","repoussant","sincère","vil","vrai","vulgaire"]},{"Texte": "Qui est agréable, qui cause du bien-être.","Synonymes": ["attrayant","charmant","délicieux","enchanteur","exquis","féerique","magnifique","merveilleux","radieux","riant"],"Contraires": ["abominable","affreux","déplaisant","désagréable","détestable","effroyable","épouvantable","horrible","mauvais","repoussant","vilain"]},{"Texte": "Qui convient bien, qui est satisfaisant.","Synonymes": ["adéquat","approprié","bon","heureux"],"Contraires": ["défavorable","défectueux","déplorable","désavantageux","fâcheux","malencontreux","malheureux","manqué","mauvais","médiocre","minable","piètre","pitoyable","raté"]},{"Texte": "Qui est remarquable par sa grandeur, son importance.","Synonymes": ["achevé","consommé","coquet","élevé","énorme","fabuleux","fameux","fieffé (familier)","florissant","fort","fructueux","gros","important","lucratif","magistral","prospère","sacré (familier)"],"Contraires": ["faible","humble","menu","mince","misérable","modeste","modique","pauvre","petit"]},{"Texte": "Qui est conforme à la bienséance.","Synonymes": ["bien","bien élevé","bienséant","civil","convenable","correct","décent","digne","élégant","estimable","généreux","honorable","poli"],"Contraires": ["abject","bas","choquant","déplacé","honteux","humble","ignoble","impoli","inconvenant","incorrect","infâme","malséant","méprisable","misérable","modeste","modique","pauvre","vil"]},{"Texte": "De belles paroles, de belles promesses","Synonymes": ["fallacieux","faux","mensonger","menteur","trompeur"],"Contraires": ["authentique","profond","sincère","vrai"]}],"Homonymes": [{"Texte": "bau","Type": "nom masculin"},{"Texte": "baud","Type": "nom masculin"},{"Texte": "baux","Type": "nom masculin pluriel"},{"Texte": "bêler","Type": "forme conjuguée du verbe bêler"},{"Texte": "bêler","Type": "forme conjuguée du verbe bêler"},{"Texte": "bêler","Type": "forme conjuguée du verbe bêler"},{"Texte": "bot","Type": "adjectif"},{"Texte": "bau","Type": "nom masculin"},{"Texte": "baud","Type": "nom masculin"}],"Difficultes": [{"Type": "ORTHOGRAPHE","Texte": "Beau / bel . Devant un nom masculin commençant par une voyelle ou un h muet, on emploie la forme bel : un bel enfant ; quel bel homme ! On peut aussi rencontrer, dans le registre soutenu, un bel et charmant enfant , mais un enfant beau et charmant est plus courant de nos jours. On trouve aussi la forme bel dans quelques expressions figées comme la locution adverbiale bel et bien : il a bel et bien disparu . remarque Les adjectifs fou / fol, mou / mol, nouveau / nouvel, vieux / vieil présentent une alternance de formes similaire."}],"Citations": [{"ID": 5001063,"Auteur": "Nicolas Boileau dit Boileau-Despréaux","InfoAuteur": "(Paris 1636-Paris 1711)","Texte": "Rien n'est beau que le vrai : le vrai seul est aimable.","Info": "Épîtres"},{"ID": 5002002,"Auteur": "Pierre Corneille","InfoAuteur": "(Rouen 1606-Paris 1684)","Texte": "Vous ne passerez pour belle Qu'autant que je l'aurai dit.","Info": "Poésies diverses , LVIII, Stances à Marquise Du Parc"},{"ID": 5005817,"Auteur": "Charles Perrault","InfoAuteur": "(Paris 1628-Paris 1703)","Texte": "Tout est beau dans ce que l'on aime. Tout ce qu'on aime a de l'esprit.","Info": "Riquet à la houppe , Moralité"},{"ID": 5006250,"Auteur": "Paul Raynal","InfoAuteur": "(Narbonne 1885-Paris 1971)","Texte": "Il ne suffit pas pour être belle, d'être belle.","Info": "Au soleil de l'instinct , Stock"},{"ID": 5009337,"Auteur": "John Ruskin","InfoAuteur": "(Londres 1819-Brantwood, Cumberland, 1900)","Texte": "Rappelez-vous que les plus belles choses de ce monde sont les plus inutiles : par exemple, les paons et les lys.","Info": "Les Pierres de Venise , I, 2"}],"SeeAlso": ["https://larousse.fr/dictionnaires/francais/beau/8515","https://larousse.fr/dictionnaires/francais/beau/8516","https://larousse.fr/dictionnaires/francais/beauceron/8520","https://larousse.fr/dictionnaires/francais/beauceron/8519","https://larousse.fr/dictionnaires/francais/beaucoup/8521","https://larousse.fr/dictionnaires/francais/béatifique/8509","https://larousse.fr/dictionnaires/francais/béatitude/8510","https://larousse.fr/dictionnaires/francais/béatitudes/8511","https://larousse.fr/dictionnaires/francais/beatnik/8512","https://larousse.fr/dictionnaires/francais/beatus/8513"]}`
-		case "couper":       str = `{"PageID": 19834,"Header": {"Texte": "couper","Audio": "https://voix.larousse.fr/francais/25806fra2.mp3","Type": "verbe transitif "},"Definitions": [{"Texte": "Entamer la matière de quelque chose, sectionner avec un objet ou un instrument tranchant : Couper la ficelle avec des ciseaux.","RedBig": "","RedSmall": ""},{"Texte": "Sans complément, trancher ou écorcher, blesser en faisant une coupure : Couteau qui coupe.","RedBig": "","RedSmall": ""},{"Texte": "Séparer quelque chose de ce à quoi il tenait, avec un instrument tranchant : Couper des fleurs avec un sécateur.","RedBig": "","RedSmall": ""},{"Texte": "Amputer quelqu'un d'un membre, lui sectionner une main, un doigt, un bras, etc.","RedBig": "","RedSmall": ""},{"Texte": "Enlever le bout, l'extrémité ou une partie, un morceau de quelque chose avec un instrument tranchant : Couper les cheveux. Je vous coupe une tranche de viande.","RedBig": "","RedSmall": ""},{"Texte": "Diviser quelque chose, le partager avec un instrument tranchant : Couper le gâteau en 6 parts.","RedBig": "","RedSmall": ""},{"Texte": "Entamer plus ou moins profondément les chairs, entamer la peau avec un objet tranchant, ou blesser avec quelque chose d'extrêmement serré : Les liens serrés lui coupaient les poignets.","RedBig": "","RedSmall": ""},{"Texte": "Abîmer une étoffe, le cuir en les fendillant : Mauvais cirage qui coupe le cuir.","RedBig": "","RedSmall": ""},{"Texte": "Causer une sensation analogue à celle provoquée par une coupure ; cingler : Le froid lui coupait le visage.","RedBig": "","RedSmall": ""},{"Texte": "Diviser un texte en plusieurs parties : Il a coupé son discours en trois parties.","RedBig": "","RedSmall": ""},{"Texte": "Enlever une partie dans un texte, un film, etc. : Couper une scène interminable.","RedBig": "","RedSmall": ""},{"Texte": "Diviser un lieu, le séparer en deux parties : Une cloison mobile permet de couper le living.","RedBig": "","RedSmall": ""},{"Texte": "Diviser profondément un groupe en deux parties antagonistes : Les élections vont couper la France en deux.","RedBig": "","RedSmall": ""},{"Texte": "Séparer quelqu'un, un groupe de quelque chose, d'un groupe, interdire toute communication entre eux ; isoler : L'ennemi avait réussi à couper l'armée de ses bases.","RedBig": "","RedSmall": ""},{"Texte": "Passer au travers d'une masse fluide : Le bateau coupait les vagues.","RedBig": "","RedSmall": ""},{"Texte": "Croiser une voie, une ligne en parlant d'une autre voie, d'une autre ligne : Carrefour où la nationale coupe la départementale.","RedBig": "","RedSmall": ""},{"Texte": "Interdire l'accès à une voie de communication, en barrer le passage : La neige avait coupé la route des cols.","RedBig": "","RedSmall": ""},{"Texte": "Se mettre, être en travers du chemin de quelqu'un, et l'empêcher de passer, de progresser : Le camion m'a coupé le passage.","RedBig": "","RedSmall": ""},{"Texte": "Marquer trop nettement une séparation, une division dans une forme, la silhouette de quelqu'un, ce qui lui enlève de son unité, de son harmonie : Les jupes coupent la silhouette.","RedBig": "","RedSmall": ""},{"Texte": "Interrompre la continuité d'une période, d'une activité par une ou plusieurs pauses : Un café à trois heures coupait l'après-midi.","RedBig": "","RedSmall": ""},{"Texte": "Interrompre un circuit, une communication, faire cesser l'arrivée, la production de quelque chose : Couper le gaz.","RedBig": "","RedSmall": ""},{"Texte": "Faire cesser une sensation, un phénomène physiologique, pathologique, les interrompre ou en perturber l'évolution : Un produit qui coupe la faim. Chaussettes trop serrées qui coupent la circulation.","RedBig": "","RedSmall": ""},{"Texte": "Mélanger à une boisson, à un liquide un autre liquide, en particulier de l'eau : Couper son vin.","RedBig": "","RedSmall": ""},{"Texte": "Tailler les différentes pièces d'un vêtement d'après un patron.","RedBig": "Couture","RedSmall": ""},{"Texte": "1. Pour un ensemble, avoir au moins un point d'intersection du premier ordre avec un autre ensemble. 2. Réaliser une intersection.","RedBig": "Géométrie","RedSmall": ""},{"Texte": "Séparer en deux paquets les cartes d'un jeu.","RedBig": "Jeux","RedSmall": ""},{"Texte": "Abattre du minerai ou du stérile à l'aide d'une machine.","RedBig": "Mines","RedSmall": ""},{"Texte": "1. Dépasser un concurrent en passant devant lui pour prendre la corde, l'empêcher de passer ou retarder son action. 2. Au tennis et au tennis de table, faire un coupé.","RedBig": "Sports","RedSmall": ""}],"Expressions": [{"Texte": "À couper au couteau, extrêmement épais, dense.","RedBig": "","RedSmall": "Familier."},{"Texte": "Ça vous la coupe !, ça vous étonne !","RedBig": "","RedSmall": "Populaire."},{"Texte": "Couper bras et jambes à quelqu'un, le frapper d'un telle stupeur qu'il est incapable de réagir.","RedBig": "","RedSmall": ""},{"Texte": "Couper la parole à quelqu'un, couper quelqu'un, l'interrompre, l'empêcher de continuer à parler.","RedBig": "","RedSmall": ""},{"Texte": "Couper le cou, la gorge à quelqu'un, l'égorger.","RedBig": "","RedSmall": ""},{"Texte": "Couper le mal à (par) la racine, le faire disparaître radicalement.","RedBig": "","RedSmall": ""},{"Texte": "Couper les bras, les jambes à quelqu'un, faire qu'il n'ait plus de force dans les bras, qu'il ne puisse plus marcher.","RedBig": "","RedSmall": ""},{"Texte": "Couper les cheveux en quatre, être trop pointilleux, aimer la difficulté, chicaner.","RedBig": "","RedSmall": ""},{"Texte": "Couper les crédits, les vivres à quelqu'un, cesser de l'aider financièrement.","RedBig": "","RedSmall": ""},{"Texte": "Couper le souffle à quelqu'un, le stupéfier.","RedBig": "","RedSmall": ""},{"Texte": "Couper ses effets à quelqu'un, l'empêcher d'obtenir l'effet de surprise ou d'admiration qu'il escomptait.","RedBig": "","RedSmall": ""},{"Texte": "Couper un animal, le châtrer.","RedBig": "","RedSmall": "Familier."},{"Texte": "Donner sa main, sa tête à couper, être sûr, affirmer avec conviction.","RedBig": "","RedSmall": ""},{"Texte": "Ne coupez pas !, n'interrompez pas la communication téléphonique.","RedBig": "","RedSmall": ""},{"Texte": "Couper (une carte), couper (à trèfle, cœur, pique, carreau), prendre avec un atout une carte de son adversaire.","RedBig": "Jeux","RedSmall": ""}],"Relations": [{"Texte": "Entamer la matière de quelque chose, sectionner avec un objet...","Synonymes": ["cisailler","découper","rogner","sectionner","tailler","trancher","tronçonner"],"Contraires": null},{"Texte": "Amputer quelqu'un d'un membre, lui sectionner une main, un doigt,...","Synonymes": ["mutiler"],"Contraires": null},{"Texte": "Enlever le bout, l'extrémité ou une partie, un morceau de quelque...","Synonymes": ["tailler"],"Contraires": null},{"Texte": "Diviser quelque chose, le partager avec un instrument tranchant.","Synonymes": ["découper"],"Contraires": null},{"Texte": "Entamer plus ou moins profondément les chairs, entamer la peau...","Synonymes": ["balafrer","cisailler","écorcher","entailler","entamer","inciser","taillader"],"Contraires": null},{"Texte": "Causer une sensation analogue à celle provoquée par une coupure ...","Synonymes": ["cingler"],"Contraires": null},{"Texte": "Diviser un texte en plusieurs parties.","Synonymes": ["cingler","fouetter"],"Contraires": null},{"Texte": "Enlever une partie dans un texte, un film, etc..","Synonymes": ["épurer","sabrer","supprimer","tronquer"],"Contraires": ["agrandir","allonger","prolonger"]},{"Texte": "Diviser un lieu, le séparer en deux parties.","Synonymes": ["fractionner","scinder","segmenter"],"Contraires": null},{"Texte": "Séparer quelqu'un, un groupe de quelque chose, d'un groupe,...","Synonymes": ["intercepter"],"Contraires": null},{"Texte": "Croiser une voie, une ligne en parlant d'une autre voie, d'une...","Synonymes": ["croiser","traverser"],"Contraires": null},{"Texte": "Interdire l'accès à une voie de communication, en barrer le...","Synonymes": ["barrer"],"Contraires": null},{"Texte": "Se mettre, être en travers du chemin de quelqu'un, et l'empêcher...","Synonymes": ["obstruer"],"Contraires": null},{"Texte": "Interrompre la continuité d'une période, d'une activité...","Synonymes": ["entrecouper"],"Contraires": null},{"Texte": "Interrompre un circuit, une communication, faire cesser l'arrivée,...","Synonymes": ["arrêter"],"Contraires": null},{"Texte": "Mélanger à une boisson, à un liquide un autre liquide, en particulier...","Synonymes": ["baptiser (familier)"],"Contraires": null},{"Texte": "Couper un animal","Synonymes": ["castrer","hongrer"],"Contraires": null}],"Homonymes": [{"Texte": "coupé","Type": "nom masculin"},{"Texte": "coupée","Type": "nom féminin"},{"Texte": "couperet","Type": "nom masculin"},{"Texte": "couperet","Type": "nom masculin"},{"Texte": "couperet","Type": "nom masculin"},{"Texte": "coupon","Type": "nom masculin"}],"Difficultes": [{"Type": "ACCORD","Texte": "Elle s'est coupée / elle s'est coupé le doigt ."},{"Type": "REGISTRE","Texte": "Couper quelqu'un = l'interrompre, lui couper la parole. Familier. Être coupé = être arrêté dans une communication téléphonique par l'interruption de la ligne. Je vous rappelle, nous avons été coupés . Familier. Se couper = se contredire, se trahir. Familier."}],"Citations": null,"SeeAlso": ["https://larousse.fr/dictionnaires/francais/couper/19836","https://larousse.fr/dictionnaires/francais/couper/19835","https://larousse.fr/dictionnaires/francais/se_couper/19839","https://larousse.fr/dictionnaires/francais/se_couper/19838","https://larousse.fr/dictionnaires/francais/coupe-racines/19840","https://larousse.fr/dictionnaires/francais/coupe-œuf/19829","https://larousse.fr/dictionnaires/francais/coupe-ongles/19830","https://larousse.fr/dictionnaires/francais/coupe-papier/19831","https://larousse.fr/dictionnaires/francais/coupe-pâte/19832","https://larousse.fr/dictionnaires/francais/coupe-queue/19833"]}`
-		case "vert":         str = `{"PageID": 81664,"Header": {"Texte": "vert, verte","Audio": "https://voix.larousse.fr/francais/64636fra2.mp3","Type": "adjectif"},"Definitions": [{"Texte": "Se dit de la couleur située entre le bleu et le jaune dans le spectre de la lumière blanche : Une grenouille verte.","RedBig": "","RedSmall": ""},{"Texte": "Qui est devenu livide, en particulier sous l'effet d'une maladie, d'une émotion : Être vert de peur.","RedBig": "","RedSmall": ""},{"Texte": "Qui est encore loin de la maturité : Du raisin vert.","RedBig": "","RedSmall": ""},{"Texte": "Se dit des végétaux qui ont encore de la sève, qui sont frais, qui ne sont pas secs : Bois vert.","RedBig": "","RedSmall": ""},{"Texte": "Qui est resté vigoureux et vif malgré l'âge avancé : Un homme vert pour son âge.","RedBig": "","RedSmall": ""},{"Texte": "Se dit de propos volontairement sévères, cinglants : Recevoir une verte réprimande.","RedBig": "","RedSmall": ""},{"Texte": "Qui a trait à l'agriculture, au monde rural : L'Europe verte dans la Communauté économique européenne.","RedBig": "","RedSmall": ""},{"Texte": "Qui a trait aux écologistes : Candidats verts.","RedBig": "","RedSmall": ""}],"Expressions": [{"Texte": "Avoir, donner le feu vert, avoir, donner l'autorisation d'entreprendre quelque chose.","RedBig": "","RedSmall": ""},{"Texte": "Le billet vert, le dollar.","RedBig": "","RedSmall": ""},{"Texte": "Vert galant, homme d'un certain âge encore alerte et entreprenant auprès des femmes.","RedBig": "","RedSmall": "Littéraire."},{"Texte": "Déchet vert, déchet produit par l’entretien des jardins, des espaces verts et des milieux naturels (herbe tondue, branchages, fleurs fanées, etc.).","RedBig": "","RedSmall": ""},{"Texte": "Emploi vert, métier du domaine de l’environnement.","RedBig": "","RedSmall": ""},{"Texte": "Voie verte, route réservée aux moyens de transport non motorisés (vélo, cheval, trottinette, etc.) ainsi qu’aux piétons.","RedBig": "","RedSmall": ""},{"Texte": "Révolution verte, adaptation et diffusion des techniques modernes dans les pays en voie de développement, notamment dans le domaine de la production de variétés de semences améliorées et de l'emploi d'engrais.","RedBig": "Agriculture","RedSmall": ""},{"Texte": "Huître verte, huître creuse ayant subi le verdissement.","RedBig": "Aquaculture","RedSmall": ""},{"Texte": "Végétal vert, plante verte, espèce végétale pourvue de chlorophylle.","RedBig": "Botanique","RedSmall": ""},{"Texte": "Cuir vert, peau, matière première de la tannerie.","RedBig": "Cuirs et peaux","RedSmall": ""},{"Texte": "Sauce verte, mayonnaise additionnée d'une purée d'herbes. Vert-pré, se dit d'une grillade accompagnée de cresson et d'un beurre manié au persil.","RedBig": "Cuisine","RedSmall": ""},{"Texte": "Taux vert, taux qui servait, dans la Communauté économique européenne, à exprimer la valeur d'un prix agricole communautaire exprimé en écus en l'une des monnaies nationales des pays de la Communauté.","RedBig": "Économie","RedSmall": ""},{"Texte": "Vin vert, vin au goût acide, dû au manque de maturité du raisin.","RedBig": "Œnologie","RedSmall": ""},{"Texte": "Morue verte, morue non desséchée, mais salée.","RedBig": "Pêche","RedSmall": ""},{"Texte": "Numéro vert, numéro téléphonique qui permet d'appeler gratuitement une entreprise, celle-ci prenant à sa charge le coût de la communication.","RedBig": "Télécommunicatons","RedSmall": ""},{"Texte": "Station verte, commune rurale homologuée pour favoriser le tourisme en zone rurale.","RedBig": "Tourisme","RedSmall": ""},{"Texte": "Feu vert, feu de signalisation routière lumineux indiquant le passage libre.","RedBig": "Transports","RedSmall": ""}],"Relations": [{"Texte": "Qui est encore loin de la maturité.","Synonymes": null,"Contraires": ["mûr"]},{"Texte": "Se dit des végétaux qui ont encore de la sève, qui sont frais,...","Synonymes": null,"Contraires": ["sec"]},{"Texte": "Se dit de propos volontairement sévères, cinglants.","Synonymes": ["acerbe","brutal","mordant","sec","vif","violent"],"Contraires": null}],"Homonymes": [{"Texte": "vair","Type": "nom masculin"},{"Texte": "ver","Type": "nom masculin"},{"Texte": "verre","Type": "nom masculin"},{"Texte": "vers","Type": "nom masculin"},{"Texte": "vers","Type": "préposition"}],"Difficultes": [{"Type": "ORTHOGRAPHE","Texte": "Des feuilles vertes , mais des yeux vert émeraude, des tissus vert foncé, vert pomme, vert bouteille, vert-jaune ."}],"Citations": [{"ID": 5004046,"Auteur": "Jean de La Fontaine","InfoAuteur": "(Château-Thierry 1621-Paris 1695)","Texte": "Ils sont trop verts, dit-il, et bons pour des goujats.","Info": "Fables , le Renard et les Raisins"}],"SeeAlso": ["https://larousse.fr/dictionnaires/francais/vert/81665","https://larousse.fr/dictionnaires/francais/vert-de-gris/81667","https://larousse.fr/dictionnaires/francais/se_vert-de-griser/81668","https://larousse.fr/dictionnaires/francais/verte/81666","https://larousse.fr/dictionnaires/francais/vertébral/81669","https://larousse.fr/dictionnaires/francais/vers-libriste/81659","https://larousse.fr/dictionnaires/francais/verso/81660","https://larousse.fr/dictionnaires/francais/versoir/81661","https://larousse.fr/dictionnaires/francais/verste/81662","https://larousse.fr/dictionnaires/francais/versus/81663"]}`
+		case "beau":         str = `{"PageID": 8514,"Header": {"Texte": "beau, belle, bel","Audio": "https://voix.larousse.fr/francais/65397fra2.mp3","Type": "adjectif"},"Definitions": [{"Texte": "Qui suscite un plaisir esthétique d'ordre visuel ou auditif : Une belle fleur. Ce piano a un beau son.","RedBig": "","RedSmall": ""},{"Texte": "Qui suscite un sentiment admiratif par sa supériorité intellectuelle, morale ou physique : Une belle démonstration. Il a eu un beau geste.","RedBig": "","RedSmall": ""},{"Texte": "Qui est agréable, qui cause du bien-être : Un bel après-midi. Faire un beau voyage.","RedBig": "","RedSmall": ""},{"Texte": "Qui convient bien, qui est satisfaisant : Nous sommes heureux de ce beau résultat.","RedBig": "","RedSmall": ""},{"Texte": "Qui est remarquable par sa grandeur, son importance : Il a amassé une belle fortune. C'est un beau dégoûtant !","RedBig": "","RedSmall": ""},{"Texte": "Qui est conforme à la bienséance : Ce n'est pas beau de se moquer des gens.","RedBig": "","RedSmall": "Familier."}],"Expressions": [{"Texte": "Au plus beau de quelque chose, au moment le plus important, le plus critique.","RedBig": "","RedSmall": ""},{"Texte": "Beau comme un dieu, comme l'amour ; belle comme un astre, comme le jour, d'une grande beauté.","RedBig": "","RedSmall": ""},{"Texte": "Ça, c'est le plus beau !, ça, c'est le comble !","RedBig": "","RedSmall": ""},{"Texte": "De belles paroles, de belles promesses, des paroles, des promesses qui ne sont pas dignes de foi.","RedBig": "","RedSmall": ""},{"Texte": "De la belle manière, de la belle façon, sans ménagement, de façon expéditive.","RedBig": "","RedSmall": ""},{"Texte": "De plus belle, de nouveau et avec une force accrue.","RedBig": "","RedSmall": ""},{"Texte": "En dire, en conter, en raconter, en apprendre, en entendre, en faire de belles, dire, raconter, apprendre, faire des choses qui attirent la réprobation, qui font scandale.","RedBig": "","RedSmall": "Familier."},{"Texte": "Il y a beau temps, il y a longtemps.","RedBig": "","RedSmall": "Littéraire."},{"Texte": "L'avoir belle de, être dans une situation favorable pour faire quelque chose.","RedBig": "","RedSmall": "Vieux."},{"Texte": "Le bel âge, les belles années, l'enfance, la jeunesse.","RedBig": "","RedSmall": ""},{"Texte": "Le plus beau, c'est que, ce qu'il y a de plus étonnant, c'est que.","RedBig": "","RedSmall": ""},{"Texte": "Les beaux jours, la belle saison, ceux qui suivent l'hiver.","RedBig": "","RedSmall": ""},{"Texte": "Me voilà beau, me voilà dans une situation délicate, difficile.","RedBig": "","RedSmall": ""},{"Texte": "Se faire beau (belle), se préparer, se parer, s'habiller avec soin.","RedBig": "","RedSmall": ""},{"Texte": "Un beau jour, un beau matin, un certain jour, un matin.","RedBig": "","RedSmall": ""},{"Texte": "Un bel âge, un âge avancé.","RedBig": "","RedSmall": ""}],"Relations": [{"Texte": "Qui suscite un plaisir esthétique d'ordre visuel ou auditif","Synonymes": ["adorable","joli","plaisant","ravissant","séduisant","splendide","superbe"],"Contraires": ["abominable","affreux","chétif","difforme","disgracieux","hideux","inesthétique","informe","ingrat","laid","mal venu","malingre","mauvais","moche (familier)","vilain"]},{"Texte": "Qui suscite un sentiment admiratif par sa supériorité intellectuelle","Synonymes": ["admirable","bien","brillant","chic (familier)","digne","distingué","éblouissant","élégant","éminent","estimable","exquis","généreux","grand","haut","honorable","magnanime","noble","passionnant","profond","remarquable","sublime","supérieur"],"Contraires": ["abject","authentique","bas","commun","déplaisant","désagréable","détestable","effroyable","épouvantable","honteux","horrible","ignoble","infâme","médiocre","méprisable","minable","ordinaire","piètre","pitoyable","profond","quelconque","repoussant","sincère","vil","vrai","vulgaire"]},{"Texte": "Qui est agréable, qui cause du bien-être","Synonymes": ["attrayant","charmant","délicieux","enchanteur","exquis","féerique","magnifique","merveilleux","radieux","riant"],"Contraires": ["abominable","affreux","déplaisant","désagréable","détestable","effroyable","épouvantable","horrible","mauvais","repoussant","vilain"]},{"Texte": "Qui convient bien, qui est satisfaisant","Synonymes": ["adéquat","approprié","bon","heureux"],"Contraires": ["défavorable","défectueux","déplorable","désavantageux","fâcheux","malencontreux","malheureux","manqué","mauvais","médiocre","minable","piètre","pitoyable","raté"]},{"Texte": "Qui est remarquable par sa grandeur, son importance","Synonymes": ["achevé","consommé","coquet","élevé","énorme","fabuleux","fameux","fieffé (familier)","florissant","fort","fructueux","gros","important","lucratif","magistral","prospère","sacré (familier)"],"Contraires": ["faible","humble","menu","mince","misérable","modeste","modique","pauvre","petit"]},{"Texte": "Qui est conforme à la bienséance","Synonymes": ["bien","bien élevé","bienséant","civil","convenable","correct","décent","digne","élégant","estimable","généreux","honorable","poli"],"Contraires": ["abject","bas","choquant","déplacé","honteux","humble","ignoble","impoli","inconvenant","incorrect","infâme","malséant","méprisable","misérable","modeste","modique","pauvre","vil"]},{"Texte": "De belles paroles, de belles promesses","Synonymes": ["fallacieux","faux","mensonger","menteur","trompeur"],"Contraires": ["authentique","profond","sincère","vrai"]}],"Homonymes": [{"Texte": "bau","Type": "nom masculin"},{"Texte": "baud","Type": "nom masculin"},{"Texte": "baux","Type": "nom masculin pluriel"},{"Texte": "bêler","Type": "forme conjuguée du verbe bêler"},{"Texte": "bêler","Type": "forme conjuguée du verbe bêler"},{"Texte": "bêler","Type": "forme conjuguée du verbe bêler"},{"Texte": "bot","Type": "adjectif"},{"Texte": "bau","Type": "nom masculin"},{"Texte": "baud","Type": "nom masculin"}],"Difficultes": [{"Type": "ORTHOGRAPHE","Texte": "Beau / bel . Devant un nom masculin commençant par une voyelle ou un h muet, on emploie la forme bel : un bel enfant ; quel bel homme ! On peut aussi rencontrer, dans le registre soutenu, un bel et charmant enfant , mais un enfant beau et charmant est plus courant de nos jours. On trouve aussi la forme bel dans quelques expressions figées comme la locution adverbiale bel et bien : il a bel et bien disparu . remarque Les adjectifs fou / fol, mou / mol, nouveau / nouvel, vieux / vieil présentent une alternance de formes similaire."}],"Citations": [{"ID": 5001063,"Auteur": "Nicolas Boileau dit Boileau-Despréaux","InfoAuteur": "(Paris 1636-Paris 1711)","Texte": "Rien n'est beau que le vrai : le vrai seul est aimable.","Info": "Épîtres"},{"ID": 5002002,"Auteur": "Pierre Corneille","InfoAuteur": "(Rouen 1606-Paris 1684)","Texte": "Vous ne passerez pour belle Qu'autant que je l'aurai dit.","Info": "Poésies diverses , LVIII, Stances à Marquise Du Parc"},{"ID": 5005817,"Auteur": "Charles Perrault","InfoAuteur": "(Paris 1628-Paris 1703)","Texte": "Tout est beau dans ce que l'on aime. Tout ce qu'on aime a de l'esprit.","Info": "Riquet à la houppe , Moralité"},{"ID": 5006250,"Auteur": "Paul Raynal","InfoAuteur": "(Narbonne 1885-Paris 1971)","Texte": "Il ne suffit pas pour être belle, d'être belle.","Info": "Au soleil de l'instinct , Stock"},{"ID": 5009337,"Auteur": "John Ruskin","InfoAuteur": "(Londres 1819-Brantwood, Cumberland, 1900)","Texte": "Rappelez-vous que les plus belles choses de ce monde sont les plus inutiles : par exemple, les paons et les lys.","Info": "Les Pierres de Venise , I, 2"}],"SeeAlso": ["https://larousse.fr/dictionnaires/francais/beau/8515","https://larousse.fr/dictionnaires/francais/beau/8516","https://larousse.fr/dictionnaires/francais/beauceron/8520","https://larousse.fr/dictionnaires/francais/beauceron/8519","https://larousse.fr/dictionnaires/francais/beaucoup/8521","https://larousse.fr/dictionnaires/francais/béatifique/8509","https://larousse.fr/dictionnaires/francais/béatitude/8510","https://larousse.fr/dictionnaires/francais/béatitudes/8511","https://larousse.fr/dictionnaires/francais/beatnik/8512","https://larousse.fr/dictionnaires/francais/beatus/8513"]}`
+		case "couper":       str = `{"PageID": 19834,"Header": {"Texte": "couper","Audio": "https://voix.larousse.fr/francais/25806fra2.mp3","Type": "verbe transitif "},"Definitions": [{"Texte": "Entamer la matière de quelque chose, sectionner avec un objet ou un instrument tranchant : Couper la ficelle avec des ciseaux.","RedBig": "","RedSmall": ""},{"Texte": "Sans complément, trancher ou écorcher, blesser en faisant une coupure : Couteau qui coupe.","RedBig": "","RedSmall": ""},{"Texte": "Séparer quelque chose de ce à quoi il tenait, avec un instrument tranchant : Couper des fleurs avec un sécateur.","RedBig": "","RedSmall": ""},{"Texte": "Amputer quelqu'un d'un membre, lui sectionner une main, un doigt, un bras, etc.","RedBig": "","RedSmall": ""},{"Texte": "Enlever le bout, l'extrémité ou une partie, un morceau de quelque chose avec un instrument tranchant : Couper les cheveux. Je vous coupe une tranche de viande.","RedBig": "","RedSmall": ""},{"Texte": "Diviser quelque chose, le partager avec un instrument tranchant : Couper le gâteau en 6 parts.","RedBig": "","RedSmall": ""},{"Texte": "Entamer plus ou moins profondément les chairs, entamer la peau avec un objet tranchant, ou blesser avec quelque chose d'extrêmement serré : Les liens serrés lui coupaient les poignets.","RedBig": "","RedSmall": ""},{"Texte": "Abîmer une étoffe, le cuir en les fendillant : Mauvais cirage qui coupe le cuir.","RedBig": "","RedSmall": ""},{"Texte": "Causer une sensation analogue à celle provoquée par une coupure ; cingler : Le froid lui coupait le visage.","RedBig": "","RedSmall": ""},{"Texte": "Diviser un texte en plusieurs parties : Il a coupé son discours en trois parties.","RedBig": "","RedSmall": ""},{"Texte": "Enlever une partie dans un texte, un film, etc. : Couper une scène interminable.","RedBig": "","RedSmall": ""},{"Texte": "Diviser un lieu, le séparer en deux parties : Une cloison mobile permet de couper le living.","RedBig": "","RedSmall": ""},{"Texte": "Diviser profondément un groupe en deux parties antagonistes : Les élections vont couper la France en deux.","RedBig": "","RedSmall": ""},{"Texte": "Séparer quelqu'un, un groupe de quelque chose, d'un groupe, interdire toute communication entre eux ; isoler : L'ennemi avait réussi à couper l'armée de ses bases.","RedBig": "","RedSmall": ""},{"Texte": "Passer au travers d'une masse fluide : Le bateau coupait les vagues.","RedBig": "","RedSmall": ""},{"Texte": "Croiser une voie, une ligne en parlant d'une autre voie, d'une autre ligne : Carrefour où la nationale coupe la départementale.","RedBig": "","RedSmall": ""},{"Texte": "Interdire l'accès à une voie de communication, en barrer le passage : La neige avait coupé la route des cols.","RedBig": "","RedSmall": ""},{"Texte": "Se mettre, être en travers du chemin de quelqu'un, et l'empêcher de passer, de progresser : Le camion m'a coupé le passage.","RedBig": "","RedSmall": ""},{"Texte": "Marquer trop nettement une séparation, une division dans une forme, la silhouette de quelqu'un, ce qui lui enlève de son unité, de son harmonie : Les jupes coupent la silhouette.","RedBig": "","RedSmall": ""},{"Texte": "Interrompre la continuité d'une période, d'une activité par une ou plusieurs pauses : Un café à trois heures coupait l'après-midi.","RedBig": "","RedSmall": ""},{"Texte": "Interrompre un circuit, une communication, faire cesser l'arrivée, la production de quelque chose : Couper le gaz.","RedBig": "","RedSmall": ""},{"Texte": "Faire cesser une sensation, un phénomène physiologique, pathologique, les interrompre ou en perturber l'évolution : Un produit qui coupe la faim. Chaussettes trop serrées qui coupent la circulation.","RedBig": "","RedSmall": ""},{"Texte": "Mélanger à une boisson, à un liquide un autre liquide, en particulier de l'eau : Couper son vin.","RedBig": "","RedSmall": ""},{"Texte": "Tailler les différentes pièces d'un vêtement d'après un patron.","RedBig": "Couture","RedSmall": ""},{"Texte": "1. Pour un ensemble, avoir au moins un point d'intersection du premier ordre avec un autre ensemble. 2. Réaliser une intersection.","RedBig": "Géométrie","RedSmall": ""},{"Texte": "Séparer en deux paquets les cartes d'un jeu.","RedBig": "Jeux","RedSmall": ""},{"Texte": "Abattre du minerai ou du stérile à l'aide d'une machine.","RedBig": "Mines","RedSmall": ""},{"Texte": "1. Dépasser un concurrent en passant devant lui pour prendre la corde, l'empêcher de passer ou retarder son action. 2. Au tennis et au tennis de table, faire un coupé.","RedBig": "Sports","RedSmall": ""}],"Expressions": [{"Texte": "À couper au couteau, extrêmement épais, dense.","RedBig": "","RedSmall": "Familier."},{"Texte": "Ça vous la coupe !, ça vous étonne !","RedBig": "","RedSmall": "Populaire."},{"Texte": "Couper bras et jambes à quelqu'un, le frapper d'un telle stupeur qu'il est incapable de réagir.","RedBig": "","RedSmall": ""},{"Texte": "Couper la parole à quelqu'un, couper quelqu'un, l'interrompre, l'empêcher de continuer à parler.","RedBig": "","RedSmall": ""},{"Texte": "Couper le cou, la gorge à quelqu'un, l'égorger.","RedBig": "","RedSmall": ""},{"Texte": "Couper le mal à (par) la racine, le faire disparaître radicalement.","RedBig": "","RedSmall": ""},{"Texte": "Couper les bras, les jambes à quelqu'un, faire qu'il n'ait plus de force dans les bras, qu'il ne puisse plus marcher.","RedBig": "","RedSmall": ""},{"Texte": "Couper les cheveux en quatre, être trop pointilleux, aimer la difficulté, chicaner.","RedBig": "","RedSmall": ""},{"Texte": "Couper les crédits, les vivres à quelqu'un, cesser de l'aider financièrement.","RedBig": "","RedSmall": ""},{"Texte": "Couper le souffle à quelqu'un, le stupéfier.","RedBig": "","RedSmall": ""},{"Texte": "Couper ses effets à quelqu'un, l'empêcher d'obtenir l'effet de surprise ou d'admiration qu'il escomptait.","RedBig": "","RedSmall": ""},{"Texte": "Couper un animal, le châtrer.","RedBig": "","RedSmall": "Familier."},{"Texte": "Donner sa main, sa tête à couper, être sûr, affirmer avec conviction.","RedBig": "","RedSmall": ""},{"Texte": "Ne coupez pas !, n'interrompez pas la communication téléphonique.","RedBig": "","RedSmall": ""},{"Texte": "Couper (une carte), couper (à trèfle, cœur, pique, carreau), prendre avec un atout une carte de son adversaire.","RedBig": "Jeux","RedSmall": ""}],"Relations": [{"Texte": "Entamer la matière de quelque chose, sectionner avec un objet","Synonymes": ["cisailler","découper","rogner","sectionner","tailler","trancher","tronçonner"],"Contraires": null},{"Texte": "Amputer quelqu'un d'un membre, lui sectionner une main, un doigt","Synonymes": ["mutiler"],"Contraires": null},{"Texte": "Enlever le bout, l'extrémité ou une partie, un morceau de quelque","Synonymes": ["tailler"],"Contraires": null},{"Texte": "Diviser quelque chose, le partager avec un instrument tranchant","Synonymes": ["découper"],"Contraires": null},{"Texte": "Entamer plus ou moins profondément les chairs, entamer la peau","Synonymes": ["balafrer","cisailler","écorcher","entailler","entamer","inciser","taillader"],"Contraires": null},{"Texte": "Causer une sensation analogue à celle provoquée par une coupure ","Synonymes": ["cingler"],"Contraires": null},{"Texte": "Diviser un texte en plusieurs parties","Synonymes": ["cingler","fouetter"],"Contraires": null},{"Texte": "Enlever une partie dans un texte, un film, etc","Synonymes": ["épurer","sabrer","supprimer","tronquer"],"Contraires": ["agrandir","allonger","prolonger"]},{"Texte": "Diviser un lieu, le séparer en deux parties","Synonymes": ["fractionner","scinder","segmenter"],"Contraires": null},{"Texte": "Séparer quelqu'un, un groupe de quelque chose, d'un groupe","Synonymes": ["intercepter"],"Contraires": null},{"Texte": "Croiser une voie, une ligne en parlant d'une autre voie, d'une","Synonymes": ["croiser","traverser"],"Contraires": null},{"Texte": "Interdire l'accès à une voie de communication, en barrer le","Synonymes": ["barrer"],"Contraires": null},{"Texte": "Se mettre, être en travers du chemin de quelqu'un, et l'empêcher","Synonymes": ["obstruer"],"Contraires": null},{"Texte": "Interrompre la continuité d'une période, d'une activité","Synonymes": ["entrecouper"],"Contraires": null},{"Texte": "Interrompre un circuit, une communication, faire cesser l'arrivée","Synonymes": ["arrêter"],"Contraires": null},{"Texte": "Mélanger à une boisson, à un liquide un autre liquide, en particulier","Synonymes": ["baptiser (familier)"],"Contraires": null},{"Texte": "Couper un animal","Synonymes": ["castrer","hongrer"],"Contraires": null}],"Homonymes": [{"Texte": "coupé","Type": "nom masculin"},{"Texte": "coupée","Type": "nom féminin"},{"Texte": "couperet","Type": "nom masculin"},{"Texte": "couperet","Type": "nom masculin"},{"Texte": "couperet","Type": "nom masculin"},{"Texte": "coupon","Type": "nom masculin"}],"Difficultes": [{"Type": "ACCORD","Texte": "Elle s'est coupée / elle s'est coupé le doigt ."},{"Type": "REGISTRE","Texte": "Couper quelqu'un = l'interrompre, lui couper la parole. Familier. Être coupé = être arrêté dans une communication téléphonique par l'interruption de la ligne. Je vous rappelle, nous avons été coupés . Familier. Se couper = se contredire, se trahir. Familier."}],"Citations": null,"SeeAlso": ["https://larousse.fr/dictionnaires/francais/couper/19836","https://larousse.fr/dictionnaires/francais/couper/19835","https://larousse.fr/dictionnaires/francais/se_couper/19839","https://larousse.fr/dictionnaires/francais/se_couper/19838","https://larousse.fr/dictionnaires/francais/coupe-racines/19840","https://larousse.fr/dictionnaires/francais/coupe-œuf/19829","https://larousse.fr/dictionnaires/francais/coupe-ongles/19830","https://larousse.fr/dictionnaires/francais/coupe-papier/19831","https://larousse.fr/dictionnaires/francais/coupe-pâte/19832","https://larousse.fr/dictionnaires/francais/coupe-queue/19833"]}`
+		case "vert":         str = `{"PageID": 81664,"Header": {"Texte": "vert, verte","Audio": "https://voix.larousse.fr/francais/64636fra2.mp3","Type": "adjectif"},"Definitions": [{"Texte": "Se dit de la couleur située entre le bleu et le jaune dans le spectre de la lumière blanche : Une grenouille verte.","RedBig": "","RedSmall": ""},{"Texte": "Qui est devenu livide, en particulier sous l'effet d'une maladie, d'une émotion : Être vert de peur.","RedBig": "","RedSmall": ""},{"Texte": "Qui est encore loin de la maturité : Du raisin vert.","RedBig": "","RedSmall": ""},{"Texte": "Se dit des végétaux qui ont encore de la sève, qui sont frais, qui ne sont pas secs : Bois vert.","RedBig": "","RedSmall": ""},{"Texte": "Qui est resté vigoureux et vif malgré l'âge avancé : Un homme vert pour son âge.","RedBig": "","RedSmall": ""},{"Texte": "Se dit de propos volontairement sévères, cinglants : Recevoir une verte réprimande.","RedBig": "","RedSmall": ""},{"Texte": "Qui a trait à l'agriculture, au monde rural : L'Europe verte dans la Communauté économique européenne.","RedBig": "","RedSmall": ""},{"Texte": "Qui a trait aux écologistes : Candidats verts.","RedBig": "","RedSmall": ""}],"Expressions": [{"Texte": "Avoir, donner le feu vert, avoir, donner l'autorisation d'entreprendre quelque chose.","RedBig": "","RedSmall": ""},{"Texte": "Le billet vert, le dollar.","RedBig": "","RedSmall": ""},{"Texte": "Vert galant, homme d'un certain âge encore alerte et entreprenant auprès des femmes.","RedBig": "","RedSmall": "Littéraire."},{"Texte": "Déchet vert, déchet produit par l’entretien des jardins, des espaces verts et des milieux naturels (herbe tondue, branchages, fleurs fanées, etc.).","RedBig": "","RedSmall": ""},{"Texte": "Emploi vert, métier du domaine de l’environnement.","RedBig": "","RedSmall": ""},{"Texte": "Voie verte, route réservée aux moyens de transport non motorisés (vélo, cheval, trottinette, etc.) ainsi qu’aux piétons.","RedBig": "","RedSmall": ""},{"Texte": "Révolution verte, adaptation et diffusion des techniques modernes dans les pays en voie de développement, notamment dans le domaine de la production de variétés de semences améliorées et de l'emploi d'engrais.","RedBig": "Agriculture","RedSmall": ""},{"Texte": "Huître verte, huître creuse ayant subi le verdissement.","RedBig": "Aquaculture","RedSmall": ""},{"Texte": "Végétal vert, plante verte, espèce végétale pourvue de chlorophylle.","RedBig": "Botanique","RedSmall": ""},{"Texte": "Cuir vert, peau, matière première de la tannerie.","RedBig": "Cuirs et peaux","RedSmall": ""},{"Texte": "Sauce verte, mayonnaise additionnée d'une purée d'herbes. Vert-pré, se dit d'une grillade accompagnée de cresson et d'un beurre manié au persil.","RedBig": "Cuisine","RedSmall": ""},{"Texte": "Taux vert, taux qui servait, dans la Communauté économique européenne, à exprimer la valeur d'un prix agricole communautaire exprimé en écus en l'une des monnaies nationales des pays de la Communauté.","RedBig": "Économie","RedSmall": ""},{"Texte": "Vin vert, vin au goût acide, dû au manque de maturité du raisin.","RedBig": "Œnologie","RedSmall": ""},{"Texte": "Morue verte, morue non desséchée, mais salée.","RedBig": "Pêche","RedSmall": ""},{"Texte": "Numéro vert, numéro téléphonique qui permet d'appeler gratuitement une entreprise, celle-ci prenant à sa charge le coût de la communication.","RedBig": "Télécommunicatons","RedSmall": ""},{"Texte": "Station verte, commune rurale homologuée pour favoriser le tourisme en zone rurale.","RedBig": "Tourisme","RedSmall": ""},{"Texte": "Feu vert, feu de signalisation routière lumineux indiquant le passage libre.","RedBig": "Transports","RedSmall": ""}],"Relations": [{"Texte": "Qui est encore loin de la maturité","Synonymes": null,"Contraires": ["mûr"]},{"Texte": "Se dit des végétaux qui ont encore de la sève, qui sont frais","Synonymes": null,"Contraires": ["sec"]},{"Texte": "Se dit de propos volontairement sévères, cinglants","Synonymes": ["acerbe","brutal","mordant","sec","vif","violent"],"Contraires": null}],"Homonymes": [{"Texte": "vair","Type": "nom masculin"},{"Texte": "ver","Type": "nom masculin"},{"Texte": "verre","Type": "nom masculin"},{"Texte": "vers","Type": "nom masculin"},{"Texte": "vers","Type": "préposition"}],"Difficultes": [{"Type": "ORTHOGRAPHE","Texte": "Des feuilles vertes , mais des yeux vert émeraude, des tissus vert foncé, vert pomme, vert bouteille, vert-jaune ."}],"Citations": [{"ID": 5004046,"Auteur": "Jean de La Fontaine","InfoAuteur": "(Château-Thierry 1621-Paris 1695)","Texte": "Ils sont trop verts, dit-il, et bons pour des goujats.","Info": "Fables , le Renard et les Raisins"}],"SeeAlso": ["https://larousse.fr/dictionnaires/francais/vert/81665","https://larousse.fr/dictionnaires/francais/vert-de-gris/81667","https://larousse.fr/dictionnaires/francais/se_vert-de-griser/81668","https://larousse.fr/dictionnaires/francais/verte/81666","https://larousse.fr/dictionnaires/francais/vertébral/81669","https://larousse.fr/dictionnaires/francais/vers-libriste/81659","https://larousse.fr/dictionnaires/francais/verso/81660","https://larousse.fr/dictionnaires/francais/versoir/81661","https://larousse.fr/dictionnaires/francais/verste/81662","https://larousse.fr/dictionnaires/francais/versus/81663"]}`
 		case "informatique": str = `{"PageID": 42996,"Header": {"Texte": "informatique","Audio": "https://voix.larousse.fr/francais/33876fra2.mp3","Type": "nom féminin"},"Definitions": [{"Texte": "Science du traitement automatique et rationnel de l'information considérée comme le support des connaissances et des communications.","RedBig": "","RedSmall": ""},{"Texte": "Ensemble des applications de cette science, mettant en œuvre des matériels (ordinateurs) et des logiciels.","RedBig": "","RedSmall": ""}],"Expressions": [{"Texte": "Informatique en nuage, → nuage.","RedBig": "","RedSmall": ""}],"Relations": null,"Homonymes": null,"Difficultes": null,"Citations": null,"SeeAlso": ["https://larousse.fr/dictionnaires/francais/informatique/42997","https://larousse.fr/dictionnaires/francais/informatiquement/42998","https://larousse.fr/dictionnaires/francais/informatisable/42999","https://larousse.fr/dictionnaires/francais/informatisation/43000","https://larousse.fr/dictionnaires/francais/informatiser/43001","https://larousse.fr/dictionnaires/francais/informaticien/42991","https://larousse.fr/dictionnaires/francais/informatif/42992","https://larousse.fr/dictionnaires/francais/information/42993","https://larousse.fr/dictionnaires/francais/informationnel/42995","https://larousse.fr/dictionnaires/francais/informations/42994"]}`
 	}
 	
@@ -94,3 +732,426 @@ func getCorrectResult(word string) (Result, error) {
 	return res, err
 }
 
+
+// TestExamples tests that Result.Examples returns the example phrases of both
+// definitions and expressions, without the text around them.
+func TestExamples(t *testing.T) {
+	for _, c := range []struct{
+		in   string
+		want []string
+	}{
+		{"testdata/arbre.html", []string{"Arbre généalogique."}},
+		{"testdata/main.html", []string{"Il a la main heureuse au jeu."}},
+		{"testdata/manger.html", nil},
+	} {
+		res, err := NewFromFileOrURL(c.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := res.Examples()
+		if strings.Join(got, "|") != strings.Join(c.want, "|") {
+			t.Fatalf("%s: want %q, got %q", c.in, c.want, got)
+		}
+	}
+}
+
+// TestIsVerb tests Result.IsVerb and Header.InfinitiveURL on a verb and a
+// non-verb.
+func TestIsVerb(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/manger.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.IsVerb() {
+		t.Fatalf("manger: want IsVerb, Type is %q", res.Header.Type)
+	}
+	if res.Header.Type != "verbe transitif " {
+		t.Fatalf("Type: want \"verbe transitif \", got %q", res.Header.Type)
+	}
+	want := "https://larousse.fr/conjugaison/francais/manger/6184"
+	if res.Header.InfinitiveURL != want {
+		t.Fatalf("InfinitiveURL: want %s, got %s", want, res.Header.InfinitiveURL)
+	}
+	
+	res, err = NewFromFileOrURL("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.IsVerb() || res.Header.InfinitiveURL != "" {
+		t.Fatalf("arbre: want non-verb, got %+v", res.Header)
+	}
+}
+
+// TestFormPhonetics tests that Header.Phonetic is scraped and that
+// Header.FormPhonetics maps its pronunciations to the forms in Texte.
+func TestFormPhonetics(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/vert.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Header.Phonetic != "[vεr, vεrt]" {
+		t.Fatalf("Phonetic: want [vεr, vεrt], got %q", res.Header.Phonetic)
+	}
+	got := res.Header.FormPhonetics()
+	if len(got) != 2 || got["vert"] != "[vεr]" || got["verte"] != "[vεrt]" {
+		t.Fatalf("want vert:[vεr] and verte:[vεrt], got %v", got)
+	}
+	
+	got = Header{Texte: "aigu, aiguë", Phonetic: "[egy]"}.FormPhonetics()
+	if got["aigu"] != "[egy]" || got["aiguë"] != "[egy]" {
+		t.Fatalf("want both forms to share [egy], got %v", got)
+	}
+	
+	res, err = NewFromFileOrURL("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Header.Phonetic != "" || len(res.Header.FormPhonetics()) != 0 {
+		t.Fatalf("arbre: want no phonetic, got %+v", res.Header)
+	}
+}
+
+// TestFetchedAt tests that a Result read from a file is dated by the file's
+// modification time.
+func TestFetchedAt(t *testing.T) {
+	stat, err := os.Stat("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := NewFromFileOrURL("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.FetchedAt.Equal(stat.ModTime()) || !res.LastModified.Equal(stat.ModTime()) {
+		t.Fatalf("want %v, got FetchedAt %v and LastModified %v", stat.ModTime(), res.FetchedAt, res.LastModified)
+	}
+}
+
+// TestDiffItems tests laroussefr.DiffResults on two scrapes of "arbre", one of
+// which lost its citation and had its audio URL changed.
+func TestDiffItems(t *testing.T) {
+	oldRes, err := NewFromFileOrURL("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	newRes, err := NewFromFileOrURL("testdata/arbre-citation.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	newRes.Header.Audio += "?token=2"
+	changes := laroussefr.DiffResults(oldRes, newRes)
+	if len(changes) != 1 || changes[0].Section != "Citations" || changes[0].Kind != laroussefr.Removed {
+		t.Fatalf("want 1 removed citation, got %v", changes)
+	}
+}
+
+// TestHeaderWithoutAudio tests a page whose header has no audio clip.
+func TestHeaderWithoutAudio(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/auto.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Header{Texte: "auto", Type: "nom féminin"}
+	message, ok := want.equals(res.Header)
+	if !ok {
+		t.Fatal(message)
+	}
+	if res.HasAudio() || len(res.Definitions) != 1 {
+		t.Fatalf("want no audio and 1 definition, got %+v", res)
+	}
+}
+
+// TestFormsWithPhonetics tests Header.FormsWithPhonetics on pages with as many
+// pronunciations as forms and with fewer.
+func TestFormsWithPhonetics(t *testing.T) {
+	cases := map[string][]FormPhonetic {
+		"testdata/vert.html":    {{"vert", "[vεr]"}, {"verte", "[vεrt]"}},
+		"testdata/nouveau.html": {{"nouveau", "[nuvo]"}, {"nouvel", "[nuvεl]"}, {"nouvelle", ""}},
+		"testdata/arbre.html":   {{"arbre", ""}},
+	}
+	for in, want := range cases {
+		res, err := NewFromFileOrURL(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := res.Header.FormsWithPhonetics()
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Fatalf("%s: want %v, got %v", in, want, got)
+		}
+	}
+}
+
+// TestNewFromHTML tests that a page given as HTML is scraped like the same
+// page read from a file.
+func TestNewFromHTML(t *testing.T) {
+	page, err := ioutil.ReadFile("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := NewFromFileOrURL("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := NewFromHTML(string(page))
+	if err != nil {
+		t.Fatal(err)
+	}
+	message, ok := want.equals(got)
+	if !ok {
+		t.Fatal(message)
+	}
+	
+	page, err = ioutil.ReadFile("testdata/notfound.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = NewFromHTML(string(page))
+	if !errors.Is(err, ErrWordNotFound) {
+		t.Fatalf("want ErrWordNotFound, got %v", err)
+	}
+}
+
+// TestSens tests that a definition's meaning is split from its examples at the
+// first example node, even when the meaning itself contains colons.
+func TestSens(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/rapport.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct{
+		sens     string
+		exemples []string
+	}{
+		{"Exposé dans lequel on relate ce qu'on a vu ou entendu", []string{"Rédiger un rapport."}},
+		{"Quotient de deux grandeurs de même nature : a : b, noté aussi a/b", []string{"Le rapport de 10 à 2 est 5.", "Un rapport de un à trois."}},
+		{"Lien, relation entre des choses.", nil},
+	}
+	if len(res.Definitions) != len(want) {
+		t.Fatalf("want %d definitions, got %d", len(want), len(res.Definitions))
+	}
+	for i, w := range want {
+		d := res.Definitions[i]
+		if d.Sens != w.sens {
+			t.Errorf("Definitions[%d].Sens: want %q, got %q", i, w.sens, d.Sens)
+		}
+		if strings.Join(d.Exemples, "|") != strings.Join(w.exemples, "|") {
+			t.Errorf("Definitions[%d].Exemples: want %q, got %q", i, w.exemples, d.Exemples)
+		}
+	}
+	if res.Definitions[1].RedBig != "Mathématiques" {
+		t.Errorf("want RedBig Mathématiques, got %q", res.Definitions[1].RedBig)
+	}
+}
+
+// TestParseDocument tests that a parsed page is scraped like the same page read
+// from a file.
+func TestParseDocument(t *testing.T) {
+	doc, err := scrapeutil.HTMLRoot("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := NewFromFileOrURL("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ParseDocument(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message, ok := want.equals(got)
+	if !ok {
+		t.Fatal(message)
+	}
+}
+
+// BenchmarkParseDocument measures the cost of scraping a parsed page, without
+// reading or parsing it.
+func BenchmarkParseDocument(b *testing.B) {
+	doc, err := scrapeutil.HTMLRoot("testdata/arbre.html")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := ParseDocument(doc)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// TestValidate tests that Validate finds nothing wrong with a complete page and
+// flags fields emptied by hand.
+func TestValidate(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if warnings := res.Validate(); len(warnings) != 0 {
+		t.Fatalf("want no warnings, got %q", warnings)
+	}
+	
+	res.Header.Texte = ""
+	res.Definitions[2].Texte = ""
+	want := []string{
+		"Header has empty Texte",
+		"Definition 2 has context but no text",
+	}
+	got := res.Validate()
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("want %q, got %q", want, got)
+	}
+}
+
+// TestHeaderAudioURLs tests that every audio clip of a header is kept, and
+// that Audio is the first one.
+func TestHeaderAudioURLs(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/tuque.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"https://voix.larousse.fr/francais/80325fra2.mp3",
+		"https://voix.larousse.fr/francais/80325can2.mp3",
+	}
+	h := res.Header
+	if strings.Join(h.AudioURLs, "|") != strings.Join(want, "|") || h.Audio != want[0] {
+		t.Fatalf("want Audio %s and AudioURLs %q, got %s and %q", want[0], want, h.Audio, h.AudioURLs)
+	}
+	
+	res, err = NewFromFileOrURL("testdata/auto.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Header.AudioURLs) != 0 {
+		t.Fatalf("want no AudioURLs, got %q", res.Header.AudioURLs)
+	}
+}
+
+// TestHeaderAudioClips tests that the header's audio clips keep the region
+// label shown after them, and that the label isn't part of Texte.
+func TestHeaderAudioClips(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/tuque.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []laroussefr.AudioClip{
+		{URL: "https://voix.larousse.fr/francais/80325fra2.mp3", Label: ""},
+		{URL: "https://voix.larousse.fr/francais/80325can2.mp3", Label: "Québec"},
+	}
+	h := res.Header
+	if !reflect.DeepEqual(h.AudioClips, want) {
+		t.Errorf("want %v, got %v", want, h.AudioClips)
+	}
+	if h.Texte != "tuque" {
+		t.Errorf("want the label kept out of Texte, got %q", h.Texte)
+	}
+}
+
+// TestAudioURLs tests that a Result's audio clips are counted once each.
+func TestAudioURLs(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/tuque.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.AssetCount() != 2 || strings.Join(res.AudioURLs(), "|") != strings.Join(res.Header.AudioURLs, "|") {
+		t.Fatalf("want 2 clips %q, got %d %q", res.Header.AudioURLs, res.AssetCount(), res.AudioURLs())
+	}
+	
+	res, err = NewFromFileOrURL("testdata/auto.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.AssetCount() != 0 {
+		t.Fatalf("want no clips, got %q", res.AudioURLs())
+	}
+}
+
+// TestDefinitionTexts tests DefinitionTexts with and without examples and red
+// contexts.
+func TestDefinitionTexts(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/pile.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var i int
+	for i = range res.Definitions {
+		if res.Definitions[i].RedSmall != "" {
+			break
+		}
+	}
+	
+	texts := res.DefinitionTexts(true, false)
+	if len(texts) != len(res.Definitions) || texts[0] != "Amas, tas d'objets placés les uns sur les autres : Une pile de livres." {
+		t.Fatalf("with examples: got %q", texts)
+	}
+	texts = res.DefinitionTexts(false, false)
+	if texts[0] != "Amas, tas d'objets placés les uns sur les autres" {
+		t.Fatalf("without examples: got %q", texts[0])
+	}
+	texts = res.DefinitionTexts(false, true)
+	if texts[i] != "Électricité. Familier. Batterie d'accumulateurs." {
+		t.Fatalf("with contexts: got %q", texts[i])
+	}
+}
+
+// TestSortedRelations tests that SortedSynonymes and SortedContraires sort
+// accented words in French order without changing the Relation.
+func TestSortedRelations(t *testing.T) {
+	rel := Relation{
+		Synonymes:  []string{"élevé", "grand", "éminent", "élevé", "haut"},
+		Contraires: []string{"petit", "bas", "abaissé"},
+	}
+	got := rel.SortedSynonymes()
+	if strings.Join(got, ",") != "élevé,éminent,grand,haut" {
+		t.Fatalf("Synonymes: got %q", got)
+	}
+	got = rel.SortedContraires()
+	if strings.Join(got, ",") != "abaissé,bas,petit" {
+		t.Fatalf("Contraires: got %q", got)
+	}
+	if rel.Synonymes[0] != "élevé" || rel.Synonymes[1] != "grand" {
+		t.Fatalf("want Larousse's order kept, got %q", rel.Synonymes)
+	}
+}
+
+// TestGob tests that a Result survives a ToGob and FromGob round trip with
+// every field intact except Errors, which isn't encoded, as checked by Equal,
+// which also tells apart Results that differ only in their AudioClips, and
+// that FromGob rejects data that isn't a gob-encoded Result.
+func TestGob(t *testing.T) {
+	for _, path := range []string{"testdata/arbre.html", "testdata/arbre-citation.html", "testdata/manger.html", "testdata/vert.html"} {
+		res, err := NewFromFileOrURL(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := res.ToGob()
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		got, err := FromGob(data)
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		if got.Errors != nil {
+			t.Errorf("%s: want no Errors, got %v", path, got.Errors)
+		}
+		if got.Equal(res) != (len(res.Errors) == 0) {
+			t.Errorf("%s: want Equal only if the original has no Errors", path)
+		}
+		res.Errors = nil
+		if !got.Equal(res) {
+			message, _ := res.equals(got)
+			t.Errorf("%s: round trip changed the Result\n%s\nwant %+v\ngot  %+v", path, message, res, got)
+		}
+		got.Header.AudioClips = nil
+		if len(res.Header.AudioClips) > 0 && got.Equal(res) {
+			t.Errorf("%s: want Results with different AudioClips unequal", path)
+		}
+	}
+	
+	_, err := FromGob([]byte("not gob"))
+	if !errors.Is(err, laroussefr.ErrInvalidInput) {
+		t.Errorf("bad data: want ErrInvalidInput, got %v", err)
+	}
+}
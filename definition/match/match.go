@@ -3,6 +3,8 @@
 package match
 
 import (
+	"strings"
+	
 	"github.com/yhat/scrape"
 	
 	"golang.org/x/net/html"
@@ -14,18 +16,21 @@ func class(n *html.Node) string {
 	return scrape.Attr(n, "class")
 }
 
-// HeaderTexteNode returns true if n is a node containing a header's Texte.
+// HeaderTexteNode returns true if n is a node containing a header's Texte,
+// i.e. a non-blank text node directly inside the <h2> element of class
+// AdresseDefinition. The text may or may not be preceded by an <audio> node,
+// since some words, such as "auto", have no audio.
 func HeaderTexteNode(n *html.Node) bool {
-	if n.Type != html.TextNode {
+	if n.Type != html.TextNode || strings.TrimSpace(n.Data) == "" {
 		return false
 	}
 	
-	prev := n.PrevSibling
-	if prev == nil {
+	par := n.Parent
+	if par == nil {
 		return false
 	}
 	
-	return prev.DataAtom == atom.Audio
+	return par.DataAtom == atom.H2 && class(par) == "AdresseDefinition"
 }
 
 // HeaderAudioNode returns true if n is an <audio> node, which contains a
@@ -83,6 +88,17 @@ func RelationNode(n *html.Node) bool {
 	return m.DataAtom == atom.B
 }
 
+// OrphanRelationNode returns true if n is an item on the SYNONYMES ET
+// CONTRAIRES list which has no corresponding definition, i.e. one which starts
+// directly with its "Synonymes :" or "Contraires :" label (see 'aguiche').
+func OrphanRelationNode(n *html.Node) bool {
+	if n.DataAtom != atom.Div || class(n) != "SensSynonymes" {
+		return false
+	}
+	m := n.FirstChild
+	return m != nil && m.DataAtom == atom.P && class(m) == "SynonymeOrAntonyme"
+}
+
 // SuggestionsNode returns true if n contains the "word not found - try these
 // suggestions" text.
 func SuggestionsNode(n *html.Node) bool {
@@ -110,6 +126,12 @@ func DifficulteTypeNode(n *html.Node) bool {
 	return n.DataAtom == atom.P && class(n) == "TypeDifficulte"
 }
 
+// DifficulteExempleNode returns true if n is an example within the Texte of a
+// DIFFICULTÉ, i.e. an <i> element or a <span> of class ExempleDifficulte.
+func DifficulteExempleNode(n *html.Node) bool {
+	return n.DataAtom == atom.I || n.DataAtom == atom.Span && class(n) == "ExempleDifficulte"
+}
+
 // DifficulteTexteNode returns true if n holds the Texte field of a DIFFICULTÉ.
 func DifficulteTexteNode(n *html.Node) bool {
 	return n.DataAtom == atom.P && class(n) == "DefinitionDifficulte"
@@ -166,6 +188,19 @@ func ExempleDefinitionNode(n *html.Node) bool {
 	return n.DataAtom == atom.Span && class(n) == "ExempleDefinition"
 }
 
+// CandidateNode returns true if n is an item in the list of entries shown by a
+// disambiguation page, i.e. an <li> element inside a <ul> element of class
+// ListeHomographes.
+func CandidateNode(n *html.Node) bool {
+	return n.DataAtom == atom.Li && n.Parent != nil && n.Parent.DataAtom == atom.Ul && class(n.Parent) == "ListeHomographes"
+}
+
+// ExempleNode returns true if n is a <span> element of class ExempleDefinition
+// or ExempleLocution, i.e. an example phrase of a definition or an expression.
+func ExempleNode(n *html.Node) bool {
+	return ExempleDefinitionNode(n) || n.DataAtom == atom.Span && class(n) == "ExempleLocution"
+}
+
 // AdresseLocutionNode returns true if n is an <h2> element of class
 // AdresseLocution, which holds a single Textes element of an Expression.
 func AdresseLocutionNode(n *html.Node) bool {
@@ -2,6 +2,7 @@
 package parse
 
 import (
+	"regexp"
 	"strconv"
 	"strings"
 	
@@ -35,13 +36,49 @@ func DefinitionNode(n *html.Node) ([3]string, error) {
 				if shouldGetSpace(texte) {
 					texte += " "
 				}
-				texte += scrape.Text(m)
+				texte += laroussefr.Text(m)
 		}
 		m = m.NextSibling
 	}
 	return [3]string{texte, redBig, redSmall}, nil
 }
 
+// Exemples takes a DÉFINITIONS or EXPRESSIONS node and returns the text of each
+// of its example phrases.
+func Exemples(n *html.Node) []string {
+	var out []string
+	for _, m := range scrape.FindAll(n, match.ExempleNode) {
+		str := strings.TrimSpace(laroussefr.Text(m))
+		if str != "" {
+			out = append(out, str)
+		}
+	}
+	return out
+}
+
+// Sens takes a DEFINITION node and returns its meaning, i.e. the text which
+// precedes its first example phrase, without the colon separating the two.
+// The boundary is the first ExempleDefinition node rather than the first
+// colon, since the meaning may contain colons of its own (e.g. "a : b" in a
+// mathematical definition). Red context isn't included.
+func Sens(n *html.Node) string {
+	var sens string
+	for m := n.FirstChild; m != nil; m = m.NextSibling {
+		if _, ok := scrape.Find(m, match.ExempleNode); ok {
+			break
+		}
+		if match.RubriqueDefinitionNode(m) || match.IndicateurDefinitionNode(m) {
+			continue
+		}
+		if shouldGetSpace(sens) {
+			sens += " "
+		}
+		sens += laroussefr.Text(m)
+	}
+	sens = strings.TrimSuffix(strings.TrimSpace(sens), ":")
+	return strings.TrimSpace(sens)
+}
+
 // shouldGetSpace returns true if str should be appended with a space (that is,
 // if it's non-empty and doesn't end with a space).
 func shouldGetSpace(str string) bool {
@@ -72,11 +109,14 @@ func ExpressionNode(n *html.Node) (string, string, string, error) {
 			redSmall = scrape.Text(indiloc)
 		}
 		
-		// texte
+		// texte, which is the AdresseLocution followed by every description
+		// node up to the next AdresseLocution
 		texte := scrape.Text(n)
-		if n.NextSibling != nil {
-			texte += " "
-			texte += scrape.Text(n.NextSibling)
+		for m := n.NextSibling; m != nil && !match.AdresseLocutionNode(m); m = m.NextSibling {
+			desc := strings.TrimSpace(scrape.Text(m))
+			if desc != "" && !match.RubriqueDefinitionNode(m) {
+				texte += " " + desc
+			}
 		}
 		
 		textes = append(textes, texte)
@@ -90,6 +130,30 @@ func ExpressionNode(n *html.Node) (string, string, string, error) {
 	return texte, redBig, redSmall, nil
 }
 
+// sensReference matches a reference to a numbered definition in the text of
+// an expression, e.g. "voir sens 2".
+var sensReference = regexp.MustCompile(`(?i)\bsens\s+(\d+)\b`)
+
+// ExpressionReference takes an EXPRESSION ("Locution") node and returns the
+// reference it makes to a definition, if any: the ID of the element targeted
+// by its first in-page link, e.g. "60425" for href="#60425", and the number in
+// its first mention of a numbered sense, e.g. 2 for "(voir sens 2)". Either is
+// empty or 0 if there's none.
+func ExpressionReference(n *html.Node) (string, int) {
+	var id string
+	a, ok := scrape.Find(n, func(m *html.Node) bool {
+		return m.DataAtom == atom.A && strings.HasPrefix(scrape.Attr(m, "href"), "#")
+	})
+	if ok {
+		id = strings.TrimPrefix(scrape.Attr(a, "href"), "#")
+	}
+	var num int
+	if sub := sensReference.FindStringSubmatch(scrape.Text(n)); sub != nil {
+		num, _ = strconv.Atoi(sub[1])
+	}
+	return id, num
+}
+
 // expressionCleanupTexte cleans up the texte parsed in ExpressionNode.
 func expressionCleanupTexte(texte string) string {
 	replace := map[string]string{
@@ -147,12 +211,26 @@ func HomonymeNode(n *html.Node) (string, string, error) {
 
 // RelationNode parses a single SYNONYMES ET CONTRAIRES node into the fields
 // for a Relation object.
+// 
+// If the relation has no corresponding definition (see match.OrphanRelationNode),
+// the returned texte is empty.
 func RelationNode(n *html.Node) (string, []string, []string, error) {
+	if match.OrphanRelationNode(n) {
+		lists, err := parseRelationNodeLists(n.FirstChild)
+		if err != nil {
+			return "", nil, nil, laroussefr.NewError("RelationNode", "", err.Error())
+		}
+		return "", lists[0], lists[1], nil
+	}
+	
 	texte, err := parseRelationNodeTexte(n)
 	if err != nil {
 		return "", nil, nil, laroussefr.NewError("RelationNode", "", err.Error())
 	}
-	lists, err := parseRelationNodeLists(n)
+	if n.FirstChild.NextSibling == nil {
+		return "", nil, nil, laroussefr.NewError("RelationNode", "", "nil NextSibling")
+	}
+	lists, err := parseRelationNodeLists(n.FirstChild.NextSibling)
 	if err != nil {
 		return "", nil, nil, laroussefr.NewError("RelationNode", "", err.Error())
 	}
@@ -160,29 +238,25 @@ func RelationNode(n *html.Node) (string, []string, []string, error) {
 }
 
 // parseRelationText retrieves the Texte from a relation node.
+// 
+// Larousse often shortens the text with a trailing "..." or ends it with a
+// period, so trailing spaces, periods, commas and ellipses are trimmed. This
+// makes the Texte a prefix of the corresponding Definition's or Expression's
+// Texte.
 func parseRelationNodeTexte(n *html.Node) (string, error) {
 	m := n.FirstChild
 	if m == nil {
 		return "", laroussefr.NewError("parseRelationNodeTexte", "", "nil FirstChild")
 	}
-	return scrape.Text(m), nil
+	return strings.TrimRight(scrape.Text(m), " .,…"), nil
 }
 
 // parseRelationNodeLists returns both the SYNONYMES list and CONTRAIRES list
-// from a relation node, in that order.
-func parseRelationNodeLists(n *html.Node) ([2][]string, error) {
+// from a relation node, in that order, given the node's first "Synonymes :" or
+// "Contraires :" label.
+func parseRelationNodeLists(m *html.Node) ([2][]string, error) {
 	var out [2][]string
 	
-	m := n.FirstChild
-	if m == nil {
-		return out, laroussefr.NewError("parseRelationNodeLists", "", "nil FirstChild")
-	}
-	
-	m = m.NextSibling
-	if m == nil {
-		return out, laroussefr.NewError("parseRelationNodeLists", "", "nil NextSibling")
-	}
-	
 	var i int
 	if strings.HasPrefix(scrape.Text(m), "Synonyme") {
 		i = 0
@@ -190,6 +264,9 @@ func parseRelationNodeLists(n *html.Node) ([2][]string, error) {
 		i = 1
 	}
 	m = m.NextSibling
+	if m == nil {
+		return out, laroussefr.NewError("parseRelationNodeLists", "", "nil NextSibling")
+	}
 	out[i] = strings.Split(scrape.Text(m), " - ")
 	if i == 1 || m.NextSibling == nil {
 		return out, nil
@@ -201,39 +278,83 @@ func parseRelationNodeLists(n *html.Node) ([2][]string, error) {
 }
 
 // DifficulteNode takes a DIFFICULTÉ node and returns the text fields for a
-// Difficulte object.
-func DifficulteNode(n *html.Node) (string, string, error) {
+// Difficulte object, followed by its correct and incorrect examples.
+// 
+// Examples are the italic or ExempleDifficulte nodes in the text. An example is
+// incorrect if the text before it ends with "non" or "pas", as in "on écrit
+// un chariot et non un charriot". Otherwise, it's correct.
+func DifficulteNode(n *html.Node) (string, string, []string, []string, error) {
 	// Type
 	var typ string
 	typeNode, ok := scrape.Find(n, match.DifficulteTypeNode)
 	if !ok {
-		return "", "", laroussefr.NewError("DifficulteNode", "", "Can't find Type")
+		return "", "", nil, nil, laroussefr.NewError("DifficulteNode", "", "Can't find Type")
 	}
 	typ = scrape.Text(typeNode)
 	
 	var texte string
+	var correct, incorrect []string
 	m := typeNode.NextSibling
 	for m != nil {
 		texte += scrape.Text(m)
+		c, i := difficulteExamples(m)
+		correct = append(correct, c...)
+		incorrect = append(incorrect, i...)
 		m = m.NextSibling
 	}
 	
-	return typ, texte, nil
+	return typ, texte, correct, incorrect, nil
+}
+
+// difficulteExamples returns the correct and incorrect examples within a
+// DIFFICULTÉ text node.
+func difficulteExamples(n *html.Node) ([]string, []string) {
+	var correct, incorrect []string
+	var before string
+	var walk func(*html.Node)
+	walk = func(m *html.Node) {
+		if match.DifficulteExempleNode(m) {
+			ex := strings.TrimSpace(scrape.Text(m))
+			words := strings.Fields(strings.ToLower(before))
+			if len(words) > 0 && (words[len(words)-1] == "non" || words[len(words)-1] == "pas") {
+				incorrect = append(incorrect, ex)
+			} else {
+				correct = append(correct, ex)
+			}
+			before = ""
+			return
+		}
+		if m.Type == html.TextNode {
+			before += m.Data
+		}
+		for c := m.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return correct, incorrect
 }
 
 
 // CitationNode takes a CITATION node and returns the ID and string fields for
 // a Citation object.
-func CitationNode(n *html.Node) (int, [4]string, error) {
+//
+// [0] Auteur
+// [1] AuteurURL
+// [2] InfoAuteur
+// [3] Texte
+// [4] Info
+func CitationNode(n *html.Node) (int, [5]string, error) {
 	id, err := getNodeID(n)
 	if err != nil {
-		return -1, [4]string{}, laroussefr.NewError("CitationNode", "", err.Error())
+		return -1, [5]string{}, laroussefr.NewError("CitationNode", "", err.Error())
 	}
 	
 	auteurNode, ok := scrape.Find(n, match.CitationAuteurNode)
-	var auteur string // auteur optional; see "arbre" page
+	var auteur, auteurURL string // auteur optional; see "arbre" page
 	if ok {
 		auteur = scrape.Text(auteurNode)
+		auteurURL = citationAuteurURL(auteurNode)
 	}
 	
 	infoAuteurNode, ok := scrape.Find(n, match.CitationInfoAuteurNode)
@@ -244,7 +365,7 @@ func CitationNode(n *html.Node) (int, [4]string, error) {
 	
 	texteNode, ok := scrape.Find(n, match.CitationTexteNode)
 	if !ok {
-		return -1, [4]string{}, laroussefr.NewError("CitationNode", "", "can't find Texte node")
+		return -1, [5]string{}, laroussefr.NewError("CitationNode", "", "can't find Texte node")
 	}
 	texte := scrape.Text(texteNode)
 	
@@ -254,7 +375,21 @@ func CitationNode(n *html.Node) (int, [4]string, error) {
 		info = scrape.Text(infoNode)
 	}
 	
-	return id, [4]string{auteur, infoAuteur, texte, info}, nil
+	return id, [5]string{auteur, auteurURL, infoAuteur, texte, info}, nil
+}
+
+// citationAuteurURL takes an Auteur node and returns the absolute URL of the
+// link inside it, or "" if the author isn't linked.
+func citationAuteurURL(auteurNode *html.Node) string {
+	a, ok := scrape.Find(auteurNode, scrape.ByTag(atom.A))
+	if !ok {
+		return ""
+	}
+	href := scrape.Attr(a, "href")
+	if href == "" {
+		return ""
+	}
+	return laroussefr.AbsoluteURL(href)
 }
 
 // getNodeID takes a node with an "id" attribute and returns it as an integer.
@@ -0,0 +1,99 @@
+package definition
+
+import (
+	"bytes"
+	"strings"
+	htmltemplate "html/template"
+)
+
+// htmlTemplate renders a Result as HTML. Every field is escaped by
+// html/template, since the text comes from a third-party page.
+var htmlTemplate = htmltemplate.Must(htmltemplate.New("definition").Funcs(htmltemplate.FuncMap{
+	"join": strings.Join,
+}).Parse(`<article class="lfr-definition">
+<header class="lfr-header">
+<h1 class="lfr-word">{{.Header.Texte}}</h1>
+{{- if .Header.Audio}}
+<a class="lfr-audio" href="{{.Header.Audio}}">écouter</a>
+{{- end}}
+{{- if .Header.Type}}
+<p class="lfr-type">{{.Header.Type}}</p>
+{{- end}}
+</header>
+{{- with .DefinitionGroups}}
+<section class="lfr-definitions">
+{{- range .}}
+<div class="lfr-group">
+{{- if .Context}}
+<h2 class="lfr-red-big">{{.Context}}</h2>
+{{- end}}
+<ol>
+{{- range .Defs}}
+<li>{{if .RedSmall}}<span class="lfr-red-small">{{.RedSmall}}</span> {{end}}{{.Texte}}</li>
+{{- end}}
+</ol>
+</div>
+{{- end}}
+</section>
+{{- end}}
+{{- with .Expressions}}
+<section class="lfr-expressions">
+<ul>
+{{- range .}}
+<li class="lfr-blue">{{if .RedBig}}<span class="lfr-red-big">{{.RedBig}}</span> {{end}}{{if .RedSmall}}<span class="lfr-red-small">{{.RedSmall}}</span> {{end}}{{.Texte}}</li>
+{{- end}}
+</ul>
+</section>
+{{- end}}
+{{- with .Relations}}
+<section class="lfr-relations">
+<ul>
+{{- range .}}
+<li><b>{{.Texte}}</b>{{if .Synonymes}} <span class="lfr-synonymes">{{join .Synonymes " - "}}</span>{{end}}{{if .Contraires}} <span class="lfr-contraires">{{join .Contraires " - "}}</span>{{end}}</li>
+{{- end}}
+</ul>
+</section>
+{{- end}}
+{{- with .Homonymes}}
+<section class="lfr-homonymes">
+<ul>
+{{- range .}}
+<li>{{.Texte}} <span class="lfr-type">{{.Type}}</span></li>
+{{- end}}
+</ul>
+</section>
+{{- end}}
+{{- with .Difficultes}}
+<section class="lfr-difficultes">
+<ul>
+{{- range .}}
+<li><span class="lfr-red-big">{{.Type}}</span> {{.Texte}}</li>
+{{- end}}
+</ul>
+</section>
+{{- end}}
+{{- with .Citations}}
+<section class="lfr-citations">
+<ul>
+{{- range .}}
+<li><blockquote>{{.Texte}}</blockquote> <cite>{{if .AuteurURL}}<a href="{{.AuteurURL}}">{{.Auteur}}</a>{{else}}{{.Auteur}}{{end}}</cite>{{if .InfoAuteur}} <span class="lfr-info">{{.InfoAuteur}}</span>{{end}}{{if .Info}} <span class="lfr-info">{{.Info}}</span>{{end}}</li>
+{{- end}}
+</ul>
+</section>
+{{- end}}
+</article>
+`))
+
+// HTML renders r as semantic HTML for embedding in a web page. Contexts,
+// expressions and audio links get the CSS classes lfr-red-big,
+// lfr-red-small, lfr-blue and lfr-audio, so they can be styled like
+// Larousse's own pages. All scraped text is HTML-escaped, and the output is
+// the same for equal Results.
+func (r Result) HTML() (string, error) {
+	var buf bytes.Buffer
+	err := htmlTemplate.Execute(&buf, r)
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
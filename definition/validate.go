@@ -0,0 +1,85 @@
+package definition
+
+import (
+	"fmt"
+	
+	"github.com/serope/laroussefr"
+)
+
+// Validate returns a human-readable warning for each field of r which looks
+// wrong, e.g. "Definition 3 has context but no text", or nil if there's
+// nothing suspicious. It's meant for auditing scraped data in bulk, since a
+// warning usually means that the scrapers missed a change in Larousse's
+// markup. r isn't modified.
+// 
+// A "word not found" Result has no warnings.
+func (r Result) Validate() []string {
+	if r.NotFound() {
+		return nil
+	}
+	var out []string
+	warn := func(format string, a ...interface{}) {
+		out = append(out, fmt.Sprintf(format, a...))
+	}
+	
+	if r.PageID <= 0 {
+		warn("PageID %d isn't positive", r.PageID)
+	}
+	if r.Header.Texte == "" {
+		warn("Header has empty Texte")
+	}
+	if r.Header.Type == "" {
+		warn("Header has empty Type")
+	}
+	if len(r.Definitions) == 0 && len(r.Expressions) == 0 {
+		warn("Result has no Definitions or Expressions")
+	}
+	for i, d := range r.Definitions {
+		switch {
+			case d.Texte == "" && (d.RedBig != "" || d.RedSmall != ""):
+				warn("Definition %d has context but no text", i)
+			case d.Texte == "":
+				warn("Definition %d has empty Texte", i)
+		}
+		if len(d.Exemples) > 0 && d.Sens == "" {
+			warn("Definition %d has Exemples but empty Sens", i)
+		}
+	}
+	for i, e := range r.Expressions {
+		if e.Texte == "" {
+			warn("Expression %d has empty Texte", i)
+		}
+	}
+	for i, rel := range r.Relations {
+		if len(rel.Synonymes) == 0 && len(rel.Contraires) == 0 {
+			warn("Relation %d has no Synonymes or Contraires", i)
+		}
+	}
+	for i, h := range r.Homonymes {
+		if h.Texte == "" {
+			warn("Homonyme %d has empty Texte", i)
+		}
+	}
+	for i, d := range r.Difficultes {
+		if d.Texte == "" {
+			warn("Difficulte %d has empty Texte", i)
+		}
+	}
+	for i, c := range r.Citations {
+		if c.Texte == "" {
+			warn("Citation %d has empty Texte", i)
+		}
+		if c.Auteur == "" {
+			warn("Citation %d has empty Auteur", i)
+		}
+	}
+	for i, u := range r.SeeAlso {
+		if ok, message := laroussefr.IsURL(u); !ok {
+			warn("SeeAlso %d isn't a dictionary URL: %s", i, message)
+		}
+	}
+	for _, err := range r.Errors {
+		warn("Section error: %s", err.Error())
+	}
+	return out
+}
@@ -0,0 +1,155 @@
+package laroussefr
+
+import (
+	"strings"
+)
+
+// Type DiffItem is a piece of a Result's content, as compared by DiffResults.
+// Section names the part of the page it's from, e.g. "Definitions", and Text is
+// its content, without audio URLs or other data that changes when the entry
+// itself doesn't.
+type DiffItem struct {
+	Section string
+	Text    string
+}
+
+// Differ is implemented by the Result types of packages definition, synonymes
+// and traduction. DiffItems returns a Result's content in page order.
+type Differ interface {
+	DiffItems() []DiffItem
+}
+
+// Type ChangeKind is an enum type for the kinds of Change.
+type ChangeKind int
+
+const (
+	Added ChangeKind = iota
+	Removed
+	Changed
+)
+
+func (k ChangeKind) String() string {
+	switch k {
+		case Added:   return "added"
+		case Removed: return "removed"
+		case Changed: return "changed"
+	}
+	return "unknown"
+}
+
+// Type Change is a difference between two scrapes of the same page. Old is
+// empty for an added item, and New is empty for a removed one.
+type Change struct {
+	Section string
+	Kind    ChangeKind
+	Old     string
+	New     string
+}
+
+// DiffResults compares two scrapes of the same page, e.g. two
+// definition.Results, and returns the items which were added, removed or
+// changed, section by section. Items are matched by their longest common
+// subsequence, and an item removed where another was added is reported as
+// changed. Audio URLs aren't compared, so a new CDN token isn't a change.
+// 
+// An empty slice means the entry is unchanged.
+func DiffResults(oldRes, newRes Differ) []Change {
+	oldItems := groupBySection(oldRes.DiffItems())
+	newItems := groupBySection(newRes.DiffItems())
+	var sections []string
+	seen := map[string]bool{}
+	for _, items := range [][]DiffItem{oldRes.DiffItems(), newRes.DiffItems()} {
+		for _, item := range items {
+			if !seen[item.Section] {
+				seen[item.Section] = true
+				sections = append(sections, item.Section)
+			}
+		}
+	}
+	
+	var out []Change
+	for _, s := range sections {
+		out = append(out, diffSection(s, oldItems[s], newItems[s])...)
+	}
+	return out
+}
+
+// groupBySection returns the Texts of items by Section.
+func groupBySection(items []DiffItem) map[string][]string {
+	out := make(map[string][]string)
+	for _, item := range items {
+		out[item.Section] = append(out[item.Section], item.Text)
+	}
+	return out
+}
+
+// diffSection returns the changes from a to b, the Texts of a section.
+func diffSection(section string, a, b []string) []Change {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and
+	// b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a)-1; i >= 0; i-- {
+		for j := len(b)-1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	
+	var out, removed, added []Change
+	flush := func() {
+		for k := 0; k < len(removed) || k < len(added); k++ {
+			switch {
+				case k >= len(added):   out = append(out, removed[k])
+				case k >= len(removed): out = append(out, added[k])
+				default:                out = append(out, Change{section, Changed, removed[k].Old, added[k].New})
+			}
+		}
+		removed, added = nil, nil
+	}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+			case i < len(a) && j < len(b) && a[i] == b[j]:
+				flush()
+				i++
+				j++
+			case j == len(b) || i < len(a) && lcs[i+1][j] >= lcs[i][j+1]:
+				removed = append(removed, Change{section, Removed, a[i], ""})
+				i++
+			default:
+				added = append(added, Change{section, Added, "", b[j]})
+				j++
+		}
+	}
+	flush()
+	return out
+}
+
+// NewDiffItem returns a DiffItem whose Text is the non-empty strings in strs
+// joined with " | ".
+func NewDiffItem(section string, strs ...string) DiffItem {
+	var parts []string
+	for _, s := range strs {
+		if s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return DiffItem{section, strings.Join(parts, " | ")}
+}
+
+// DiffList returns label, a colon and strs joined with commas, or "" if strs is
+// empty.
+func DiffList(label string, strs []string) string {
+	if len(strs) == 0 {
+		return ""
+	}
+	return label + ": " + strings.Join(strs, ", ")
+}
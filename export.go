@@ -0,0 +1,144 @@
+package laroussefr
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ExportFile is the name of the JSON file written by ExportWord.
+const ExportFile = "result.json"
+
+// ExportWord writes result, a Result from package definition, synonymes or
+// traduction, to a self-contained bundle in destDir, e.g. for an offline
+// flashcard app. The directory is created if it doesn't exist.
+// 
+// Every audio clip referenced by result, i.e. every URL in a field whose name
+// begins with "Audio", is downloaded into destDir with OpenAudio and named
+// after the last element of its URL, e.g. "16869A.mp3", so that exporting the
+// same word again gives the same files. result is then written to ExportFile
+// as indented JSON, with each downloaded clip's URL replaced by its file name,
+// which is relative to destDir. result itself isn't modified.
+// 
+// If a clip can't be downloaded, its URL is left in place and the failure is
+// logged with the log package. An error is only returned if destDir or one of
+// its files can't be written.
+func ExportWord(result Page, destDir string) error {
+	data, err := json.MarshalIndent(result, "", "\t")
+	if err != nil {
+		return NewError("ExportWord", destDir, err.Error())
+	}
+	var decoded interface{}
+	err = json.Unmarshal(data, &decoded)
+	if err != nil {
+		return NewError("ExportWord", destDir, err.Error())
+	}
+	err = os.MkdirAll(destDir, 0755)
+	if err != nil {
+		return NewError("ExportWord", destDir, err.Error())
+	}
+	
+	// sorted, so that the same clips always get the same names
+	urls := audioURLs(decoded, "", nil)
+	sort.Strings(urls)
+	names := make(map[string]string)
+	for i, u := range urls {
+		if i > 0 && urls[i-1] == u {
+			continue
+		}
+		name := exportFileName(u, names)
+		err := downloadAudio(u, filepath.Join(destDir, name))
+		if err != nil {
+			log.Printf("ExportWord: keeping %s: %v", u, err)
+			continue
+		}
+		names[u] = name
+	}
+	
+	for u, name := range names {
+		data = bytes.ReplaceAll(data, jsonString(u), jsonString(name))
+	}
+	err = ioutil.WriteFile(filepath.Join(destDir, ExportFile), data, 0644)
+	if err != nil {
+		return NewError("ExportWord", destDir, err.Error())
+	}
+	return nil
+}
+
+// audioURLs appends the non-empty strings found under keys beginning with
+// "Audio" in v, a value decoded from JSON, to out. key is the key v was found
+// under.
+func audioURLs(v interface{}, key string, out []string) []string {
+	switch v := v.(type) {
+		case map[string]interface{}:
+			for k, val := range v {
+				out = audioURLs(val, k, out)
+			}
+		case []interface{}:
+			for _, val := range v {
+				out = audioURLs(val, key, out)
+			}
+		case string:
+			if v != "" && strings.HasPrefix(key, "Audio") {
+				out = append(out, v)
+			}
+	}
+	return out
+}
+
+// exportFileName returns the file name under which the clip at u is saved,
+// which is the last element of its path unless that's empty or already used
+// by another clip in names, in which case a hash of u is used instead.
+func exportFileName(u string, names map[string]string) string {
+	name := ""
+	parsed, err := url.Parse(u)
+	if err == nil {
+		name = path.Base(parsed.Path)
+	}
+	taken := name == "" || name == "." || name == "/"
+	for _, other := range names {
+		if other == name {
+			taken = true
+		}
+	}
+	if taken {
+		name = HashStrings([]string{u})[:16] + path.Ext(name)
+	}
+	return name
+}
+
+// downloadAudio saves the clip at u to the file dest.
+func downloadAudio(u, dest string) error {
+	rc, _, err := OpenAudio(u)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	f, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, rc)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(dest)
+	}
+	return err
+}
+
+// jsonString returns str encoded as a JSON string, as it appears in the
+// output of json.MarshalIndent.
+func jsonString(str string) []byte {
+	data, _ := json.Marshal(str)
+	return data
+}
@@ -1,24 +1,122 @@
 // Package laroussefr provides packages for web scraping Larousse
 // (https://www.larousse.fr).
 // 
-// laroussefr.go contains common functions shared by packages definition and
-// traduction.
+// laroussefr.go contains common functions shared by packages definition,
+// synonymes and traduction.
 package laroussefr
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	
+	"github.com/serope/laroussefr/scrapeutil"
 
 	"golang.org/x/net/html"
 	"golang.org/x/net/html/atom"
 	"github.com/yhat/scrape"
 )
 
-// ErrWordNotFound is returned by functions that search for words on Larousse
-// and end up encountering a "word not found" page.
-var ErrWordNotFound error
+// ErrWordNotFound is wrapped by the error returned by functions that search
+// for words on Larousse and end up encountering a "word not found" page. Use
+// errors.Is to detect it.
+var ErrWordNotFound = errors.New("word not found")
+
+// ErrNetwork is wrapped by the error returned when a page can't be downloaded,
+// e.g. because Larousse can't be reached or responds with an HTTP error
+// status. Unlike ErrWordNotFound, it's usually worth trying again later. Use
+// errors.Is to detect it.
+var ErrNetwork = scrapeutil.ErrNetwork
+
+// ErrParse is wrapped by the error returned when a page was downloaded but
+// couldn't be scraped, e.g. because its markup has changed. Use errors.Is to
+// detect it.
+var ErrParse = scrapeutil.ErrParse
+
+// ErrInvalidInput is wrapped by the error returned when a function is given an
+// argument it can't look up, e.g. an empty word, a URL that isn't a Larousse
+// dictionary page or an unknown language. Use errors.Is to detect it.
+var ErrInvalidInput = errors.New("invalid input")
+
+// StripTrademarks controls whether trademark and registered symbols ("™" and
+// "®") are removed from the text of each word's header, e.g. Text and TextAlt
+// in package traduction, and from the URLs in SeeAlso, e.g. "Airbag®" becomes
+// "Airbag". This helps when matching against user input. It's false by default,
+// so that the exact glyphs are kept.
+var StripTrademarks = false
+
+// PreserveEmphasis controls whether italic and bold text keeps a light
+// Markdown-like markup in the text of definitions and phrases, e.g. Texte, Sens
+// and Exemples in package definition, and the Text fields of Meanings and
+// Phrases in package traduction. Italics (<i> and <em>) become *...* and bold
+// text (<b> and <strong>) becomes **...**. See EmphasisText.
+// 
+// It's false by default, so that the text is flattened as before.
+var PreserveEmphasis = false
+
+// AudioURLRewriter, if non-nil, is applied to every audio URL as soon as it's
+// scraped, i.e. to each URL returned by GetAudioURL, so that the Results of
+// every package hold the rewritten URLs, e.g. ones pointing to a proxy which
+// serves Larousse's clips. If it's nil, which is the default, the URLs are kept
+// as scraped.
+var AudioURLRewriter func(string) string
+
+// BaseURL is the origin from which New and the other functions that look up a
+// word download its page, e.g. "https://www.larousse.fr" in
+// "https://www.larousse.fr/dictionnaires/francais/arbre". It can be changed to
+// fetch the pages from another host, such as a mirror or a local server, and
+// URLs under it are accepted by IsURL.
+// 
+// Relative links scraped from a page are resolved against BaseURL once it has
+// been changed. Until then, they're resolved against https://larousse.fr as
+// they always were, so that the URLs in Results don't change by default.
+// 
+// Every page is scraped by the same parser whatever its host, so a host whose
+// markup differs from www.larousse.fr's, e.g. a lightweight or mobile variant
+// of the site, may not be scraped correctly.
+var BaseURL = defaultBaseURL
+
+// defaultBaseURL is BaseURL's default value.
+const defaultBaseURL = "https://www.larousse.fr"
+
+// linkOrigin returns the origin against which relative links are resolved, as
+// described by BaseURL.
+func linkOrigin() string {
+	if BaseURL == defaultBaseURL {
+		return "https://larousse.fr"
+	}
+	return strings.TrimSuffix(BaseURL, "/")
+}
+
+// NotFoundPageID is the PageID of every Result returned along with
+// ErrWordNotFound, in every package. Such a Result has no content, i.e. its
+// IsEmpty method returns true, and its SeeAlso slice holds Larousse's search
+// suggestions, or is nil if there are none.
+const NotFoundPageID = -1
+
+// Page is implemented by the Result types of packages definition, synonymes and
+// traduction, so that code can handle a page from any dictionary.
+// 
+// ID returns the page's ID (the Result's PageID field, which rules out a method
+// of the same name). SeeAlsoURLs returns its SeeAlso slice. NotFound returns
+// true if the page is a "word not found" page, i.e. its ID is NotFoundPageID.
+// HasAudio returns true if the page has an audio clip.
+type Page interface {
+	ID() int
+	SeeAlsoURLs() []string
+	NotFound() bool
+	HasAudio() bool
+}
 
 // LfrError implements the Error interface.
 // 
@@ -27,23 +125,54 @@ type LfrError struct {
 	function string
 	arg      string
 	message  string 
+	err      error
 }
 
 func (lfre LfrError) Error() string {
 	return fmt.Sprintf("%s(%s)\n%s", lfre.function, lfre.arg, lfre.message)
 }
 
+// Unwrap returns the error wrapped by WrapError, if any.
+func (lfre LfrError) Unwrap() error {
+	return lfre.err
+}
+
 // NewError takes a function name, an example of an argument passed to it, and
 // a short message describing an error that occurred, returning a new LfrError.
 // 
 // This is for internal use. Exported functions always return normal errors.
 func NewError(function, arg, message string) LfrError {
-	return LfrError{function, arg, message}
+	return LfrError{function, arg, message, nil}
+}
+
+// WrapError is like NewError, but err's message is appended to message and err
+// itself is kept, so that the returned LfrError can be examined with errors.Is
+// and errors.As.
+func WrapError(function, arg, message string, err error) LfrError {
+	return LfrError{function, arg, message + err.Error(), err}
+}
+
+// NewKindError is like NewError, but the returned LfrError wraps kind, e.g.
+// ErrParse, so that it can be detected with errors.Is. kind's message isn't
+// added to message.
+func NewKindError(function, arg, message string, kind error) LfrError {
+	return LfrError{function, arg, message, kind}
+}
+
+// HashStrings returns the hex-encoded SHA-256 hash of strs. Each string is
+// prefixed with its length, so that ["ab", "c"] and ["a", "bc"] have different
+// hashes.
+func HashStrings(strs []string) string {
+	h := sha256.New()
+	for _, str := range strs {
+		fmt.Fprintf(h, "%d:%s", len(str), str)
+	}
+	return hex.EncodeToString(h.Sum(nil))
 }
 
 // GetPageID takes the root node of a page and returns its ID.
 func GetPageID(doc *html.Node) (int, error) {
-	n, ok := scrape.Find(doc, isPageIDnode)
+	n, ok := scrape.Find(doc, scrapeutil.IsCanonicalLink)
 	if !ok {
 		return -1, NewError("GetPageID", "", "Failed to find ID node")
 	}
@@ -59,6 +188,17 @@ func GetPageID(doc *html.Node) (int, error) {
 	return pageID, nil
 }
 
+// GetPageDictionary takes the root node of a page and returns the name of the
+// dictionary in its canonical URL (see DictionaryName), e.g.
+// "francais-anglais", or "" if it has none.
+func GetPageDictionary(doc *html.Node) string {
+	n, ok := scrape.Find(doc, scrapeutil.IsCanonicalLink)
+	if !ok {
+		return ""
+	}
+	return DictionaryName(scrape.Attr(n, "href"))
+}
+
 // GetPageIDsFromURLs takes a slice of URLs and calls GetPageIDFromURL on each.
 func GetPageIDsFromURLs(urls []string) ([]int, error) {
 	out := make([]int, len(urls))
@@ -101,15 +241,170 @@ func GetSimilarWords(doc *html.Node) ([]string, error) {
 		if href == "" {
 			continue
 		}
-		str, err := url.PathUnescape(href)
+		origin, path := splitHref(href)
+		str, err := url.PathUnescape(path)
 		if err != nil {
 			return nil, NewError("GetSimilarWords", "", err.Error())
 		}
-		out = append(out, "https://larousse.fr" + str)
+		str = undoubleWord(stripTags(str), m)
+		out = append(out, origin + CleanWordText(str))
 	}
 	return out, nil
 }
 
+// AbsoluteURL returns href as an absolute URL. Relative hrefs are resolved
+// as described by BaseURL and protocol-relative ones ("//www.larousse.fr/...")
+// are given BaseURL's scheme, while absolute ones are returned unchanged.
+func AbsoluteURL(href string) string {
+	origin, path := splitHref(href)
+	return origin + path
+}
+
+// splitHref resolves href against linkOrigin and splits the result into its
+// origin, e.g. "https://www.larousse.fr", and the rest, which begins with a
+// slash. The rest of an absolute or root-relative href is left escaped exactly
+// as it was in href, since the scrapers unescape it themselves.
+func splitHref(href string) (origin, path string) {
+	origin = linkOrigin()
+	u, err := url.Parse(href)
+	switch {
+		case err != nil && !strings.HasPrefix(href, "/"):
+			return origin, "/" + href
+		case err != nil, u.Host == "" && strings.HasPrefix(href, "/"):
+			return origin, href
+		case u.Host == "":
+			// e.g. "mer/50591" or "../mer/50591"
+			root := &url.URL{Path: "/"}
+			return origin, root.ResolveReference(u).RequestURI()
+	}
+	if u.Scheme == "" {
+		u.Scheme = "https"
+		if base, err := url.Parse(origin); err == nil && base.Scheme != "" {
+			u.Scheme = base.Scheme
+		}
+	}
+	i := strings.Index(href, u.Host) + len(u.Host)
+	return u.Scheme + "://" + u.Host, href[i:]
+}
+
+// stripTags removes any HTML tags from a link's path.
+// 
+// Some links contain markup around a copyright or trademark symbol, e.g. the
+// Airbag link in "aire"
+// (https://www.larousse.fr/dictionnaires/francais-anglais/aire/1944) has the
+// path "/dictionnaires/francais-anglais/Airbag<sup>®</sup>/82998". The slash
+// in "</sup>" makes the URL unusable, so the path is reduced to
+// "/dictionnaires/francais-anglais/Airbag®/82998".
+func stripTags(path string) string {
+	var out strings.Builder
+	inTag := false
+	for _, r := range path {
+		switch {
+		case r == '<':
+			inTag = true
+		case r == '>' && inTag:
+			inTag = false
+		case !inTag:
+			out.WriteRune(r)
+		}
+	}
+	return out.String()
+}
+
+// undoubleWord replaces a path element that repeats the text of link twice
+// with the text itself.
+// 
+// Pages saved with wget store the Airbag link in "aire"
+// (https://www.larousse.fr/dictionnaires/francais-anglais/aire/1944) as
+// "/dictionnaires/francais-anglais/AirbagAirbag/82998", which doesn't lead
+// anywhere. Given the link's text "Airbag®", the path is reduced to
+// "/dictionnaires/francais-anglais/Airbag®/82998", the same as for pages
+// downloaded by http.Get. Only links whose text has a trademark or registered
+// symbol are changed, so words like "bonbon" are left alone.
+func undoubleWord(path string, link *html.Node) string {
+	var b strings.Builder
+	isText := func(n *html.Node) bool { return n.Type == html.TextNode }
+	for _, n := range scrape.FindAll(link, isText) {
+		b.WriteString(n.Data)
+	}
+	text := strings.TrimSpace(b.String())
+	word := NormalizeLemma(text)
+	if word == "" || word == text {
+		return path
+	}
+	elems := strings.Split(path, "/")
+	for i, elem := range elems {
+		if elem == word + word {
+			elems[i] = text
+		}
+	}
+	return strings.Join(elems, "/")
+}
+
+// Text returns the text of n like scrape.Text, or like EmphasisText if
+// PreserveEmphasis is true.
+func Text(n *html.Node) string {
+	if !PreserveEmphasis {
+		return scrape.Text(n)
+	}
+	return EmphasisText(n)
+}
+
+// EmphasisText returns the text of n like scrape.Text, i.e. its text nodes
+// trimmed and joined by single spaces, but with the text of italic elements
+// (<i> and <em>) surrounded by "*" and that of bold elements (<b> and
+// <strong>) surrounded by "**". For example, "<i>a</i> b <b>c</b>" becomes
+// "*a* b **c**".
+func EmphasisText(n *html.Node) string {
+	return strings.Join(emphasisParts(n, nil), " ")
+}
+
+// emphasisParts appends the trimmed, non-empty pieces of n's text to parts,
+// with each emphasized element as a single piece, as in EmphasisText.
+func emphasisParts(n *html.Node, parts []string) []string {
+	if n.Type == html.TextNode {
+		if str := strings.TrimSpace(n.Data); str != "" {
+			parts = append(parts, str)
+		}
+		return parts
+	}
+	
+	var mark string
+	switch n.DataAtom {
+		case atom.I, atom.Em:     mark = "*"
+		case atom.B, atom.Strong: mark = "**"
+	}
+	var inner []string
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		inner = emphasisParts(c, inner)
+	}
+	if mark != "" && len(inner) > 0 {
+		return append(parts, mark + strings.Join(inner, " ") + mark)
+	}
+	return append(parts, inner...)
+}
+
+// CleanWordText returns str without trademark and registered symbols if
+// StripTrademarks is true. Otherwise, str is returned unchanged.
+func CleanWordText(str string) string {
+	if !StripTrademarks {
+		return str
+	}
+	str = strings.ReplaceAll(str, "®", "")
+	str = strings.ReplaceAll(str, "™", "")
+	return str
+}
+
+// NormalizeLemma returns str, a headword, without trademark and registered
+// symbols and with its whitespace trimmed and collapsed to single spaces, e.g.
+// " Airbag® " becomes "Airbag". Unlike CleanWordText, the symbols are removed
+// whatever StripTrademarks is, so that the result is a stable key.
+func NormalizeLemma(str string) string {
+	str = strings.ReplaceAll(str, "®", "")
+	str = strings.ReplaceAll(str, "™", "")
+	return strings.Join(strings.Fields(str), " ")
+}
+
 // GetSearchSuggestions takes a "word not found" page and returns a list of
 // search suggestions, if any are provided.
 func GetSearchSuggestions(doc *html.Node) []string {
@@ -119,13 +414,83 @@ func GetSearchSuggestions(doc *html.Node) []string {
 		liNodes := scrape.FindAll(n, scrape.ByTag(atom.Li))
 		for _, li := range liNodes {
 			a, _ := scrape.Find(li, scrape.ByTag(atom.A))
-			str := scrape.Attr(a, "href")
-			out = append(out, "https://larousse.fr" + str)
+			origin, path := splitHref(scrape.Attr(a, "href"))
+			out = append(out, origin + CleanWordText(stripTags(path)))
 		}
 	}
 	return out
 }
 
+// RankSuggestions returns a copy of urls sorted by the Levenshtein distance
+// between query and each URL's word, closest first, e.g. for the suggestions
+// returned by GetSearchSuggestions. URLs at the same distance keep their
+// order, and urls itself isn't changed, so Larousse's own ranking remains
+// available.
+// 
+// The comparison is case-insensitive. A URL's word is the path element before
+// its page ID, e.g. "mère" in ".../francais-anglais/mère/50600".
+func RankSuggestions(query string, urls []string) []string {
+	query = strings.ToLower(query)
+	dists := make(map[string]int, len(urls))
+	for _, u := range urls {
+		dists[u] = levenshtein(query, strings.ToLower(urlWord(u)))
+	}
+	out := append([]string(nil), urls...)
+	sort.SliceStable(out, func(i, j int) bool {
+		return dists[out[i]] < dists[out[j]]
+	})
+	return out
+}
+
+// urlWord returns the word in a dictionary URL, i.e. the unescaped path
+// element before the page ID.
+func urlWord(u string) string {
+	elems := strings.Split(strings.TrimSuffix(u, "/"), "/")
+	if len(elems) < 2 {
+		return u
+	}
+	word := elems[len(elems)-2]
+	unescaped, err := url.PathUnescape(word)
+	if err != nil {
+		return word
+	}
+	return unescaped
+}
+
+// levenshtein returns the number of single-character insertions, deletions
+// and substitutions needed to change a into b.
+func levenshtein(a, b string) int {
+	r, s := []rune(a), []rune(b)
+	prev := make([]int, len(s)+1)
+	cur := make([]int, len(s)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(r); i++ {
+		cur[0] = i
+		for j := 1; j <= len(s); j++ {
+			cost := 1
+			if r[i-1] == s[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(s)]
+}
+
+// min returns the smallest of a, b and c.
+func min(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
 // IsWordNotFoundPage returns true if doc is the root of a "word not found"
 // page.
 func IsWordNotFoundPage(doc *html.Node) bool {
@@ -133,14 +498,52 @@ func IsWordNotFoundPage(doc *html.Node) bool {
 	return ok
 }
 
-// IsURL verifies if str is a valid URL to a Larousse dictionary page. If it is,
-// true and "" are returned. Otherwise, false and a message describing the
-// problem are returned.
+// EscapeWord returns word as it appears in a dictionary URL: spaces are
+// replaced by hyphens, as on Larousse, and the result is percent-encoded, e.g.
+// "aujourd%27hui" for "aujourd'hui" and "%C3%A9t%C3%A9" for "été".
+func EscapeWord(word string) string {
+	return url.PathEscape(strings.ReplaceAll(word, " ", "-"))
+}
+
+// NormalizeURL returns a valid dictionary URL (see IsURL) with each element of
+// its path escaped by EscapeWord, so that a URL whose word is already
+// percent-encoded and one whose word isn't both refer to the page that New
+// would download. If str can't be parsed, it's returned as is.
+func NormalizeURL(str string) string {
+	u, err := url.Parse(str)
+	if err != nil {
+		return str
+	}
+	elems := strings.Split(u.Path, "/")
+	for i, e := range elems {
+		elems[i] = EscapeWord(e)
+	}
+	escaped := strings.Join(elems, "/")
+	u.RawPath = escaped
+	u.Path, err = url.PathUnescape(escaped)
+	if err != nil {
+		return str
+	}
+	return u.String()
+}
+
+// WordURL returns the URL of word's page in the dictionary dict, e.g.
+// "francais" or "francais-anglais", under BaseURL.
+func WordURL(dict, word string) string {
+	return strings.TrimSuffix(BaseURL, "/") + "/dictionnaires/" + dict + "/" + EscapeWord(word)
+}
+
+// IsURL verifies if str is a valid URL to a Larousse dictionary page, either
+// under BaseURL or on a larousse.fr host. If it is, true and "" are returned.
+// Otherwise, false and a message describing the problem are returned.
 func IsURL(str string) (bool, string) {
 	_, err := url.PathUnescape(str)
 	if err != nil {
 		return false, err.Error()
 	}
+	if strings.HasPrefix(str, strings.TrimSuffix(BaseURL, "/") + "/dictionnaires/") {
+		return true, ""
+	}
 	
 	url, err := url.Parse(str)
 	if err != nil {
@@ -151,12 +554,10 @@ func IsURL(str string) (bool, string) {
 		return false, "Hostname must contain larousse.fr"
 	}
 	
-	i := strings.Index(str, "larousse.fr") // reject if has "//" after protocol
-	substr := str[i+11:]
-	if strings.Contains(substr, "//") {
+	if strings.Contains(url.Path, "//") {
 		return false, "Found \"//\""
-	} else if !strings.Contains(str, "larousse.fr/dictionnaires/") {
-		return false, "URL must contain \"larousse.fr/dictionnaires/\""
+	} else if !strings.HasPrefix(url.Path, "/dictionnaires/") {
+		return false, "Path must begin with \"/dictionnaires/\""
 	}
 	
 	return true, ""
@@ -173,25 +574,264 @@ func urlHasAllowedScheme(in *url.URL) bool {
 	return false
 }
 
+// UniqueLinks returns the non-empty URLs in urls with duplicates removed, in
+// the order they first appear. Relative URLs are made absolute with
+// AbsoluteURL. URLs which only differ by their escaping, e.g.
+// ".../cl%C3%A9/16470" and ".../clé/16470", are duplicates.
+func UniqueLinks(urls []string) []string {
+	var out []string
+	seen := map[string]bool{}
+	for _, u := range urls {
+		if u == "" {
+			continue
+		}
+		u = AbsoluteURL(u)
+		key := u
+		if unescaped, err := url.PathUnescape(u); err == nil {
+			key = unescaped
+		}
+		if !seen[key] {
+			seen[key] = true
+			out = append(out, u)
+		}
+	}
+	return out
+}
+
+// RenderHTML returns the outer HTML of each node in nodes, concatenated. Nil
+// nodes are skipped.
+func RenderHTML(nodes ...*html.Node) string {
+	var buf bytes.Buffer
+	for _, n := range nodes {
+		if n != nil {
+			html.Render(&buf, n)
+		}
+	}
+	return buf.String()
+}
+
 // GetAudioURL takes an <audio> node containing a link to a TTS audio file
 // and extracts the URL from it.
 // 
 // All URLs to larousse.fr/dictionnaires-prononciation/x/tts/... always redirect
-// to voix.larousse.fr.
+// to voix.larousse.fr. Any other src, e.g. one which already points to
+// voix.larousse.fr, is returned as an absolute URL.
+// 
+// If AudioURLRewriter is set, the URL is passed through it.
 func GetAudioURL(n *html.Node) string {
 	src := scrape.Attr(n, "src")
 	if src == "" {
 		return ""
 	}
-	
-	str := src[29:] // after "/dictionnaires-prononciation/"
+	url := audioURL(src)
+	if AudioURLRewriter != nil {
+		url = AudioURLRewriter(url)
+	}
+	return url
+}
+
+// AudioClip is an audio clip together with its label. Larousse labels some
+// clips by region or accent, e.g. "Québec", to tell a word's pronunciations
+// apart. Label is empty if the clip has none.
+type AudioClip struct {
+	URL   string
+	Label string
+}
+
+// GetAudioClip takes an <audio> node and returns its URL, as given by
+// GetAudioURL, along with its label, as given by GetAudioLabel.
+func GetAudioClip(n *html.Node) AudioClip {
+	return AudioClip{GetAudioURL(n), GetAudioLabel(n)}
+}
+
+// GetAudioClips takes a list of <audio> nodes and returns their clips, as given
+// by GetAudioClip, without duplicate or empty URLs, in the order they appear.
+func GetAudioClips(nodes []*html.Node) []AudioClip {
+	var out []AudioClip
+	seen := make(map[string]bool)
+	for _, n := range nodes {
+		clip := GetAudioClip(n)
+		if clip.URL == "" || seen[clip.URL] {
+			continue
+		}
+		seen[clip.URL] = true
+		out = append(out, clip)
+	}
+	return out
+}
+
+// AudioClipURLs returns the URL of each clip in clips.
+func AudioClipURLs(clips []AudioClip) []string {
+	var out []string
+	for _, clip := range clips {
+		out = append(out, clip.URL)
+	}
+	return out
+}
+
+// GetAudioLabel takes an <audio> node and returns the region label which
+// follows it, i.e. the text of a "RegionSon" node that is its next sibling
+// besides whitespace, without its parentheses, e.g. "Québec" for "(Québec)".
+// If there's none, an empty string is returned.
+func GetAudioLabel(n *html.Node) string {
+	m := n.NextSibling
+	for m != nil && m.Type == html.TextNode && strings.TrimSpace(m.Data) == "" {
+		m = m.NextSibling
+	}
+	if m == nil || scrape.Attr(m, "class") != "RegionSon" {
+		return ""
+	}
+	return strings.TrimSpace(strings.Trim(strings.TrimSpace(scrape.Text(m)), "()"))
+}
+
+// audioURL returns the voix.larousse.fr URL of the clip whose <audio> node's
+// src is src, as described by GetAudioURL.
+func audioURL(src string) string {
+	const prefix = "/dictionnaires-prononciation/"
+	str := strings.TrimPrefix(src, prefix)
 	i := strings.IndexByte(str, '/')
 	j := strings.LastIndexByte(str, '/')
+	if str == src || i < 0 {
+		return AbsoluteURL(src)
+	}
 	
 	lang := str[:i]
 	filename := str[j+1:]
-	url := fmt.Sprintf("https://voix.larousse.fr/%s/%s.mp3", lang, filename)
-	return url
+	return fmt.Sprintf("https://voix.larousse.fr/%s/%s.mp3", lang, filename)
+}
+
+// GetConjugaisonURL takes a node and returns the absolute URL of the first
+// link to a Larousse conjugation page inside it, i.e. one whose path begins with
+// "/conjugaison/". If there's no such link, an empty string is returned.
+func GetConjugaisonURL(n *html.Node) string {
+	a, ok := scrape.Find(n, func(m *html.Node) bool {
+		return m.DataAtom == atom.A && strings.Contains(scrape.Attr(m, "href"), "/conjugaison/")
+	})
+	if !ok {
+		return ""
+	}
+	return AbsoluteURL(scrape.Attr(a, "href"))
+}
+
+// SplitPhonetic splits phonetic, the text of one or more "Phonetique" spans,
+// into the pronunciation of each form, keeping their square brackets, e.g.
+// "[vεr, vεrt]" becomes "[vεr]" and "[vεrt]", and "[meɪk][meɪd]" becomes
+// "[meɪk]" and "[meɪd]".
+func SplitPhonetic(phonetic string) []string {
+	var out []string
+	for _, group := range strings.Split(phonetic, "[")[1:] {
+		group = strings.SplitN(group, "]", 2)[0]
+		for _, p := range strings.Split(group, ",") {
+			p = strings.TrimSpace(p)
+			if p != "" {
+				out = append(out, "[" + p + "]")
+			}
+		}
+	}
+	return out
+}
+
+// IsVerbType returns true if typ, a header's grammatical type such as
+// "verbe transitif" or "transitive verb", denotes a verb. Locutions, e.g.
+// "locution verbale", aren't verbs.
+func IsVerbType(typ string) bool {
+	for _, f := range strings.Fields(strings.ToLower(typ)) {
+		if f == "verbe" || f == "verb" {
+			return true
+		}
+	}
+	return false
+}
+
+// PartsOfSpeech returns the grammatical categories named by types, which are
+// headers' grammatical types such as "adjectif" or "nom masculin, nom
+// féminin", without duplicates, in the order they first appear. Types naming
+// several categories are split at their commas, and whitespace is collapsed.
+func PartsOfSpeech(types ...string) []string {
+	var out []string
+	seen := make(map[string]bool)
+	for _, typ := range types {
+		for _, pos := range strings.Split(typ, ",") {
+			pos = strings.Join(strings.Fields(pos), " ")
+			if pos == "" || seen[pos] {
+				continue
+			}
+			seen[pos] = true
+			out = append(out, pos)
+		}
+	}
+	return out
+}
+
+// resolvedAudioURLs caches the results of ResolveAudioURL.
+var resolvedAudioURLs = struct {
+	sync.Mutex
+	m map[string]string
+}{m: make(map[string]string)}
+
+// ResolveAudioURL takes an audio URL, such as one returned by GetAudioURL, and
+// returns the URL it finally redirects to, found by making a HEAD request with
+// scrapeutil.Client.
+// 
+// GetAudioURL assumes that every clip is found at voix.larousse.fr, which is
+// almost always the case. This can be used when that isn't good enough, at the
+// cost of one request per clip. Resolved URLs are cached, so each clip is only
+// requested once.
+func ResolveAudioURL(audioURL string) (string, error) {
+	resolvedAudioURLs.Lock()
+	resolved, ok := resolvedAudioURLs.m[audioURL]
+	resolvedAudioURLs.Unlock()
+	if ok {
+		return resolved, nil
+	}
+	
+	req, err := scrapeutil.NewRequest(context.Background(), http.MethodHead, audioURL)
+	if err != nil {
+		return "", WrapError("ResolveAudioURL", audioURL, "", err)
+	}
+	res, err := scrapeutil.Client.Do(req)
+	if err != nil {
+		return "", WrapError("ResolveAudioURL", audioURL, "", err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", NewError("ResolveAudioURL", audioURL, fmt.Sprintf("HTTP %d", res.StatusCode))
+	}
+	resolved = res.Request.URL.String()
+	
+	resolvedAudioURLs.Lock()
+	resolvedAudioURLs.m[audioURL] = resolved
+	resolvedAudioURLs.Unlock()
+	return resolved, nil
+}
+
+// OpenAudio takes an audio URL, such as one returned by GetAudioURL, and
+// returns the clip's contents as a stream along with its content type, e.g.
+// "audio/mpeg". Redirects, such as the ones to voix.larousse.fr, are followed.
+// The clip is downloaded with scrapeutil.Client as it's read, so it can be
+// proxied without being saved to a file first.
+// 
+// The caller must close the returned io.ReadCloser.
+func OpenAudio(audioURL string) (io.ReadCloser, string, error) {
+	return OpenAudioContext(context.Background(), audioURL)
+}
+
+// OpenAudioContext is like OpenAudio, but the download uses the Config carried
+// by ctx (see scrapeutil.WithConfig) and is cancelled when ctx is done.
+func OpenAudioContext(ctx context.Context, audioURL string) (io.ReadCloser, string, error) {
+	req, err := scrapeutil.NewRequest(ctx, http.MethodGet, audioURL)
+	if err != nil {
+		return nil, "", WrapError("OpenAudio", audioURL, "", err)
+	}
+	res, err := scrapeutil.ConfigFrom(ctx).Client.Do(req)
+	if err != nil {
+		return nil, "", WrapError("OpenAudio", audioURL, "", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, "", NewError("OpenAudio", audioURL, fmt.Sprintf("HTTP %d", res.StatusCode))
+	}
+	return res.Body, res.Header.Get("Content-Type"), nil
 }
 
 // hasSuggestions returns true if this "word not found" page has search
@@ -206,9 +846,3 @@ func hasSuggestions(doc *html.Node) bool {
 	_, ok := scrape.Find(doc, scrape.ByClass("err"))
 	return !ok
 }
-
-// isPageIDnode returns true if n is a <link> node containing the page URL, from
-// which the page ID can be exracted.
-func isPageIDnode(n *html.Node) bool {
-	return n.DataAtom == atom.Link && scrape.Attr(n, "rel") == "canonical"
-}
@@ -5,8 +5,20 @@
 package laroussefr
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
 	"testing"
+	
+	"github.com/serope/laroussefr/scrapeutil"
+	
+	"golang.org/x/net/html"
 )
 
 // TestIsURL tests IsURL on good and bad values.
@@ -35,3 +47,448 @@ func TestIsURL(t *testing.T) {
 		}
 	}
 }
+
+
+// TestResolveAudioURL tests ResolveAudioURL on a redirecting server, and that
+// the result is cached.
+func TestResolveAudioURL(t *testing.T) {
+	var requests int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/dictionnaires-prononciation/francais/tts/36338fra2", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.Redirect(w, r, "/francais/36338fra2.mp3", http.StatusFound)
+	})
+	mux.HandleFunc("/francais/36338fra2.mp3", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "audio/mpeg")
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	
+	in := server.URL + "/dictionnaires-prononciation/francais/tts/36338fra2"
+	want := server.URL + "/francais/36338fra2.mp3"
+	for i := 0; i < 2; i++ {
+		got, err := ResolveAudioURL(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Fatalf("want %s, got %s", want, got)
+		}
+	}
+	if requests != 1 {
+		t.Fatalf("requests: want 1, got %d", requests)
+	}
+}
+
+// TestOpenAudio tests that OpenAudio follows a redirect and streams the clip
+// with its content type.
+func TestOpenAudio(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/dictionnaires-prononciation/francais/tts/36338fra2", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/francais/36338fra2.mp3", http.StatusFound)
+	})
+	mux.HandleFunc("/francais/36338fra2.mp3", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "audio/mpeg")
+		w.Write([]byte("ID3 clip"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	
+	rc, contentType, err := OpenAudio(server.URL + "/dictionnaires-prononciation/francais/tts/36338fra2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+	data, err := ioutil.ReadAll(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentType != "audio/mpeg" || string(data) != "ID3 clip" {
+		t.Fatalf("want audio/mpeg \"ID3 clip\", got %s %q", contentType, data)
+	}
+	
+	_, _, err = OpenAudio(server.URL + "/francais/missing.mp3")
+	if err == nil {
+		t.Fatal("want an error for a missing clip")
+	}
+}
+
+// TestAudioOfflineMode tests that ResolveAudioURL and OpenAudio send no request
+// in offline mode, and that their errors wrap scrapeutil.ErrOfflineMode.
+func TestAudioOfflineMode(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+	defer func() { scrapeutil.OfflineMode = false }()
+	scrapeutil.OfflineMode = true
+	
+	_, err := ResolveAudioURL(server.URL + "/francais/36338fra2.mp3")
+	if !errors.Is(err, scrapeutil.ErrOfflineMode) {
+		t.Fatalf("ResolveAudioURL: want ErrOfflineMode, got %v", err)
+	}
+	_, _, err = OpenAudio(server.URL + "/francais/36338fra2.mp3")
+	if !errors.Is(err, scrapeutil.ErrOfflineMode) {
+		t.Fatalf("OpenAudio: want ErrOfflineMode, got %v", err)
+	}
+	if requests != 0 {
+		t.Fatalf("requests: want 0, got %d", requests)
+	}
+}
+
+// TestRankSuggestions tests that suggestions are sorted by edit distance and
+// that the original slice is left alone.
+func TestRankSuggestions(t *testing.T) {
+	urls := []string{
+		"https://larousse.fr/dictionnaires/francais-anglais/mec/50060",
+		"https://larousse.fr/dictionnaires/francais-anglais/m%C3%A8re/50600",
+		"https://larousse.fr/dictionnaires/francais-anglais/merci/50595",
+		"https://larousse.fr/dictionnaires/francais-anglais/mer/50591",
+	}
+	want := []string{urls[3], urls[0], urls[1], urls[2]}
+	got := RankSuggestions("Mer", urls)
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("want %q, got %q", want, got)
+		}
+	}
+	if urls[0] != "https://larousse.fr/dictionnaires/francais-anglais/mec/50060" {
+		t.Fatal("urls was modified")
+	}
+}
+
+// diffResult is a Differ for testing DiffResults.
+type diffResult []DiffItem
+
+func (r diffResult) DiffItems() []DiffItem {
+	return r
+}
+
+// TestDiffResults tests that DiffResults reports added, removed and changed
+// items section by section.
+func TestDiffResults(t *testing.T) {
+	oldRes := diffResult{
+		{"Header", "arbre | nom masculin"},
+		{"Definitions", "Végétal vivace."},
+		{"Definitions", "Figure arborescente."},
+		{"Definitions", "Chimie | Dépôts métalliques."},
+		{"Citations", "Victor Hugo | L'arbre est la vie."},
+	}
+	newRes := diffResult{
+		{"Header", "arbre | nom masculin"},
+		{"Definitions", "Plante vivace."},
+		{"Definitions", "Figure arborescente."},
+		{"Definitions", "Chimie | Dépôts métalliques."},
+		{"Definitions", "Informatique | Graphe."},
+		{"Expressions", "L'arbre cache la forêt."},
+	}
+	want := []Change{
+		{"Definitions", Changed, "Végétal vivace.", "Plante vivace."},
+		{"Definitions", Added, "", "Informatique | Graphe."},
+		{"Citations", Removed, "Victor Hugo | L'arbre est la vie.", ""},
+		{"Expressions", Added, "", "L'arbre cache la forêt."},
+	}
+	got := DiffResults(oldRes, newRes)
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("want %v\ngot  %v", want, got)
+	}
+	if len(DiffResults(oldRes, oldRes)) != 0 {
+		t.Fatal("want no changes between identical results")
+	}
+}
+
+// TestGetSimilarWords tests GetSimilarWords on a carousel mixing relative,
+// absolute and protocol-relative links.
+func TestGetSimilarWords(t *testing.T) {
+	f, err := os.Open("testdata/carousel.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	doc, err := html.Parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	
+	got, err := GetSimilarWords(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"https://larousse.fr/dictionnaires/francais-anglais/mère/50600",
+		"https://www.larousse.fr/dictionnaires/francais-anglais/merci/50595",
+		"https://www.larousse.fr/dictionnaires/francais-anglais/mercerie/50593",
+		"http://larousse.fr/dictionnaires/francais-anglais/mec/50060",
+		"https://larousse.fr/mercredi/50598",
+		"https://larousse.fr/dictionnaires/francais-anglais/Airbag®/82998",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("want %q\ngot  %q", want, got)
+	}
+	
+	for _, u := range got {
+		if strings.Count(u, "://") != 1 {
+			t.Errorf("malformed URL %q", u)
+		}
+	}
+}
+
+// TestResolveLink tests ResolveLink on complete links, which need no request,
+// and on an ID-only link, whose canonical URL is downloaded.
+func TestResolveLink(t *testing.T) {
+	var requests int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/dictionnaires/francais/49069", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`<html><head><link rel="canonical" href="https://www.larousse.fr/dictionnaires/francais/manger/49069"></head></html>`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	
+	cases := []struct{
+		href string
+		url  string
+		kind string
+	}{
+		{"/dictionnaires/francais-anglais/mer/50591", "https://larousse.fr/dictionnaires/francais-anglais/mer/50591", LinkTraduction},
+		{"//www.larousse.fr/dictionnaires/synonymes/beau", "https://www.larousse.fr/dictionnaires/synonymes/beau", LinkSynonymes},
+		{server.URL + "/dictionnaires/francais/49069", "https://www.larousse.fr/dictionnaires/francais/manger/49069", LinkDefinition},
+	}
+	for _, c := range cases {
+		u, kind, err := ResolveLink(c.href)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u != c.url || kind != c.kind {
+			t.Errorf("%s: want %s (%s), got %s (%s)", c.href, c.url, c.kind, u, kind)
+		}
+	}
+	if requests != 1 {
+		t.Fatalf("want 1 request, got %d", requests)
+	}
+	
+	_, _, err := ResolveLink(server.URL + "/dictionnaires/francais/1")
+	if err == nil {
+		t.Fatal("want an error for a missing page")
+	}
+}
+
+// exportResult is a Page for testing ExportWord.
+type exportResult struct {
+	PageID    int
+	Audio     string
+	AudioURLs []string
+	Phrases   []struct{ Audio1, Audio2 string }
+}
+
+func (r exportResult) ID() int               { return r.PageID }
+func (r exportResult) SeeAlsoURLs() []string { return nil }
+func (r exportResult) NotFound() bool        { return false }
+func (r exportResult) HasAudio() bool        { return r.Audio != "" }
+
+// TestExportWord tests that ExportWord downloads each clip once, rewrites its
+// URLs to the local file, and keeps the URL of a clip that can't be
+// downloaded.
+func TestExportWord(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/francais/16869A.mp3", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ID3 aire"))
+	})
+	mux.HandleFunc("/francais/110957fra2.mp3", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ID3 aire de jeu"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	
+	res := exportResult{
+		PageID:    1944,
+		Audio:     server.URL + "/francais/16869A.mp3",
+		AudioURLs: []string{server.URL + "/francais/16869A.mp3"},
+		Phrases:   []struct{ Audio1, Audio2 string }{
+			{server.URL + "/francais/110957fra2.mp3", server.URL + "/anglais/missing.mp3"},
+		},
+	}
+	dir, err := ioutil.TempDir("", "export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	
+	err = ExportWord(res, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(dir + "/" + ExportFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got exportResult
+	err = json.Unmarshal(data, &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := exportResult{
+		PageID:    1944,
+		Audio:     "16869A.mp3",
+		AudioURLs: []string{"16869A.mp3"},
+		Phrases:   []struct{ Audio1, Audio2 string }{
+			{"110957fra2.mp3", server.URL + "/anglais/missing.mp3"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("want %+v\ngot  %+v", want, got)
+	}
+	clip, err := ioutil.ReadFile(dir + "/110957fra2.mp3")
+	if err != nil || string(clip) != "ID3 aire de jeu" {
+		t.Fatalf("want clip \"ID3 aire de jeu\", got %q (%v)", clip, err)
+	}
+	if res.Audio != server.URL + "/francais/16869A.mp3" {
+		t.Fatal("result was modified")
+	}
+}
+
+// TestEmphasisText tests EmphasisText, and that Text only keeps emphasis if
+// PreserveEmphasis is true.
+func TestEmphasisText(t *testing.T) {
+	doc, err := html.Parse(strings.NewReader(`<p>prendre <i>qqch</i>  à <b>quelqu'un</b> : <em>il <strong>lui</strong> prend</em> la main</p>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "prendre *qqch* à **quelqu'un** : *il **lui** prend* la main"
+	if got := EmphasisText(doc); got != want {
+		t.Fatalf("want %q, got %q", want, got)
+	}
+	
+	plain := "prendre qqch à quelqu'un : il lui prend la main"
+	if got := Text(doc); got != plain {
+		t.Fatalf("PreserveEmphasis false: want %q, got %q", plain, got)
+	}
+	PreserveEmphasis = true
+	defer func() { PreserveEmphasis = false }()
+	if got := Text(doc); got != want {
+		t.Fatalf("PreserveEmphasis true: want %q, got %q", want, got)
+	}
+}
+
+// TestPermalink tests Permalink on an element of a page, on the page's first
+// word, and on a missing ID.
+func TestPermalink(t *testing.T) {
+	page := "https://www.larousse.fr/dictionnaires/francais-anglais/coup/19682"
+	cases := []struct{
+		url  string
+		id   int
+		want string
+	}{
+		{page, 975605, page + "#975605"},
+		{page + "#123", 975605, page + "#975605"},
+		{page, 19682, page},
+		{page, 0, ""},
+	}
+	for _, c := range cases {
+		if got := Permalink(c.url, c.id); got != c.want {
+			t.Errorf("Permalink(%s, %d): want %q, got %q", c.url, c.id, c.want, got)
+		}
+	}
+}
+
+// TestBaseURL tests that WordURL, IsURL, AbsoluteURL, DictionaryKind and
+// ResolveLink follow BaseURL.
+func TestBaseURL(t *testing.T) {
+	defer func(old string) { BaseURL = old }(BaseURL)
+	if got := WordURL("francais", "vert"); got != "https://www.larousse.fr/dictionnaires/francais/vert" {
+		t.Fatalf("want the default host, got %s", got)
+	}
+	
+	BaseURL = "http://localhost:8080/"
+	got := WordURL("francais-anglais", "œil")
+	if got != "http://localhost:8080/dictionnaires/francais-anglais/%C5%93il" {
+		t.Fatalf("want the local host, got %s", got)
+	}
+	if ok, message := IsURL(got); !ok {
+		t.Fatalf("want a URL under BaseURL accepted, got %s", message)
+	}
+	if ok, _ := IsURL("http://localhost:8080/other"); ok {
+		t.Fatal("want a URL outside of the dictionaries rejected")
+	}
+	
+	if got := AbsoluteURL("/dictionnaires/francais/arbre/4974"); got != "http://localhost:8080/dictionnaires/francais/arbre/4974" {
+		t.Fatalf("want a relative href resolved against BaseURL, got %s", got)
+	}
+	if got := AbsoluteURL("//www.larousse.fr/dictionnaires/francais/arbre/4974"); got != "http://www.larousse.fr/dictionnaires/francais/arbre/4974" {
+		t.Fatalf("want BaseURL's scheme, got %s", got)
+	}
+	if kind := DictionaryKind(got); kind != LinkTraduction {
+		t.Fatalf("want %s for a URL under BaseURL, got %q", LinkTraduction, kind)
+	}
+	u, kind, err := ResolveLink("/dictionnaires/synonymes/beau")
+	if err != nil || u != "http://localhost:8080/dictionnaires/synonymes/beau" || kind != LinkSynonymes {
+		t.Fatalf("want the link resolved under BaseURL, got %s (%s) and %v", u, kind, err)
+	}
+}
+
+// TestSplitSyllables tests SplitSyllables on transcriptions from Larousse
+// pages and on ones with explicit boundaries.
+func TestSplitSyllables(t *testing.T) {
+	cases := map[string][]string {
+		"":              nil,
+		"[tyk]":         {"tyk"},
+		"[mɑ̃ʒe]":        {"mɑ̃", "ʒe"},
+		"[vənir]":       {"və", "nir"},
+		"[nwajo]":       {"nwa", "jo"},
+		"[kur, kurt]":   {"kur"},
+		"[fɔː friː]":    {"fɔː", "friː"},
+		"[meɪk]":        {"meɪk"},
+		"[ɛ̃.kɔ.ny]":     {"ɛ̃", "kɔ", "ny"},
+		"[ˈwɔːtəˌfɔːl]": {"ˈwɔː", "tə", "ˌfɔːl"},
+		"[kɔ̃tr]":        {"kɔ̃tr"},
+		"[apʁɑ̃dʁ]":      {"a", "pʁɑ̃dʁ"},
+		"[pɛrdy]":       {"pɛr", "dy"},
+	}
+	for ipa, want := range cases {
+		got := SplitSyllables(ipa)
+		if strings.Join(got, "|") != strings.Join(want, "|") || len(got) != len(want) {
+			t.Errorf("%s: want %q, got %q", ipa, want, got)
+		}
+	}
+}
+
+// TestSamePage tests SamePage on URLs of the same page written differently.
+func TestSamePage(t *testing.T) {
+	page := "https://www.larousse.fr/dictionnaires/francais-anglais/Airbag®/82998"
+	cases := map[string]bool{
+		"//larousse.fr/dictionnaires/francais-anglais/Airbag%C2%AE/82998/":       true,
+		"http://www.larousse.fr/dictionnaires/francais-anglais/airbag/82998?q=1": true,
+		page + "#12345":                                                          true,
+		"https://www.larousse.fr/dictionnaires/francais/Airbag/82998":            false,
+		"https://www.larousse.fr/dictionnaires/francais-anglais/aire/1944":       false,
+	}
+	for u, want := range cases {
+		got, err := SamePage(page, u)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("%s: want %v, got %v", u, want, got)
+		}
+	}
+	
+	_, err := SamePage(page, "https://www.larousse.fr/dictionnaires/francais/arbre")
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("URL without a page ID: want ErrInvalidInput, got %v", err)
+	}
+}
+
+// TestSortFrench tests that accented words are sorted with their unaccented
+// letters, as by golang.org/x/text/collate, and that duplicates are removed.
+func TestSortFrench(t *testing.T) {
+	words := []string{"côté", "effet", "œuvre", "cote", "écrire", "eau", "côte", "Zèbre", "coté", "oeuf", "eau", "à-côté", "abri", ""}
+	want := []string{"à-côté", "abri", "cote", "coté", "côte", "côté", "eau", "écrire", "effet", "oeuf", "œuvre", "Zèbre"}
+	got := SortFrench(words)
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("want %q, got %q", want, got)
+	}
+	if words[0] != "côté" {
+		t.Fatal("want words left unchanged")
+	}
+}
@@ -0,0 +1,202 @@
+package laroussefr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	
+	"github.com/serope/laroussefr/scrapeutil"
+	
+	"golang.org/x/net/html"
+	"github.com/yhat/scrape"
+)
+
+// Kinds of link returned by ResolveLink and DictionaryKind. They match the
+// dictionary names of package lookup.
+const (
+	LinkDefinition = "definition"
+	LinkSynonymes  = "synonymes"
+	LinkTraduction = "traduction"
+)
+
+// ResolveLink takes a link found on a Larousse page, e.g. in SeeAlso or among
+// synonyms, and returns its absolute, normalized URL along with the kind of
+// dictionary page it leads to (LinkDefinition, LinkSynonymes or
+// LinkTraduction).
+//
+// Relative and protocol-relative links are made absolute with AbsoluteURL.
+// If the link doesn't include the word, e.g. one made of a dictionary and a
+// page ID only, or its dictionary can't be told from its path, the page is
+// downloaded with scrapeutil.Client and its canonical URL is returned instead.
+// Other links are resolved without any request.
+func ResolveLink(href string) (finalURL string, kind string, err error) {
+	return ResolveLinkContext(context.Background(), href)
+}
+
+// ResolveLinkContext is like ResolveLink, but the download, if any, uses the
+// Config carried by ctx (see scrapeutil.WithConfig) and is cancelled when ctx
+// is done.
+func ResolveLinkContext(ctx context.Context, href string) (finalURL string, kind string, err error) {
+	if strings.TrimSpace(href) == "" {
+		return "", "", NewError("ResolveLink", href, "Empty link")
+	}
+	u := NormalizeURL(AbsoluteURL(href))
+	kind = DictionaryKind(u)
+	if kind != "" && hasWord(u) {
+		return u, kind, nil
+	}
+	
+	canonical, err := canonicalURL(ctx, u)
+	if err != nil {
+		return "", "", WrapError("ResolveLink", href, "", err)
+	}
+	canonical = NormalizeURL(canonical)
+	kind = DictionaryKind(canonical)
+	if kind == "" {
+		return "", "", NewError("ResolveLink", href, "Not a dictionary page: " + canonical)
+	}
+	return canonical, kind, nil
+}
+
+// DictionaryKind returns the kind of dictionary page at the URL u (LinkDefinition,
+// LinkSynonymes or LinkTraduction), going by its path, or "" if it can't be
+// told.
+func DictionaryKind(u string) string {
+	switch DictionaryName(u) {
+		case "francais":                             return LinkDefinition
+		case "synonymes":                            return LinkSynonymes
+		case "francais-anglais", "anglais-francais": return LinkTraduction
+	}
+	return ""
+}
+
+// dictionaryNames are the names of Larousse's dictionaries as they appear in
+// the paths of their pages.
+var dictionaryNames = []string{"francais", "synonymes", "francais-anglais", "anglais-francais"}
+
+// DictionaryName returns the name of the dictionary in the path of the URL u,
+// e.g. "francais-anglais" for
+// "https://www.larousse.fr/dictionnaires/francais-anglais/vert/611412", or ""
+// if u isn't the URL of a dictionary page. Only the path is looked at, so the
+// URL of a page under BaseURL is recognized whatever its host, even if BaseURL
+// has a path of its own.
+func DictionaryName(u string) string {
+	path := u
+	if parsed, err := url.Parse(u); err == nil {
+		path = parsed.Path
+	}
+	if base, err := url.Parse(strings.TrimSuffix(BaseURL, "/")); err == nil {
+		path = strings.TrimPrefix(path, base.Path)
+	}
+	for _, name := range dictionaryNames {
+		if strings.HasPrefix(path, "/dictionnaires/" + name + "/") {
+			return name
+		}
+	}
+	return ""
+}
+
+// hasWord returns true if the path of the dictionary URL u has a word after the
+// dictionary's name, as opposed to nothing or a page ID alone.
+func hasWord(u string) bool {
+	i := strings.Index(u, "/dictionnaires/")
+	if i == -1 {
+		return false
+	}
+	elems := strings.Split(strings.Trim(u[i+len("/dictionnaires/"):], "/"), "/")
+	if len(elems) < 2 || elems[1] == "" {
+		return false
+	}
+	_, err := strconv.Atoi(elems[1])
+	return err != nil
+}
+
+// canonicalURL downloads the page at u and returns its canonical URL, or the
+// URL it was redirected to if it has none.
+func canonicalURL(ctx context.Context, u string) (string, error) {
+	req, err := scrapeutil.NewRequest(ctx, http.MethodGet, u)
+	if err != nil {
+		return "", err
+	}
+	res, err := scrapeutil.ConfigFrom(ctx).Client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("HTTP %d", res.StatusCode)
+	}
+	doc, err := html.Parse(res.Body)
+	if err != nil {
+		return "", err
+	}
+	if n, ok := scrape.Find(doc, scrapeutil.IsCanonicalLink); ok && scrape.Attr(n, "href") != "" {
+		return AbsoluteURL(scrape.Attr(n, "href")), nil
+	}
+	return res.Request.URL.String(), nil
+}
+
+// Permalink returns a link to the element whose id is id on the page at
+// pageURL, i.e. pageURL with id as its fragment, e.g.
+// ".../francais/arbre/4974#1066420" for a citation on the "arbre" page. Any
+// fragment already in pageURL is replaced. If id is the page's own ID, which
+// is the code of a page's first word, pageURL is returned without a fragment,
+// since the page itself is the element. If id isn't positive, an empty string
+// is returned.
+func Permalink(pageURL string, id int) string {
+	if id <= 0 {
+		return ""
+	}
+	if i := strings.IndexByte(pageURL, '#'); i != -1 {
+		pageURL = pageURL[:i]
+	}
+	if pageID, err := GetPageIDFromURL(pageURL); err == nil && pageID == id {
+		return pageURL
+	}
+	return pageURL + "#" + strconv.Itoa(id)
+}
+
+// SamePage returns true if url1 and url2 lead to the same dictionary page,
+// going by the dictionary and page ID in their paths, e.g.
+// "https://www.larousse.fr/dictionnaires/francais-anglais/Airbag®/82998" and
+// "//larousse.fr/dictionnaires/francais-anglais/Airbag%C2%AE/82998/". Their
+// scheme, host, escaping, query, fragment and trailing slash, as well as the
+// word before the page ID, don't matter.
+// 
+// An error is returned if either URL doesn't end with a page ID, e.g. one made
+// by New, since those can only be told apart by downloading them.
+func SamePage(url1, url2 string) (bool, error) {
+	dict1, id1, err := pageKey(url1)
+	if err != nil {
+		return false, WrapError("SamePage", url1, "", err)
+	}
+	dict2, id2, err := pageKey(url2)
+	if err != nil {
+		return false, WrapError("SamePage", url2, "", err)
+	}
+	return dict1 == dict2 && id1 == id2, nil
+}
+
+// pageKey returns the dictionary name and page ID in the path of the
+// dictionary URL u, e.g. "francais" and 4974.
+func pageKey(u string) (string, int, error) {
+	if i := strings.IndexAny(u, "?#"); i != -1 {
+		u = u[:i]
+	}
+	u = strings.TrimRight(u, "/")
+	var dict string
+	if i := strings.Index(u, "/dictionnaires/"); i != -1 {
+		dict = strings.SplitN(u[i+len("/dictionnaires/"):], "/", 2)[0]
+	}
+	if dict == "" {
+		return "", -1, NewKindError("pageKey", u, "Not a dictionary page", ErrInvalidInput)
+	}
+	id, err := GetPageIDFromURL(u)
+	if err != nil {
+		return "", -1, NewKindError("pageKey", u, err.Error(), ErrInvalidInput)
+	}
+	return dict, id, nil
+}
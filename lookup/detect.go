@@ -0,0 +1,110 @@
+package lookup
+
+import (
+	"strings"
+	
+	"github.com/serope/laroussefr"
+	"github.com/serope/laroussefr/definition"
+	"github.com/serope/laroussefr/scrapeutil"
+	"github.com/serope/laroussefr/synonymes"
+	"github.com/serope/laroussefr/traduction"
+	
+	"github.com/yhat/scrape"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// DetectDictionary returns the dictionary (Definition, Synonymes or
+// Traduction) of the page whose root is doc, along with the languages of a
+// Traduction page, e.g. traduction.Fr and traduction.En for the
+// French-English dictionary. from and to are both traduction.Fr for the other
+// dictionaries, which are French only.
+// 
+// The page's URL isn't needed, so this works on saved HTML. The dictionary is
+// found from the page's canonical link, then its title, then the classes of
+// its header. An error is returned if none of them match.
+func DetectDictionary(doc *html.Node) (kind string, from, to traduction.Language, err error) {
+	if n, ok := scrape.Find(doc, scrapeutil.IsCanonicalLink); ok {
+		if kind, from, to, ok := dictionaryOfPath(scrape.Attr(n, "href")); ok {
+			return kind, from, to, nil
+		}
+	}
+	if n, ok := scrape.Find(doc, scrape.ByTag(atom.Title)); ok {
+		if kind, from, to, ok := dictionaryOfTitle(scrape.Text(n)); ok {
+			return kind, from, to, nil
+		}
+	}
+	if kind, from, to, ok := dictionaryOfMarkup(doc); ok {
+		return kind, from, to, nil
+	}
+	return "", traduction.Fr, traduction.Fr, laroussefr.NewError("DetectDictionary", "", "Unknown dictionary")
+}
+
+// ParseDocument scrapes the page whose root is doc with the package of its
+// dictionary, as found by DetectDictionary. Nothing is read or downloaded.
+// 
+// As in FromURL, the returned Page is nil if the page can't be scraped, except
+// for a "word not found" page.
+func ParseDocument(doc *html.Node) (laroussefr.Page, error) {
+	kind, _, _, err := DetectDictionary(doc)
+	if err != nil {
+		return nil, laroussefr.NewError("ParseDocument", "", err.Error())
+	}
+	switch kind {
+		case Definition: return page(definition.ParseDocument(doc))
+		case Synonymes:  return page(synonymes.ParseDocument(doc))
+	}
+	return page(traduction.ParseDocument(doc))
+}
+
+// dictionaryOfPath returns the dictionary and languages of the page at the URL
+// u, going by laroussefr.DictionaryName. ok is false if u isn't the URL of a
+// dictionary page.
+func dictionaryOfPath(u string) (kind string, from, to traduction.Language, ok bool) {
+	switch laroussefr.DictionaryName(u) {
+		case "francais-anglais": return Traduction, traduction.Fr, traduction.En, true
+		case "anglais-francais": return Traduction, traduction.En, traduction.Fr, true
+		case "synonymes":        return Synonymes, traduction.Fr, traduction.Fr, true
+		case "francais":         return Definition, traduction.Fr, traduction.Fr, true
+	}
+	return "", traduction.Fr, traduction.Fr, false
+}
+
+// dictionaryOfTitle is like dictionaryOfPath, but takes the text of the page's
+// <title>, e.g. "Traduction : vert - Dictionnaire Français-Anglais Larousse".
+func dictionaryOfTitle(title string) (kind string, from, to traduction.Language, ok bool) {
+	title = strings.ToLower(title)
+	switch {
+		case strings.Contains(title, "dictionnaire français-anglais"): return Traduction, traduction.Fr, traduction.En, true
+		case strings.Contains(title, "dictionnaire anglais-français"): return Traduction, traduction.En, traduction.Fr, true
+		case strings.Contains(title, "dictionnaire de synonymes"):     return Synonymes, traduction.Fr, traduction.Fr, true
+		case strings.Contains(title, "dictionnaire de français"):      return Definition, traduction.Fr, traduction.Fr, true
+	}
+	return "", traduction.Fr, traduction.Fr, false
+}
+
+// dictionaryOfMarkup is like dictionaryOfPath, but looks for the header of
+// each dictionary's pages. The languages of a bilingual page are taken from
+// the language of its headword's audio clip.
+func dictionaryOfMarkup(doc *html.Node) (kind string, from, to traduction.Language, ok bool) {
+	if _, ok := scrape.Find(doc, scrape.ByClass("AdresseDefinition")); ok {
+		return Definition, traduction.Fr, traduction.Fr, true
+	}
+	if _, ok := scrape.Find(doc, scrape.ByClass("AdresseSynonyme")); ok {
+		return Synonymes, traduction.Fr, traduction.Fr, true
+	}
+	zoneEntree, ok := scrape.Find(doc, scrape.ByClass("ZoneEntree"))
+	if !ok {
+		return "", traduction.Fr, traduction.Fr, false
+	}
+	audio, ok := scrape.Find(zoneEntree, scrape.ByTag(atom.Audio))
+	if !ok {
+		return "", traduction.Fr, traduction.Fr, false
+	}
+	src := scrape.Attr(audio, "src")
+	switch {
+		case strings.Contains(src, "/francais/"): return Traduction, traduction.Fr, traduction.En, true
+		case strings.Contains(src, "/anglais/"):  return Traduction, traduction.En, traduction.Fr, true
+	}
+	return "", traduction.Fr, traduction.Fr, false
+}
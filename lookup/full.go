@@ -0,0 +1,73 @@
+package lookup
+
+import (
+	"context"
+	"strings"
+	"sync"
+	
+	"github.com/serope/laroussefr"
+	"github.com/serope/laroussefr/definition"
+	"github.com/serope/laroussefr/traduction"
+)
+
+// Type FullResult holds a French word's page in the monolingual dictionary and
+// its page in the French-English dictionary, as returned by Full.
+// 
+// DefinitionErr and TraductionErr are the errors returned by definition.New and
+// traduction.New, respectively. A Result whose error is non-nil is whatever
+// that function returned, e.g. the "word not found" Result.
+type FullResult struct {
+	Definition    definition.Result
+	DefinitionErr error
+	Traduction    traduction.Result
+	TraductionErr error
+}
+
+// Full looks up a French word in both the monolingual dictionary and the
+// French-English dictionary, downloading the two pages concurrently. This is
+// the usual query of a French learner, who wants the word's definitions along
+// with its translations.
+// 
+// If either lookup fails, the other Result is still returned, and the error
+// describes every failure. The errors of each lookup are kept in FullResult.
+func Full(word string) (FullResult, error) {
+	return FullContext(context.Background(), word)
+}
+
+// FullContext is like Full, but the downloads are cancelled when ctx is done.
+func FullContext(ctx context.Context, word string) (FullResult, error) {
+	var res FullResult
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		res.Definition, res.DefinitionErr = definition.NewContext(ctx, word)
+	}()
+	go func() {
+		defer wg.Done()
+		res.Traduction, res.TraductionErr = traduction.NewContext(ctx, word, traduction.Fr, traduction.En)
+	}()
+	wg.Wait()
+	
+	var problems []string
+	if res.DefinitionErr != nil {
+		problems = append(problems, "definition: " + res.DefinitionErr.Error())
+	}
+	if res.TraductionErr != nil {
+		problems = append(problems, "traduction: " + res.TraductionErr.Error())
+	}
+	if len(problems) > 0 {
+		return res, laroussefr.NewError("Full", word, strings.Join(problems, "\n"))
+	}
+	return res, nil
+}
+
+// Full is like the Full function, but uses s's Config.
+func (s *Scraper) Full(word string) (FullResult, error) {
+	return FullContext(s.context(context.Background()), word)
+}
+
+// FullContext is like the FullContext function, but uses s's Config.
+func (s *Scraper) FullContext(ctx context.Context, word string) (FullResult, error) {
+	return FullContext(s.context(ctx), word)
+}
@@ -0,0 +1,105 @@
+package lookup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	
+	"github.com/serope/laroussefr"
+	"github.com/serope/laroussefr/definition"
+	"github.com/serope/laroussefr/synonymes"
+	"github.com/serope/laroussefr/traduction"
+)
+
+// healthCheck is a word whose page is known to have every section the
+// scrapers look for, and a function which checks that they were found.
+type healthCheck struct {
+	name  string
+	check func(ctx context.Context) []string
+}
+
+// healthChecks are run by HealthCheck.
+var healthChecks = []healthCheck{
+	{"definition arbre", func(ctx context.Context) []string {
+		res, err := definition.NewContext(ctx, "arbre")
+		if err != nil {
+			return []string{err.Error()}
+		}
+		return missing(map[string]bool{
+			"Header.Texte (AdresseDefinition)":  res.Header.Texte != "",
+			"Header.Type (CatgramDefinition)":   res.Header.Type != "",
+			"Definitions (DivisionDefinition)":  len(res.Definitions) > 0,
+			"Expressions (Locution)":            len(res.Expressions) > 0,
+			"Relations (SensSynonymes)":         len(res.Relations) > 0,
+		})
+	}},
+	{"traduction vert fr-en", func(ctx context.Context) []string {
+		res, err := traduction.NewContext(ctx, "vert", traduction.Fr, traduction.En)
+		if err != nil {
+			return []string{err.Error()}
+		}
+		if len(res.Words) == 0 {
+			return []string{"Words (ZoneEntree)"}
+		}
+		w := res.Words[0]
+		hasMeaning := len(w.Subheaders) > 0 && len(w.Subheaders[0].Items) > 0 && len(w.Subheaders[0].Items[0].Meanings) > 0
+		return missing(map[string]bool{
+			"Header.Text (Adresse)":           w.Header.Text != "",
+			"Header.Phonetic (Phonetique)":    w.Header.Phonetic != "",
+			"Meanings (itemZONESEM)":          hasMeaning,
+		})
+	}},
+	{"synonymes beau", func(ctx context.Context) []string {
+		res, err := synonymes.NewContext(ctx, "beau")
+		if err != nil {
+			return []string{err.Error()}
+		}
+		return missing(map[string]bool{
+			"Texte (AdresseSynonyme)":   res.Texte != "",
+			"Groups (DivisionSynonyme)": len(res.Groups) > 0,
+		})
+	}},
+}
+
+// missing returns the sorted names of the fields in found which are false.
+func missing(found map[string]bool) []string {
+	var out []string
+	for name, ok := range found {
+		if !ok {
+			out = append(out, name)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// HealthCheck scrapes a few words whose pages are known to be complete and
+// returns an error describing any field that came back empty, along with the
+// class of the node it's scraped from. A non-nil error usually means that
+// Larousse changed its markup, so it's useful to run periodically in order to
+// find out before a pipeline fills up with empty results.
+// 
+// HealthCheck downloads one page per dictionary.
+func HealthCheck() error {
+	return healthCheckContext(context.Background())
+}
+
+// HealthCheck is like the HealthCheck function, but uses s's Config.
+func (s *Scraper) HealthCheck() error {
+	return healthCheckContext(s.context(context.Background()))
+}
+
+// healthCheckContext runs healthChecks with ctx.
+func healthCheckContext(ctx context.Context) error {
+	var problems []string
+	for _, hc := range healthChecks {
+		for _, m := range hc.check(ctx) {
+			problems = append(problems, fmt.Sprintf("%s: %s", hc.name, m))
+		}
+	}
+	if len(problems) > 0 {
+		return laroussefr.NewError("HealthCheck", "", "Larousse's markup may have changed; missing:\n" + strings.Join(problems, "\n"))
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+// Package lookup provides functions which choose between packages definition,
+// synonymes and traduction, for programs which handle more than one kind of
+// Larousse dictionary.
+// 
+// These can't be part of package laroussefr itself, since every dictionary
+// package imports it.
+package lookup
+
+import (
+	"encoding/json"
+	
+	"github.com/serope/laroussefr"
+	"github.com/serope/laroussefr/definition"
+	"github.com/serope/laroussefr/synonymes"
+	"github.com/serope/laroussefr/traduction"
+)
+
+// Names of the dictionaries accepted by JSON.
+const (
+	Definition = "definition"
+	Synonymes  = "synonymes"
+	Traduction = "traduction"
+)
+
+// JSON searches for word in the dictionary dict (Definition, Synonymes or
+// Traduction) and returns the Result as indented JSON. The languages from and
+// to are only used by Traduction.
+// 
+// If the word doesn't exist, the JSON of the "word not found" Result is
+// returned along with the error, so that its search suggestions aren't lost.
+func JSON(word, dict string, from, to traduction.Language) ([]byte, error) {
+	var res interface{}
+	var err error
+	switch dict {
+		case Definition: res, err = definition.New(word)
+		case Synonymes:  res, err = synonymes.New(word)
+		case Traduction: res, err = traduction.New(word, from, to)
+		default:         return nil, laroussefr.NewError("JSON", dict, "Unknown dictionary")
+	}
+	
+	data, jsonErr := json.MarshalIndent(res, "", "\t")
+	if jsonErr != nil {
+		return nil, laroussefr.NewError("JSON", word, jsonErr.Error())
+	}
+	return data, err
+}
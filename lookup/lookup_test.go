@@ -0,0 +1,407 @@
+// lookup_test.go contains unit tests for exported functions.
+package lookup
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	
+	"github.com/serope/laroussefr"
+	"github.com/serope/laroussefr/definition"
+	"github.com/serope/laroussefr/scrapeutil"
+	"github.com/serope/laroussefr/synonymes"
+	"github.com/serope/laroussefr/traduction"
+)
+
+// TestJSONBad tests JSON on an unknown dictionary.
+func TestJSONBad(t *testing.T) {
+	data, err := JSON("arbre", "encyclopedie", traduction.Fr, traduction.En)
+	if err == nil || data != nil {
+		t.Fatal("unknown dictionary should be rejected")
+	}
+}
+
+// TestScraperConcurrent tests two Scrapers with different headers used at the
+// same time.
+func TestScraperConcurrent(t *testing.T) {
+	page, err := ioutil.ReadFile("../definition/testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	
+	var mu sync.Mutex
+	agents := map[string]int{}
+	client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		agents[req.Header.Get("User-Agent")]++
+		mu.Unlock()
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewReader(page)),
+			Header:     make(http.Header),
+			Request:    req,
+		}, nil
+	})}
+	
+	var scrapers []*Scraper
+	for _, agent := range []string{"a", "b"} {
+		s := NewScraper()
+		s.Config.Client = client
+		s.Config.Header = http.Header{"User-Agent": {agent}}
+		scrapers = append(scrapers, s)
+	}
+	
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(s *Scraper) {
+			defer wg.Done()
+			res, err := s.Definition("arbre")
+			if err != nil || res.PageID != 4974 {
+				t.Errorf("want page 4974, got %d and %v", res.PageID, err)
+			}
+		}(scrapers[i%2])
+	}
+	wg.Wait()
+	if agents["a"] != 5 || agents["b"] != 5 {
+		t.Fatalf("want 5 requests per User-Agent, got %v", agents)
+	}
+}
+
+// TestHealthCheck tests HealthCheck on complete pages and on a page missing
+// some sections.
+func TestHealthCheck(t *testing.T) {
+	pages := map[string]string{
+		"/dictionnaires/francais/arbre":       "../definition/testdata/arbre.html",
+		"/dictionnaires/francais-anglais/vert": "../traduction/testdata/make.html",
+		"/dictionnaires/synonymes/beau":       "../synonymes/testdata/beau.html",
+	}
+	s := NewScraper()
+	s.Config.Client = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		page, err := ioutil.ReadFile(pages[req.URL.Path])
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewReader(page)),
+			Header:     make(http.Header),
+			Request:    req,
+		}, nil
+	})}
+	
+	err := s.HealthCheck()
+	if err != nil {
+		t.Fatal(err)
+	}
+	
+	pages["/dictionnaires/francais/arbre"] = "../definition/testdata/pile.html"
+	err = s.HealthCheck()
+	if err == nil || !strings.Contains(err.Error(), "definition arbre: Expressions (Locution)") {
+		t.Fatalf("want missing Expressions, got %v", err)
+	}
+}
+
+// roundTripFunc is an http.RoundTripper which serves responses from a function
+// instead of the network.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// TestPage tests that the Result of each dictionary implements laroussefr.Page.
+func TestPage(t *testing.T) {
+	def, err := definition.NewFromFileOrURL("../definition/testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tra, err := traduction.NewFromFileOrURL("../traduction/testdata/notfound.html")
+	if !errors.Is(err, traduction.ErrWordNotFound) {
+		t.Fatalf("want ErrWordNotFound, got %v", err)
+	}
+	syn, err := synonymes.NewFromFileOrURL("../synonymes/testdata/beau.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	
+	cases := []struct{
+		page     laroussefr.Page
+		notFound bool
+		hasAudio bool
+	}{
+		{def, false, true},
+		{tra, true, false},
+		{syn, false, false},
+	}
+	for i, c := range cases {
+		if c.page.NotFound() != c.notFound || c.page.HasAudio() != c.hasAudio {
+			t.Errorf("cases[%d]: want NotFound %v and HasAudio %v", i, c.notFound, c.hasAudio)
+		}
+		if c.notFound != (c.page.ID() == laroussefr.NotFoundPageID) {
+			t.Errorf("cases[%d]: ID %d doesn't match NotFound", i, c.page.ID())
+		}
+	}
+	if len(def.SeeAlsoURLs()) != len(def.SeeAlso) {
+		t.Fatalf("SeeAlsoURLs: want %q, got %q", def.SeeAlso, def.SeeAlsoURLs())
+	}
+}
+
+// TestScrapeURLList tests ScrapeURLList on a list with comments, blank lines,
+// a missing word and a URL from an unknown dictionary.
+func TestScrapeURLList(t *testing.T) {
+	pages := map[string]string{
+		"/dictionnaires/francais/arbre/4974":     "../definition/testdata/arbre.html",
+		"/dictionnaires/synonymes/beau/1":        "../synonymes/testdata/beau.html",
+		"/dictionnaires/francais-anglais/mxke/1": "../traduction/testdata/notfound.html",
+	}
+	client := scrapeutil.Client
+	defer func() { scrapeutil.Client = client }()
+	scrapeutil.Client = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		page, err := ioutil.ReadFile(pages[req.URL.Path])
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewReader(page)),
+			Header:     make(http.Header),
+			Request:    req,
+		}, nil
+	})}
+	
+	list := `# nightly corpus
+https://www.larousse.fr/dictionnaires/francais/arbre/4974
+
+https://www.larousse.fr/dictionnaires/synonymes/beau/1
+	# translations
+https://www.larousse.fr/dictionnaires/francais-anglais/mxke/1
+https://www.larousse.fr/encyclopedie/divers/arbre/1
+`
+	path := filepath.Join(t.TempDir(), "urls.txt")
+	if err := ioutil.WriteFile(path, []byte(list), 0644); err != nil {
+		t.Fatal(err)
+	}
+	got, errs, err := ScrapeURLList(path, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 4 || len(errs) != 4 {
+		t.Fatalf("want 4 pages and errors, got %d and %d", len(got), len(errs))
+	}
+	if errs[0] != nil || errs[1] != nil || got[0].ID() != 4974 || got[1] == nil {
+		t.Fatalf("want arbre and beau, got %v and %v", got[:2], errs[:2])
+	}
+	if got[2] == nil || !got[2].NotFound() || !strings.Contains(fmt.Sprint(errs[2]), "urls.txt:6") {
+		t.Fatalf("want a not found page on line 6, got %v", errs[2])
+	}
+	if got[3] != nil || !strings.Contains(fmt.Sprint(errs[3]), "Unknown dictionary") {
+		t.Fatalf("want an unknown dictionary, got %v", errs[3])
+	}
+	
+	_, _, err = ScrapeURLList(filepath.Join(t.TempDir(), "missing.txt"), 1)
+	if err == nil {
+		t.Fatal("want an error for a missing file")
+	}
+}
+
+// TestDictionaryOf tests DictionaryOf on URLs on larousse.fr and under a
+// BaseURL of another host.
+func TestDictionaryOf(t *testing.T) {
+	defer func(old string) { laroussefr.BaseURL = old }(laroussefr.BaseURL)
+	laroussefr.BaseURL = "http://localhost:8080"
+	cases := map[string]string{
+		"https://www.larousse.fr/dictionnaires/francais/arbre/4974":       Definition,
+		"http://localhost:8080/dictionnaires/synonymes/beau":              Synonymes,
+		laroussefr.WordURL("anglais-francais", "make"):                    Traduction,
+		"http://localhost:8080/encyclopedie/divers/arbre/1":               "",
+		"https://www.larousse.fr/encyclopedie/dictionnaires/francais/x/1": "",
+	}
+	for u, want := range cases {
+		if got := DictionaryOf(u); got != want {
+			t.Errorf("%s: want %q, got %q", u, want, got)
+		}
+	}
+}
+
+// TestFull tests that Full returns both Results, and keeps the definition
+// when the translation fails.
+func TestFull(t *testing.T) {
+	pages := map[string]string{
+		"/dictionnaires/francais/manger":         "../definition/testdata/manger.html",
+		"/dictionnaires/francais-anglais/manger": "../traduction/testdata/manger.html",
+	}
+	s := NewScraper()
+	s.Config.Client = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		page, err := ioutil.ReadFile(pages[req.URL.Path])
+		if err != nil {
+			return &http.Response{
+				StatusCode: 500,
+				Body:       ioutil.NopCloser(strings.NewReader("")),
+				Header:     make(http.Header),
+				Request:    req,
+			}, nil
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewReader(page)),
+			Header:     make(http.Header),
+			Request:    req,
+		}, nil
+	})}
+	
+	res, err := s.Full("manger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Definition.Definitions) == 0 || len(res.Traduction.Words) == 0 {
+		t.Fatalf("want both Results, got %+v", res)
+	}
+	
+	delete(pages, "/dictionnaires/francais-anglais/manger")
+	res, err = s.Full("manger")
+	if err == nil || res.TraductionErr == nil || res.DefinitionErr != nil {
+		t.Fatalf("want only the translation to fail, got %v", err)
+	}
+	if len(res.Definition.Definitions) == 0 {
+		t.Fatal("definition missing from partial result")
+	}
+}
+
+// TestDetectDictionary tests DetectDictionary on a page of each dictionary, and
+// on pages stripped of the markers it looks for first.
+func TestDetectDictionary(t *testing.T) {
+	cases := []struct{
+		path     string
+		kind     string
+		from, to traduction.Language
+	}{
+		{"../definition/testdata/arbre.html", Definition, traduction.Fr, traduction.Fr},
+		{"../synonymes/testdata/beau.html", Synonymes, traduction.Fr, traduction.Fr},
+		{"../traduction/testdata/aire.html", Traduction, traduction.Fr, traduction.En},
+		{"../traduction/testdata/make.html", Traduction, traduction.En, traduction.Fr},
+		{"../traduction/testdata/notfound.html", Traduction, traduction.Fr, traduction.En},
+	}
+	for _, c := range cases {
+		page, err := ioutil.ReadFile(c.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, strip := range []string{"", "canonical", "title"} {
+			str := string(page)
+			if strip != "" {
+				str = strings.ReplaceAll(str, `rel="canonical"`, "")
+			}
+			if strip == "title" {
+				str = strings.ReplaceAll(str, "<title>", "<meta>")
+				str = strings.ReplaceAll(str, "</title>", "</meta>")
+			}
+			if strip == "title" && strings.Contains(c.path, "notfound") {
+				continue // nothing else to go by
+			}
+			doc, err := scrapeutil.HTMLRootFromString(str)
+			if err != nil {
+				t.Fatal(err)
+			}
+			kind, from, to, err := DetectDictionary(doc)
+			if err != nil {
+				t.Fatalf("%s without %s: %v", c.path, strip, err)
+			}
+			if kind != c.kind || from != c.from || to != c.to {
+				t.Errorf("%s without %q: want %s %v-%v, got %s %v-%v", c.path, strip, c.kind, c.from, c.to, kind, from, to)
+			}
+		}
+	}
+	
+	doc, err := scrapeutil.HTMLRootFromString("<html><body><p>rien</p></body></html>")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err = DetectDictionary(doc); err == nil {
+		t.Fatal("want an error for a page of no dictionary")
+	}
+}
+
+// TestParseDocument tests that ParseDocument scrapes a page with the package of
+// its dictionary.
+func TestParseDocument(t *testing.T) {
+	doc, err := scrapeutil.HTMLRoot("../synonymes/testdata/beau.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := ParseDocument(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := p.(synonymes.Result); !ok {
+		t.Fatalf("want a synonymes.Result, got %T", p)
+	}
+}
+
+// TestResultCache tests that a ResultCache drops its least recently used
+// Result, and that a Scraper with one can be used from several goroutines and
+// doesn't download a word again once its Result is cached.
+func TestResultCache(t *testing.T) {
+	c := NewResultCache(2)
+	a := definition.Result{PageID: 1}
+	b := definition.Result{PageID: 2}
+	c.Add("a", a)
+	c.Add("b", b)
+	c.Get("a")
+	c.Add("c", definition.Result{PageID: 3})
+	if _, ok := c.Get("b"); ok || c.Len() != 2 {
+		t.Fatalf("want b dropped and 2 Results, got %d", c.Len())
+	}
+	if page, ok := c.Get("a"); !ok || page.ID() != 1 {
+		t.Fatalf("want a kept, got %v", page)
+	}
+	
+	page, err := ioutil.ReadFile("../definition/testdata/arbre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var mu sync.Mutex
+	var requests int
+	s := NewScraper()
+	s.Results = NewResultCache(10)
+	s.Config.Client = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewReader(page)),
+			Header:     make(http.Header),
+			Request:    req,
+		}, nil
+	})}
+	
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := s.Definition("arbre")
+			if err != nil || res.PageID != 4974 {
+				t.Errorf("want page 4974, got %d and %v", res.PageID, err)
+			}
+		}()
+	}
+	wg.Wait()
+	// The lookups above may each download the page before any of them
+	// caches it, so only the lookups after them are sure to be cached.
+	requests = 0
+	res, err := s.Definition("arbre")
+	if err != nil || res.PageID != 4974 || requests != 0 {
+		t.Fatalf("want cached page 4974 and no request, got %d, %v and %d requests", res.PageID, err, requests)
+	}
+	if s.Results.Len() != 1 {
+		t.Fatalf("want 1 cached Result, got %d", s.Results.Len())
+	}
+}
@@ -0,0 +1,97 @@
+package lookup
+
+import (
+	"container/list"
+	"sync"
+	
+	"github.com/serope/laroussefr"
+)
+
+// ResultCache is an in-memory cache of scraped Results, which drops the least
+// recently used Result once it holds more than its size. It's meant for
+// long-running programs which look up the same popular words again and again,
+// so that repeated lookups skip both the download and the scrape. Unlike
+// scrapeutil.PageCache, nothing is sent to Larousse for a cached Result, so it
+// may be out of date.
+// 
+// Keys are chosen by the caller, e.g. a page's canonical URL or its page ID.
+// A Scraper whose Results field is set uses the URL of the page it would
+// download. A ResultCache may be used from several goroutines at once.
+type ResultCache struct {
+	mu    sync.Mutex
+	size  int
+	order *list.List // of *cacheEntry, most recently used first
+	items map[string]*list.Element
+}
+
+// cacheEntry is an element of a ResultCache's order list.
+type cacheEntry struct {
+	key  string
+	page laroussefr.Page
+}
+
+// NewResultCache returns an empty ResultCache which holds up to size Results.
+// If size isn't positive, nothing is cached.
+func NewResultCache(size int) *ResultCache {
+	return &ResultCache{
+		size:  size,
+		order: list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+// Get returns the Result stored for key and true, marking it as the most
+// recently used. If there's none, nil and false are returned.
+func (c *ResultCache) Get(key string) (laroussefr.Page, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(*cacheEntry).page, true
+}
+
+// Add stores page for key, replacing any Result already stored for it, and
+// drops the least recently used Result if the cache is full.
+func (c *ResultCache) Add(key string, page laroussefr.Page) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.size <= 0 {
+		return
+	}
+	if e, ok := c.items[key]; ok {
+		e.Value.(*cacheEntry).page = page
+		c.order.MoveToFront(e)
+		return
+	}
+	c.items[key] = c.order.PushFront(&cacheEntry{key, page})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+// Len returns the number of Results in c.
+func (c *ResultCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// cached returns the Result stored in c for key if c is non-nil and has one.
+// Otherwise, it scrapes the Result with fetch and, if that succeeds, stores it.
+func (c *ResultCache) cached(key string, fetch func() (laroussefr.Page, error)) (laroussefr.Page, error) {
+	if c != nil {
+		if page, ok := c.Get(key); ok {
+			return page, nil
+		}
+	}
+	page, err := fetch()
+	if c != nil && err == nil {
+		c.Add(key, page)
+	}
+	return page, err
+}
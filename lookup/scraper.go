@@ -0,0 +1,94 @@
+package lookup
+
+import (
+	"context"
+	"net/http"
+	
+	"github.com/serope/laroussefr"
+	"github.com/serope/laroussefr/definition"
+	"github.com/serope/laroussefr/scrapeutil"
+	"github.com/serope/laroussefr/synonymes"
+	"github.com/serope/laroussefr/traduction"
+)
+
+// Scraper looks up words in every dictionary using its own download settings,
+// instead of the package variables of package scrapeutil. A Scraper may be
+// used from several goroutines at once, as long as its Config isn't changed
+// in the meantime.
+// 
+// Parsing options such as definition.IncludeRawHTML and traduction.Diagnostics
+// are still package variables, shared by every Scraper.
+// 
+// The functions of packages definition, synonymes and traduction behave like a
+// Scraper whose Config is made from scrapeutil's package variables.
+// 
+// Results, if non-nil, caches the Results of Definition, Synonymes and
+// Translation, keyed by the URL of the page each would download. Only Results
+// returned without an error are cached. It's nil by default, and may be shared
+// by several Scrapers.
+type Scraper struct {
+	Config  scrapeutil.Config
+	Results *ResultCache
+}
+
+// NewScraper returns a Scraper with the default settings.
+func NewScraper() *Scraper {
+	return &Scraper{Config: scrapeutil.Config{
+		Client:        &http.Client{},
+		Retries:       1,
+		CleanPageData: true,
+	}}
+}
+
+// context returns a child of ctx which carries s's Config.
+func (s *Scraper) context(ctx context.Context) context.Context {
+	return scrapeutil.WithConfig(ctx, s.Config)
+}
+
+// Definition looks up word in the French dictionary, as definition.New does.
+func (s *Scraper) Definition(word string) (definition.Result, error) {
+	return s.DefinitionContext(context.Background(), word)
+}
+
+// DefinitionContext is like Definition, but the download is cancelled when ctx
+// is done.
+func (s *Scraper) DefinitionContext(ctx context.Context, word string) (definition.Result, error) {
+	page, err := s.Results.cached(laroussefr.WordURL("francais", word), func() (laroussefr.Page, error) {
+		return definition.NewContext(s.context(ctx), word)
+	})
+	res, _ := page.(definition.Result)
+	return res, err
+}
+
+// Synonymes looks up word in the dictionary of synonyms, as synonymes.New
+// does.
+func (s *Scraper) Synonymes(word string) (synonymes.Result, error) {
+	return s.SynonymesContext(context.Background(), word)
+}
+
+// SynonymesContext is like Synonymes, but the download is cancelled when ctx
+// is done.
+func (s *Scraper) SynonymesContext(ctx context.Context, word string) (synonymes.Result, error) {
+	page, err := s.Results.cached(laroussefr.WordURL("synonymes", word), func() (laroussefr.Page, error) {
+		return synonymes.NewContext(s.context(ctx), word)
+	})
+	res, _ := page.(synonymes.Result)
+	return res, err
+}
+
+// Translation looks up word in a bilingual dictionary, as traduction.New
+// does.
+func (s *Scraper) Translation(word string, from, to traduction.Language) (traduction.Result, error) {
+	return s.TranslationContext(context.Background(), word, from, to)
+}
+
+// TranslationContext is like Translation, but the download is cancelled when
+// ctx is done.
+func (s *Scraper) TranslationContext(ctx context.Context, word string, from, to traduction.Language) (traduction.Result, error) {
+	dict := from.String() + "-" + to.String()
+	page, err := s.Results.cached(laroussefr.WordURL(dict, word), func() (laroussefr.Page, error) {
+		return traduction.NewContext(s.context(ctx), word, from, to)
+	})
+	res, _ := page.(traduction.Result)
+	return res, err
+}
@@ -0,0 +1,92 @@
+package lookup
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	
+	"github.com/serope/laroussefr"
+	"github.com/serope/laroussefr/definition"
+	"github.com/serope/laroussefr/scrapeutil"
+	"github.com/serope/laroussefr/synonymes"
+	"github.com/serope/laroussefr/traduction"
+)
+
+// DictionaryOf returns the dictionary (Definition, Synonymes or Traduction)
+// whose page is at the URL u, going by its path, or "" if u isn't the URL of a
+// dictionary page.
+// 
+// The names are the same as laroussefr's kinds of link, so this is
+// laroussefr.DictionaryKind.
+func DictionaryOf(u string) string {
+	return laroussefr.DictionaryKind(u)
+}
+
+// FromURL scrapes the page at the URL u with the package of its dictionary
+// (see DictionaryOf). The download is cancelled when ctx is done.
+// 
+// If the page can't be scraped, the returned Page is nil, except for a "word
+// not found" page, whose Result is returned along with the error.
+func FromURL(ctx context.Context, u string) (laroussefr.Page, error) {
+	switch DictionaryOf(u) {
+		case Definition: return page(definition.NewFromFileOrURLContext(ctx, u))
+		case Synonymes:  return page(synonymes.NewFromFileOrURLContext(ctx, u))
+		case Traduction: return page(traduction.NewFromFileOrURLContext(ctx, u))
+	}
+	return nil, laroussefr.NewError("FromURL", u, "Unknown dictionary")
+}
+
+// page returns p and err, or nil and err if err is set and p isn't a "word not
+// found" page.
+func page(p laroussefr.Page, err error) (laroussefr.Page, error) {
+	if err != nil && !p.NotFound() {
+		return nil, err
+	}
+	return p, err
+}
+
+// ScrapeURLList reads the file at path, which lists one Larousse URL per line,
+// and scrapes each page with FromURL, up to concurrency at once. Blank lines
+// and comments, i.e. lines starting with "#", are skipped.
+// 
+// The pages and errors are in the same order as the URLs in the file, and
+// each error names the line of its URL. Pages which couldn't be scraped are as
+// described in FromURL. err is only non-nil if the file can't be read.
+func ScrapeURLList(path string, concurrency int) (pages []laroussefr.Page, errs []error, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, laroussefr.NewError("ScrapeURLList", path, err.Error())
+	}
+	defer f.Close()
+	
+	var urls []string
+	var lines []int
+	scanner := bufio.NewScanner(f)
+	for i := 1; scanner.Scan(); i++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		urls = append(urls, line)
+		lines = append(lines, i)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, laroussefr.NewError("ScrapeURLList", path, err.Error())
+	}
+	
+	pages = make([]laroussefr.Page, len(urls))
+	opts := scrapeutil.BatchOptions{Concurrency: concurrency}
+	errs = scrapeutil.Batch(context.Background(), len(urls), opts, func(ctx context.Context, i int) error {
+		var err error
+		pages[i], err = FromURL(ctx, urls[i])
+		return err
+	})
+	for i, err := range errs {
+		if err != nil {
+			errs[i] = laroussefr.WrapError("ScrapeURLList", fmt.Sprintf("%s:%d", path, lines[i]), "", err)
+		}
+	}
+	return pages, errs, nil
+}
@@ -0,0 +1,139 @@
+package scrapeutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrAborted is wrapped by the error of each item of a FailFast batch which
+// was skipped or cancelled because another item failed. The error's message
+// includes that item's error. Use errors.Is to detect it.
+var ErrAborted = errors.New("batch aborted")
+
+// BatchOptions controls how Batch runs its items.
+type BatchOptions struct {
+	// Concurrency is the number of items fetched at once. Values below 1
+	// mean 1.
+	Concurrency int
+
+	// Timeout is the time limit for each item. Zero means no limit other
+	// than the one on the parent context.
+	Timeout time.Duration
+	
+	// Checkpoint, if non-nil, is where the batch functions of packages
+	// definition and traduction save each result as it's completed. Words
+	// whose results were already saved are loaded from it instead of being
+	// looked up again.
+	Checkpoint Checkpoint
+	
+	// FailFast makes the first hard error, i.e. one not marked with
+	// SoftError, cancel the rest of the batch, e.g. for an interactive tool
+	// which can't go on after an authentication problem. When it's false, a
+	// failed item doesn't affect the others and every error is collected.
+	FailFast bool
+}
+
+// softError marks an error which doesn't stop a FailFast batch.
+type softError struct {
+	err error
+}
+
+func (e softError) Error() string {
+	return e.err.Error()
+}
+
+// SoftError marks err, returned by a batch item, as one which doesn't stop a
+// batch run with FailFast, e.g. a word that doesn't exist. Batch stores err
+// itself, not the mark. If err is nil, nil is returned.
+func SoftError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return softError{err}
+}
+
+// Batch calls fetch for each index in [0, n), running up to
+// opts.Concurrency calls at once. Each call gets its own child of ctx,
+// limited by opts.Timeout, so that one slow item doesn't hold up the rest.
+//
+// The returned slice holds the error of each item. Once ctx is done, items
+// that haven't started are skipped and their error is ctx.Err(). So is the
+// error of items which were cancelled in flight, so that a deadline on ctx,
+// e.g. one capping a whole batch at five minutes, can be told apart from an
+// item running out of opts.Timeout, whose error is left as fetch returned it.
+//
+// If opts.FailFast is set, the first error returned by fetch which isn't
+// marked with SoftError cancels the others: items that haven't started are
+// skipped, those in flight are cancelled, and Batch returns as soon as they
+// stop. Their error wraps ErrAborted.
+func Batch(ctx context.Context, n int, opts BatchOptions, fetch func(context.Context, int) error) []error {
+	errs := make([]error, n)
+	parent := ctx
+	abort := func(error) {}
+	var abortErr error
+	if opts.FailFast {
+		var cancel context.CancelFunc
+		var once sync.Once
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		abort = func(err error) {
+			once.Do(func() {
+				abortErr = fmt.Errorf("%w: %v", ErrAborted, err)
+				cancel()
+			})
+		}
+	}
+	// doneErr returns the error of the items cut short once ctx is done.
+	doneErr := func() error {
+		if parent.Err() != nil {
+			return parent.Err()
+		}
+		return abortErr
+	}
+	
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			for j := i; j < n; j++ {
+				errs[j] = doneErr()
+			}
+			break
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			itemCtx := ctx
+			if opts.Timeout > 0 {
+				var cancel context.CancelFunc
+				itemCtx, cancel = context.WithTimeout(ctx, opts.Timeout)
+				defer cancel()
+			}
+			err := fetch(itemCtx, i)
+			soft, ok := err.(softError)
+			if ok {
+				err = soft.err
+			}
+			if err != nil && ctx.Err() != nil {
+				err = doneErr()
+			} else if err != nil && !ok {
+				abort(err)
+			}
+			errs[i] = err
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
@@ -0,0 +1,69 @@
+package scrapeutil
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// PageCache, if non-nil, stores pages downloaded from Larousse along with their
+// ETag and Last-Modified headers. When a cached page is downloaded again, the
+// request is sent with If-None-Match and If-Modified-Since, and if Larousse
+// responds with 304 Not Modified, the cached page is used instead. This saves
+// bandwidth when a large set of pages is refreshed periodically.
+// 
+// Only pages whose response had an ETag or a Last-Modified header are cached.
+// It's nil by default.
+var PageCache Cache
+
+// Cache stores downloaded pages, keyed by URL, for PageCache.
+// 
+// Get returns false if no page is stored for url.
+type Cache interface {
+	Get(url string) (page CachedPage, ok bool, err error)
+	Put(url string, page CachedPage) error
+}
+
+// CachedPage is a page stored in a Cache. Body holds the page's bytes as they
+// were downloaded, and ETag and LastModified hold the values of the response
+// headers of the same names.
+type CachedPage struct {
+	Body         []byte
+	ETag         string
+	LastModified string
+}
+
+// DirCache is a Cache which saves each page to its own JSON file in the
+// directory it names. The directory is created if it doesn't exist.
+type DirCache string
+
+// path returns the file in which the page at u is saved.
+func (dir DirCache) path(u string) string {
+	return filepath.Join(string(dir), url.PathEscape(u) + ".json")
+}
+
+func (dir DirCache) Get(u string) (CachedPage, bool, error) {
+	data, err := ioutil.ReadFile(dir.path(u))
+	if os.IsNotExist(err) {
+		return CachedPage{}, false, nil
+	}
+	if err != nil {
+		return CachedPage{}, false, err
+	}
+	var page CachedPage
+	err = json.Unmarshal(data, &page)
+	if err != nil {
+		return CachedPage{}, false, err
+	}
+	return page, true, nil
+}
+
+func (dir DirCache) Put(u string, page CachedPage) error {
+	data, err := json.Marshal(page)
+	if err != nil {
+		return err
+	}
+	return writeFile(string(dir), dir.path(u), data)
+}
@@ -0,0 +1,89 @@
+package scrapeutil
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// Checkpoint stores the results of a batch as they're completed, so that a
+// batch which is restarted after a crash doesn't look up the same words
+// again. Keys are chosen by the batch functions, e.g. the word being looked
+// up, and data is a result encoded as JSON.
+// 
+// Load returns false if nothing has been saved for key.
+type Checkpoint interface {
+	Load(key string) (data []byte, ok bool, err error)
+	Save(key string, data []byte) error
+}
+
+// DirCheckpoint is a Checkpoint which saves each result to its own JSON file in
+// the directory it names. The directory is created if it doesn't exist.
+type DirCheckpoint string
+
+// path returns the file in which key is saved.
+func (dir DirCheckpoint) path(key string) string {
+	return filepath.Join(string(dir), url.PathEscape(key) + ".json")
+}
+
+func (dir DirCheckpoint) Load(key string) ([]byte, bool, error) {
+	data, err := ioutil.ReadFile(dir.path(key))
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return data, true, nil
+}
+
+func (dir DirCheckpoint) Save(key string, data []byte) error {
+	return writeFile(string(dir), dir.path(key), data)
+}
+
+// writeFile writes data to path, creating dir if it doesn't exist.
+func writeFile(dir, path string, data []byte) error {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+	// write to a temporary file first so that a crash can't leave a partial
+	// result behind
+	tmp := path + ".tmp"
+	err = ioutil.WriteFile(tmp, data, 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Checkpointed loads the result saved for key in cp into v, which must be a
+// pointer. If nothing has been saved, it calls fetch, which should store its
+// result in v, and saves v if fetch succeeds. Failed results aren't saved, so
+// they're fetched again when the batch is restarted.
+// 
+// If cp is nil, Checkpointed simply calls fetch.
+func Checkpointed(cp Checkpoint, key string, v interface{}, fetch func() error) error {
+	if cp == nil {
+		return fetch()
+	}
+	data, ok, err := cp.Load(key)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return json.Unmarshal(data, v)
+	}
+	
+	err = fetch()
+	if err != nil {
+		return err
+	}
+	data, err = json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return cp.Save(key, data)
+}
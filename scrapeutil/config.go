@@ -0,0 +1,41 @@
+package scrapeutil
+
+import (
+	"context"
+	"net/http"
+)
+
+// Config holds the settings used to download and parse a page. Its fields
+// correspond to the package variables of the same names.
+type Config struct {
+	Client        *http.Client
+	Header        http.Header
+	Retries       int
+	CleanPageData bool
+	PageCache     Cache
+	Metrics       Hooks
+	OfflineMode   bool
+}
+
+// configKey is the context key for a Config.
+type configKey struct{}
+
+// WithConfig returns a copy of ctx which carries c. Pages downloaded or parsed
+// with the returned context use c instead of the package variables, so that
+// callers with different settings can scrape concurrently. A nil c.Client
+// means http.DefaultClient.
+func WithConfig(ctx context.Context, c Config) context.Context {
+	return context.WithValue(ctx, configKey{}, c)
+}
+
+// ConfigFrom returns the Config carried by ctx, or one made from the package
+// variables if there isn't one.
+func ConfigFrom(ctx context.Context) Config {
+	if c, ok := ctx.Value(configKey{}).(Config); ok {
+		if c.Client == nil {
+			c.Client = http.DefaultClient
+		}
+		return c
+	}
+	return Config{Client, Header, Retries, CleanPageData, PageCache, Metrics, OfflineMode}
+}
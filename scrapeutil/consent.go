@@ -0,0 +1,65 @@
+package scrapeutil
+
+import (
+	"errors"
+	"strings"
+	
+	"github.com/yhat/scrape"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// ErrConsentRequired is wrapped by the error returned when Larousse serves a
+// cookie-consent interstitial instead of the requested page, so that callers
+// can tell it apart from a word that doesn't exist. Use errors.Is to detect it.
+// 
+// Such a page usually goes away by retrying later, or by sending the consent
+// cookie set by a browser which has accepted it, e.g. with Header:
+// 
+//     scrapeutil.Header = http.Header{"Cookie": {"euconsent-v2=..."}}
+var ErrConsentRequired = errors.New("cookie consent required")
+
+// consentMarkers are ids and classes of the banners of common consent
+// platforms.
+var consentMarkers = []string{
+	"didomi-host",
+	"didomi-notice",
+	"didomi-popup",
+	"onetrust-consent-sdk",
+	"qc-cmp2-container",
+}
+
+// IsConsentPage returns true if doc is a cookie-consent interstitial, i.e. a
+// page with a consent banner but without the canonical link that every
+// dictionary page has. A dictionary page with a consent banner over it isn't
+// one, since its content is all there.
+func IsConsentPage(doc *html.Node) bool {
+	if _, ok := scrape.Find(doc, IsCanonicalLink); ok {
+		return false
+	}
+	_, ok := scrape.Find(doc, isConsentNode)
+	return ok
+}
+
+// IsCanonicalLink returns true if n is a <link rel="canonical"> node, whose
+// href is the URL of the page it's on, e.g. for use with scrape.Find.
+func IsCanonicalLink(n *html.Node) bool {
+	return n.DataAtom == atom.Link && scrape.Attr(n, "rel") == "canonical"
+}
+
+// isConsentNode returns true if n's id or one of its classes is one of
+// consentMarkers.
+func isConsentNode(n *html.Node) bool {
+	if n.Type != html.ElementNode {
+		return false
+	}
+	names := append(strings.Fields(scrape.Attr(n, "class")), scrape.Attr(n, "id"))
+	for _, name := range names {
+		for _, marker := range consentMarkers {
+			if name == marker {
+				return true
+			}
+		}
+	}
+	return false
+}
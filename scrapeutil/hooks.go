@@ -0,0 +1,59 @@
+package scrapeutil
+
+import (
+	"time"
+)
+
+// Metrics holds callbacks which are called as pages are downloaded from
+// Larousse, e.g. to export Prometheus metrics without this module depending on
+// a metrics library. Pages read from disk don't trigger them.
+// 
+// Every field may be nil, in which case it costs nothing. Callbacks may be
+// called from several goroutines at once when pages are scraped concurrently.
+// It shouldn't be changed while a page is being downloaded.
+var Metrics Hooks
+
+// Hooks is the type of Metrics.
+// 
+// OnRequest is called before a request is sent.
+// 
+// OnResponse is called once a response has been received, with its status
+// code, the number of bytes read from its body and the time elapsed since the
+// request was sent. The body of a response other than 200 OK isn't read, so
+// bytes is 0 for those.
+// 
+// OnCacheHit is called when a page from PageCache is used because Larousse
+// responded with 304 Not Modified.
+// 
+// OnError is called with the error returned for a page which couldn't be
+// downloaded.
+type Hooks struct {
+	OnRequest  func(url string)
+	OnResponse func(url string, status, bytes int, duration time.Duration)
+	OnCacheHit func(url string)
+	OnError    func(url string, err error)
+}
+
+func (h Hooks) request(url string) {
+	if h.OnRequest != nil {
+		h.OnRequest(url)
+	}
+}
+
+func (h Hooks) response(url string, status, bytes int, start time.Time) {
+	if h.OnResponse != nil {
+		h.OnResponse(url, status, bytes, time.Since(start))
+	}
+}
+
+func (h Hooks) cacheHit(url string) {
+	if h.OnCacheHit != nil {
+		h.OnCacheHit(url)
+	}
+}
+
+func (h Hooks) error(url string, err error) {
+	if h.OnError != nil {
+		h.OnError(url, err)
+	}
+}
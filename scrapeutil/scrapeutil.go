@@ -4,80 +4,402 @@ package scrapeutil
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 	
 	"golang.org/x/net/html"
 )
 
+// Client is the HTTP client used to download pages from Larousse. To keep
+// cookies set by Larousse between requests, e.g. ones which change the markup
+// of a session, give it a cookie jar:
+// 
+//     jar, _ := cookiejar.New(nil)
+//     scrapeutil.Client.Jar = jar
+var Client = &http.Client{}
+
+// Header holds HTTP headers added to every request sent to Larousse, e.g. a
+// Referer, an Accept-Language for region-specific content, or a Cookie. Every
+// header is sent as given, except Host, which Go's HTTP client ignores. Cookies
+// from Client.Jar are sent in addition to any Cookie header.
+// 
+// It's nil by default. It shouldn't be changed while a page is being
+// downloaded.
+var Header http.Header
+
+// Retries is the number of times a page downloaded from a URL is fetched again
+// if it fails to scrape. Larousse occasionally serves a truncated document,
+// which usually downloads correctly on the next attempt.
+// 
+// Pages read from disk are never read again.
+var Retries = 1
+
+// CleanPageData controls whether newlines and tabs are removed from a page's
+// bytes before it's parsed. If false, the bytes are parsed exactly as they were
+// downloaded or read, and text nodes consisting only of such whitespace are
+// removed from the parse tree afterwards instead, so that the scrapers see the
+// same structure either way.
+var CleanPageData = true
+
+// OfflineMode forbids all network access, for deployments where outbound
+// connections aren't allowed. If true, pages can only be read from files, or
+// from PageCache for a URL whose page it holds, and any other request fails
+// before it's sent with an error wrapping ErrOfflineMode. Every request made
+// by this module is built with NewRequest, which enforces it, so no page,
+// audio clip or redirect is fetched by accident.
+// 
+// It's false by default. It shouldn't be changed while a page is being
+// downloaded.
+var OfflineMode = false
+
+// ErrRateLimited is wrapped by the error returned when Larousse responds with
+// HTTP 429 Too Many Requests. Use errors.Is to detect it, and errors.As with a
+// RateLimitError to find out how long to wait.
+var ErrRateLimited = errors.New("rate limited")
+
+// RateLimitError is returned when Larousse responds with HTTP 429 Too Many
+// Requests.
+// 
+// RetryAfter is the duration given by the response's Retry-After header, or 0
+// if the header is missing or can't be parsed.
+type RateLimitError struct {
+	StatusCode int
+	RetryAfter time.Duration
+}
+
+func (e RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("HTTP %d (%s), retry after %s", e.StatusCode, ErrRateLimited, e.RetryAfter)
+	}
+	return fmt.Sprintf("HTTP %d (%s)", e.StatusCode, ErrRateLimited)
+}
+
+// Unwrap returns ErrRateLimited.
+func (e RateLimitError) Unwrap() error {
+	return ErrRateLimited
+}
+
+// Is returns true if target is ErrNetwork, since a RateLimitError is also a
+// network error.
+func (e RateLimitError) Is(target error) bool {
+	return target == ErrNetwork
+}
+
+// ErrNetwork is wrapped by the error returned when a page can't be downloaded,
+// e.g. because Larousse can't be reached, the connection is cut or the
+// response has an HTTP error status, as with a RateLimitError. Such an error is
+// usually worth retrying later. Use errors.Is to detect it.
+var ErrNetwork = errors.New("network error")
+
+// ErrOfflineMode is wrapped by the error returned when a request would be sent
+// while OfflineMode is true. Use errors.Is to detect it.
+var ErrOfflineMode = errors.New("offline mode: network access is disabled")
+
+// ErrParse is wrapped by the error returned when a page's HTML can't be
+// parsed. Use errors.Is to detect it.
+var ErrParse = errors.New("parse error")
+
+// kindError wraps err, which errors.Is and errors.As can still find, and also
+// matches kind, i.e. ErrNetwork or ErrParse.
+type kindError struct {
+	kind error
+	err  error
+}
+
+func (e kindError) Error() string {
+	return e.err.Error()
+}
+
+func (e kindError) Unwrap() error {
+	return e.err
+}
+
+func (e kindError) Is(target error) bool {
+	return target == e.kind
+}
+
+// PageInfo describes when a page was fetched.
+// 
+// FetchedAt is the time the page was downloaded. LastModified is the time given
+// by the response's Last-Modified header, or the zero time if there was none.
+// For a page read from disk, both are the file's modification time.
+type PageInfo struct {
+	FetchedAt    time.Time
+	LastModified time.Time
+}
+
 // HTMLRoot takes an HTML page, as either a URL or a disk filepath, and returns
 // the root node of its parse tree with all newline text nodes removed for
 // easier parsing.
 func HTMLRoot(in string) (*html.Node, error) {
+	return HTMLRootContext(context.Background(), in)
+}
+
+// HTMLRootContext is like HTMLRoot, but if in is a URL, the download is
+// cancelled when ctx is done.
+func HTMLRootContext(ctx context.Context, in string) (*html.Node, error) {
+	doc, _, err := HTMLRootInfo(ctx, in)
+	return doc, err
+}
+
+// HTMLRootInfo is like HTMLRootContext, but also returns when the page was
+// fetched.
+// 
+// If the page is a cookie-consent interstitial (see IsConsentPage), the
+// returned error wraps ErrConsentRequired.
+func HTMLRootInfo(ctx context.Context, in string) (*html.Node, PageInfo, error) {
 	if in == "" {
-		return nil, fmt.Errorf("HTMLRoot(%s)\n%s", in, "Empty in")
+		return nil, PageInfo{}, fmt.Errorf("HTMLRoot(%s)\n%s", in, "Empty in")
 	}
-	data, err := getHTMLData(in)
+	data, info, err := getHTMLData(ctx, in)
 	if err != nil {
-		return nil, fmt.Errorf("HTMLRoot(%s)\n%s", in, err.Error())
+		return nil, PageInfo{}, fmt.Errorf("HTMLRoot(%s)\n%w", in, err)
 	}
-	doc, err := dataToDoc(data)
+	doc, err := dataToDoc(data, ConfigFrom(ctx).CleanPageData)
 	if err != nil {
-		return nil, fmt.Errorf("HTMLRoot(%s)\n%s", in, err.Error())
+		return nil, PageInfo{}, fmt.Errorf("HTMLRoot(%s)\n%w", in, kindError{ErrParse, err})
+	}
+	if IsConsentPage(doc) {
+		return nil, PageInfo{}, fmt.Errorf("HTMLRoot(%s)\n%w", in, ErrConsentRequired)
+	}
+	return doc, info, nil
+}
+
+// HTMLRootFromString is like HTMLRoot, but takes the page's HTML itself, e.g.
+// markup copied from a browser's developer tools. CleanPageData applies to it
+// as to a downloaded page.
+func HTMLRootFromString(s string) (*html.Node, error) {
+	doc, err := dataToDoc([]byte(s), CleanPageData)
+	if err != nil {
+		return nil, fmt.Errorf("HTMLRootFromString()\n%w", kindError{ErrParse, err})
+	}
+	return doc, nil
+}
+
+// ParseHTML takes a page's contents, e.g. from a custom downloader, and returns
+// the root node of its parse tree, preprocessed exactly as HTMLRoot does it
+// with Clean or, if CleanPageData is false, by removing the newline text nodes
+// afterwards. The tree has the same shape the scrapers' matchers expect, so
+// it's meant for custom extractors of Larousse markup. The returned error
+// wraps ErrParse.
+func ParseHTML(data []byte) (*html.Node, error) {
+	doc, err := dataToDoc(data, CleanPageData)
+	if err != nil {
+		return nil, fmt.Errorf("ParseHTML()\n%w", kindError{ErrParse, err})
 	}
 	return doc, nil
 }
 
 // dataToDoc takes a web page's contents as a byte slice and returns the root
 // node of its parse tree with all newline text nodes removed for easier
-// parsing.
-func dataToDoc(data []byte) (*html.Node, error) {
-	data = cleanPageData(data)
+// parsing. clean is the value of CleanPageData to use.
+func dataToDoc(data []byte, clean bool) (*html.Node, error) {
+	if clean {
+		data = Clean(data)
+	}
 	reader := bytes.NewReader(data)
 	doc, err := html.Parse(reader)
 	if err != nil {
 		return nil, fmt.Errorf("dataToDoc()\n%s", err.Error())
 	}
+	if !clean {
+		removeNewlineNodes(doc)
+	}
 	return doc, nil
 }
 
+// removeNewlineNodes removes every text node under n which consists only of
+// whitespace and contains a newline or tab, i.e. the nodes that wouldn't exist
+// had the page been cleaned by Clean.
+func removeNewlineNodes(n *html.Node) {
+	c := n.FirstChild
+	for c != nil {
+		next := c.NextSibling
+		if isNewlineNode(c) {
+			n.RemoveChild(c)
+		} else {
+			removeNewlineNodes(c)
+		}
+		c = next
+	}
+}
+
+// isNewlineNode returns true if n is a text node made of whitespace, including
+// at least one newline or tab.
+func isNewlineNode(n *html.Node) bool {
+	if n.Type != html.TextNode || strings.TrimSpace(n.Data) != "" {
+		return false
+	}
+	return strings.ContainsAny(n.Data, "\n\t\r")
+}
+
 // getHTMLData takes an HTML page, as either a URL or a disk filepath, and
 // returns the page's contents as a byte slice.
-func getHTMLData(in string) ([]byte, error) {
-	var readingFunc func(string)([]byte,error)
+func getHTMLData(ctx context.Context, in string) ([]byte, PageInfo, error) {
+	var readingFunc func(string)([]byte,PageInfo,error)
 	if FileExists(in) {
-		readingFunc = ioutil.ReadFile
+		readingFunc = getHTMLDataFromFile
 	} else {
-		readingFunc = getHTMLDataFromURL
+		readingFunc = func(url string) ([]byte, PageInfo, error) {
+			return getHTMLDataFromURL(ctx, url)
+		}
 	}
-	data, err := readingFunc(in)
+	data, info, err := readingFunc(in)
 	if err != nil {
-		return nil, fmt.Errorf("getHTMLData(%s)\nEither the file wasn't found, or: %s", in, err.Error())
+		return nil, PageInfo{}, fmt.Errorf("getHTMLData(%s)\nEither the file wasn't found, or: %w", in, err)
 	}
-	return data, nil
+	return data, info, nil
+}
+
+// getHTMLDataFromFile takes a filepath and returns the file's contents as a
+// byte slice.
+func getHTMLDataFromFile(path string) ([]byte, PageInfo, error) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, PageInfo{}, err
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, PageInfo{}, err
+	}
+	return data, PageInfo{stat.ModTime(), stat.ModTime()}, nil
+}
+
+// NewRequest returns a request for url with the Header of ctx's Config applied
+// to it. If the Config's OfflineMode is true, an error wrapping ErrOfflineMode
+// is returned instead.
+func NewRequest(ctx context.Context, method, url string) (*http.Request, error) {
+	config := ConfigFrom(ctx)
+	if config.OfflineMode {
+		return nil, fmt.Errorf("NewRequest(%s)\n%w", url, ErrOfflineMode)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range config.Header {
+		req.Header[k] = append([]string(nil), v...)
+	}
+	return req, nil
 }
 
 // getHTMLDataFromURL takes a URL and returns the page's contents as a byte
-// slice.
-func getHTMLDataFromURL(url string) ([]byte, error) {
-	res, err := http.Get(url)
+// slice. If ctx's Config has a PageCache, the request is made conditional on
+// the cached copy of the page, if any, which is returned if the page hasn't
+// changed. The Hooks of ctx's Config are called along the way. If its
+// OfflineMode is true, only the cached copy is returned, without a request.
+func getHTMLDataFromURL(ctx context.Context, url string) ([]byte, PageInfo, error) {
+	config := ConfigFrom(ctx)
+	config.Metrics.request(url)
+	data, info, err := fetchURL(ctx, url, config)
+	if err != nil {
+		config.Metrics.error(url, err)
+	}
+	return data, info, err
+}
+
+// fetchURL does the work of getHTMLDataFromURL.
+func fetchURL(ctx context.Context, url string, config Config) ([]byte, PageInfo, error) {
+	if config.OfflineMode && config.PageCache != nil {
+		cached, ok, err := config.PageCache.Get(url)
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("getHTMLDataFromURL(%s)\nPageCache.Get\n%w", url, err)
+		}
+		if ok {
+			config.Metrics.cacheHit(url)
+			return cached.Body, newPageInfo(cached.LastModified), nil
+		}
+	}
+	req, err := NewRequest(ctx, http.MethodGet, url)
+	if err != nil {
+		return nil, PageInfo{}, fmt.Errorf("getHTMLDataFromURL(%s)\nNewRequest\n%w", url, err)
+	}
+	var cached CachedPage
+	var isCached bool
+	if config.PageCache != nil {
+		cached, isCached, err = config.PageCache.Get(url)
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("getHTMLDataFromURL(%s)\nPageCache.Get\n%w", url, err)
+		}
+		if isCached && cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if isCached && cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+	start := time.Now()
+	res, err := config.Client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("getHTMLDataFromURL(%s)\nhttp.Get\n%s", url, err.Error())
+		return nil, PageInfo{}, fmt.Errorf("getHTMLDataFromURL(%s)\nClient.Do\n%w", url, kindError{ErrNetwork, err})
+	}
+	defer res.Body.Close()
+	if res.StatusCode != 200 {
+		config.Metrics.response(url, res.StatusCode, 0, start)
+	}
+	if res.StatusCode == http.StatusNotModified && isCached {
+		config.Metrics.cacheHit(url)
+		return cached.Body, newPageInfo(cached.LastModified), nil
+	} else if res.StatusCode == http.StatusTooManyRequests {
+		retryAfter := parseRetryAfter(res.Header.Get("Retry-After"))
+		return nil, PageInfo{}, fmt.Errorf("getHTMLDataFromURL(%s)\n%w", url, RateLimitError{res.StatusCode, retryAfter})
 	} else if res.StatusCode != 200 {
-		return nil, fmt.Errorf("getHTMLDataFromURL(%s)\nHTTP %d", res.StatusCode)
+		return nil, PageInfo{}, fmt.Errorf("getHTMLDataFromURL(%s)\n%w", url, kindError{ErrNetwork, fmt.Errorf("HTTP %d", res.StatusCode)})
 	}
 	data, err := ioutil.ReadAll(res.Body)
 	if err != nil {
-		return nil, fmt.Errorf("getHTMLDataFromURL(%s)\nioutil.ReadAll\n%s", url, err.Error())
+		return nil, PageInfo{}, fmt.Errorf("getHTMLDataFromURL(%s)\nioutil.ReadAll\n%w", url, kindError{ErrNetwork, err})
+	}
+	config.Metrics.response(url, res.StatusCode, len(data), start)
+	etag, lastModified := res.Header.Get("ETag"), res.Header.Get("Last-Modified")
+	if config.PageCache != nil && (etag != "" || lastModified != "") {
+		err = config.PageCache.Put(url, CachedPage{data, etag, lastModified})
+		if err != nil {
+			return nil, PageInfo{}, fmt.Errorf("getHTMLDataFromURL(%s)\nPageCache.Put\n%w", url, err)
+		}
 	}
-	return data, err
+	return data, newPageInfo(lastModified), nil
 }
 
-// cleanPageData takes a web page's contents as a byte slice and removes all
-// newlines and tabs.
-func cleanPageData(page []byte) []byte {
+// newPageInfo returns the PageInfo of a page downloaded now, given the value of
+// its Last-Modified header.
+func newPageInfo(lastModified string) PageInfo {
+	info := PageInfo{FetchedAt: time.Now()}
+	if t, err := http.ParseTime(lastModified); err == nil {
+		info.LastModified = t
+	}
+	return info
+}
+
+// parseRetryAfter takes the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date, and returns it as a duration. If it can't
+// be parsed, 0 is returned.
+func parseRetryAfter(str string) time.Duration {
+	if str == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(str); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(str); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// Clean takes a web page's contents as a byte slice and removes all newlines,
+// tabs and carriage returns, which is how HTMLRoot prepares a page for parsing
+// when CleanPageData is true. page itself isn't modified.
+func Clean(page []byte) []byte {
 	removeThese := []string{"\n", "\t", "\r"}
 	for _, r := range removeThese {
 		page = bytes.ReplaceAll(page, []byte(r), []byte(""))
@@ -85,6 +407,15 @@ func cleanPageData(page []byte) []byte {
 	return page
 }
 
+// RetriesFor returns the number of times in may be fetched again after a failed
+// scrape, which is the Retries of ctx's Config for URLs and 0 for files.
+func RetriesFor(ctx context.Context, in string) int {
+	if FileExists(in) {
+		return 0
+	}
+	return ConfigFrom(ctx).Retries
+}
+
 // FileExists returns true if the specified file exists.
 func FileExists(filepath string) bool {
 	_, err := os.Stat(filepath)
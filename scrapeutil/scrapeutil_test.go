@@ -0,0 +1,445 @@
+// scrapeutil_test.go contains unit tests for exported functions.
+package scrapeutil
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	
+	"golang.org/x/net/html"
+)
+
+// TestHTMLRootRateLimited tests HTMLRoot on a server responding with HTTP 429.
+func TestHTMLRootRateLimited(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+	
+	_, err := HTMLRoot(server.URL)
+	if !errors.Is(err, ErrRateLimited) || !errors.Is(err, ErrNetwork) {
+		t.Fatalf("want ErrRateLimited and ErrNetwork, got %v", err)
+	}
+	var rle RateLimitError
+	if !errors.As(err, &rle) {
+		t.Fatalf("want RateLimitError, got %v", err)
+	}
+	if rle.RetryAfter != 120*time.Second {
+		t.Fatalf("RetryAfter: want 2m0s, got %s", rle.RetryAfter)
+	}
+}
+
+// TestBatchTimeout tests that Batch abandons a slow item without holding up
+// the others.
+func TestBatchTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			<-r.Context().Done()
+			return
+		}
+		w.Write([]byte("<html><body>ok</body></html>"))
+	}))
+	defer server.Close()
+	
+	paths := []string{"/fast", "/slow", "/fast"}
+	opts := BatchOptions{Concurrency: 2, Timeout: 100*time.Millisecond}
+	errs := Batch(context.Background(), len(paths), opts, func(ctx context.Context, i int) error {
+		_, err := HTMLRootContext(ctx, server.URL+paths[i])
+		return err
+	})
+	if errs[0] != nil || errs[2] != nil {
+		t.Fatalf("fast pages: want no errors, got %v", errs)
+	}
+	if !errors.Is(errs[1], context.DeadlineExceeded) {
+		t.Fatalf("slow page: want context.DeadlineExceeded, got %v", errs[1])
+	}
+}
+
+// TestBatchDeadline tests that a deadline on Batch's context cancels the items
+// in flight, which get the context's error, while finished items keep their
+// results and per-item timeouts still apply.
+func TestBatchDeadline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+			case "/slow":
+				<-r.Context().Done()
+				return
+			case "/medium":
+				select {
+					case <-r.Context().Done():
+						return
+					case <-time.After(150*time.Millisecond):
+				}
+		}
+		w.Write([]byte("<html><body>ok</body></html>"))
+	}))
+	defer server.Close()
+	
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	paths := []string{"/fast", "/medium", "/slow"}
+	opts := BatchOptions{Concurrency: 3, Timeout: 50*time.Millisecond}
+	errs := Batch(ctx, len(paths), opts, func(ctx context.Context, i int) error {
+		_, err := HTMLRootContext(ctx, server.URL+paths[i])
+		return err
+	})
+	if errs[0] != nil {
+		t.Fatalf("fast page: want no error, got %v", errs[0])
+	}
+	for _, err := range errs[1:] {
+		if !errors.Is(err, context.DeadlineExceeded) || err == context.DeadlineExceeded {
+			t.Fatalf("per-item timeouts: want wrapped context.DeadlineExceeded, got %v", errs)
+		}
+	}
+	
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	opts.Timeout = time.Second
+	errs = Batch(ctx, len(paths), opts, func(ctx context.Context, i int) error {
+		_, err := HTMLRootContext(ctx, server.URL+paths[i])
+		return err
+	})
+	if errs[0] != nil {
+		t.Fatalf("fast page: want no error, got %v", errs[0])
+	}
+	for _, err := range errs[1:] {
+		if err != context.DeadlineExceeded {
+			t.Fatalf("overall deadline: want context.DeadlineExceeded, got %v", errs)
+		}
+	}
+}
+
+// TestBatchCancel tests that Batch skips the remaining items once its context
+// is cancelled.
+func TestBatchCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := Batch(ctx, 3, BatchOptions{}, func(ctx context.Context, i int) error {
+		if i == 0 {
+			cancel()
+		}
+		return nil
+	})
+	if errs[0] != nil {
+		t.Fatalf("first item: want no error, got %v", errs[0])
+	}
+	for _, err := range errs[1:] {
+		if err != context.Canceled {
+			t.Fatalf("remaining items: want context.Canceled, got %v", errs)
+		}
+	}
+}
+
+// TestBatchFailFast tests that a hard error stops a FailFast batch while soft
+// errors don't, and that every error is kept without FailFast.
+func TestBatchFailFast(t *testing.T) {
+	errHard := errors.New("forbidden")
+	errSoft := errors.New("not found")
+	fetch := func(ctx context.Context, i int) error {
+		switch i {
+			case 0:
+				return SoftError(errSoft)
+			case 1:
+				return errHard
+		}
+		return nil
+	}
+	
+	errs := Batch(context.Background(), 4, BatchOptions{FailFast: true}, fetch)
+	if errs[0] != errSoft || errs[1] != errHard {
+		t.Fatalf("want the soft and hard errors as returned, got %v", errs)
+	}
+	for _, err := range errs[2:] {
+		if !errors.Is(err, ErrAborted) || !strings.Contains(err.Error(), "forbidden") {
+			t.Fatalf("remaining items: want ErrAborted, got %v", errs)
+		}
+	}
+	
+	errs = Batch(context.Background(), 4, BatchOptions{}, fetch)
+	if errs[0] != errSoft || errs[1] != errHard || errs[2] != nil || errs[3] != nil {
+		t.Fatalf("want only the first two items to fail, got %v", errs)
+	}
+}
+
+// TestHTMLRootHeader tests that Header and Client's cookie jar are applied to
+// requests.
+func TestHTMLRootHeader(t *testing.T) {
+	var referer, cookie string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/login" {
+			http.SetCookie(w, &http.Cookie{Name: "region", Value: "be"})
+		} else {
+			referer = r.Referer()
+			if c, err := r.Cookie("region"); err == nil {
+				cookie = c.Value
+			}
+		}
+		w.Write([]byte("<html><body>ok</body></html>"))
+	}))
+	defer server.Close()
+	
+	client := Client
+	defer func() { Client, Header = client, nil }()
+	jar, _ := cookiejar.New(nil)
+	Client = &http.Client{Jar: jar}
+	Header = http.Header{"Referer": {"https://www.larousse.fr/"}}
+	
+	for _, path := range []string{"/login", "/page"} {
+		_, err := HTMLRoot(server.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if referer != "https://www.larousse.fr/" || cookie != "be" {
+		t.Fatalf("want Referer and cookie, got %q and %q", referer, cookie)
+	}
+}
+
+// TestHTMLRootInfo tests that HTMLRootInfo returns the Last-Modified header of
+// a response and the modification time of a file.
+func TestHTMLRootInfo(t *testing.T) {
+	lastModified := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/dated" {
+			w.Header().Set("Last-Modified", lastModified.Format(http.TimeFormat))
+		}
+		w.Write([]byte("<html><body>ok</body></html>"))
+	}))
+	defer server.Close()
+	
+	before := time.Now()
+	_, info, err := HTMLRootInfo(context.Background(), server.URL+"/dated")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.LastModified.Equal(lastModified) {
+		t.Fatalf("LastModified: want %v, got %v", lastModified, info.LastModified)
+	}
+	if info.FetchedAt.Before(before) {
+		t.Fatalf("FetchedAt: want after %v, got %v", before, info.FetchedAt)
+	}
+	
+	_, info, err = HTMLRootInfo(context.Background(), server.URL+"/undated")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.LastModified.IsZero() {
+		t.Fatalf("LastModified: want zero time, got %v", info.LastModified)
+	}
+	
+	path := filepath.Join(t.TempDir(), "page.html")
+	if err := ioutil.WriteFile(path, []byte("<html><body>ok</body></html>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, lastModified, lastModified); err != nil {
+		t.Fatal(err)
+	}
+	_, info, err = HTMLRootInfo(context.Background(), path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.FetchedAt.Equal(lastModified) || !info.LastModified.Equal(lastModified) {
+		t.Fatalf("file: want %v, got %+v", lastModified, info)
+	}
+}
+
+// TestPageCache tests that a page in PageCache is requested conditionally and
+// served from the cache when Larousse responds with 304 Not Modified.
+func TestPageCache(t *testing.T) {
+	var requests, notModified int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` && r.Header.Get("If-Modified-Since") != "" {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Last-Modified", "Thu, 04 Mar 2021 05:06:07 GMT")
+		w.Write([]byte("<html><body><p>cached</p></body></html>"))
+	}))
+	defer server.Close()
+	
+	defer func() { PageCache = nil }()
+	PageCache = DirCache(t.TempDir())
+	for i := 0; i < 2; i++ {
+		doc, info, err := HTMLRootInfo(context.Background(), server.URL+"/page")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(renderNode(doc), "<p>cached</p>") {
+			t.Fatalf("request %d: cached body missing", i)
+		}
+		if info.LastModified.Year() != 2021 {
+			t.Fatalf("request %d: LastModified: got %v", i, info.LastModified)
+		}
+	}
+	if requests != 2 || notModified != 1 {
+		t.Fatalf("want 2 requests with 1 not modified, got %d and %d", requests, notModified)
+	}
+}
+
+// TestMetrics tests that the Hooks of a Config are called for a downloaded
+// page, a cache hit and a failed request.
+func TestMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte("<html><body><p>page</p></body></html>"))
+	}))
+	defer server.Close()
+	
+	var requests, cacheHits, errs int
+	var statuses, sizes []int
+	config := ConfigFrom(context.Background())
+	config.PageCache = DirCache(t.TempDir())
+	config.Metrics = Hooks{
+		OnRequest:  func(url string) { requests++ },
+		OnResponse: func(url string, status, bytes int, d time.Duration) {
+			statuses = append(statuses, status)
+			sizes = append(sizes, bytes)
+		},
+		OnCacheHit: func(url string) { cacheHits++ },
+		OnError:    func(url string, err error) { errs++ },
+	}
+	ctx := WithConfig(context.Background(), config)
+	for _, path := range []string{"/page", "/page", "/missing"} {
+		HTMLRootContext(ctx, server.URL+path)
+	}
+	
+	if requests != 3 || cacheHits != 1 || errs != 1 {
+		t.Fatalf("want 3 requests, 1 cache hit and 1 error, got %d, %d and %d", requests, cacheHits, errs)
+	}
+	want := []int{200, 304, 404}
+	if !reflect.DeepEqual(statuses, want) {
+		t.Fatalf("statuses: want %v, got %v", want, statuses)
+	}
+	if sizes[0] != len("<html><body><p>page</p></body></html>") || sizes[1] != 0 {
+		t.Fatalf("sizes: got %v", sizes)
+	}
+}
+
+// renderNode returns the HTML of n.
+func renderNode(n *html.Node) string {
+	var buf bytes.Buffer
+	html.Render(&buf, n)
+	return buf.String()
+}
+
+// TestConsentRequired tests that HTMLRoot returns ErrConsentRequired for a
+// cookie-consent interstitial, but not for a page with a banner over it.
+func TestConsentRequired(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := `<html><head></head><body><div id="didomi-host"><div class="didomi-popup">Accepter</div></div></body></html>`
+		if r.URL.Path == "/banner" {
+			page = `<html><head><link rel="canonical" href="https://www.larousse.fr/dictionnaires/francais/arbre/5066"></head><body><div id="didomi-host"></div><p>arbre</p></body></html>`
+		}
+		w.Write([]byte(page))
+	}))
+	defer server.Close()
+	
+	_, err := HTMLRoot(server.URL + "/interstitial")
+	if !errors.Is(err, ErrConsentRequired) {
+		t.Fatalf("interstitial: want ErrConsentRequired, got %v", err)
+	}
+	_, err = HTMLRoot(server.URL + "/banner")
+	if err != nil {
+		t.Fatalf("banner: want no error, got %v", err)
+	}
+}
+
+// TestParseHTML tests that Clean and ParseHTML give the tree HTMLRoot does,
+// whatever CleanPageData is.
+func TestParseHTML(t *testing.T) {
+	page := []byte("<html>\r\n<body>\n\t<div class=\"ZoneEntree\">\n\t\t<span>arbre</span>\n\t</div>\n</body>\n</html>")
+	cleaned := Clean(page)
+	if bytes.ContainsAny(cleaned, "\n\t\r") {
+		t.Errorf("Clean: want no newlines or tabs, got %q", cleaned)
+	}
+	
+	path := filepath.Join(t.TempDir(), "arbre.html")
+	err := ioutil.WriteFile(path, page, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := HTMLRoot(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { CleanPageData = true }()
+	for _, clean := range []bool{true, false} {
+		CleanPageData = clean
+		doc, err := ParseHTML(page)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := renderNode(doc); got != renderNode(want) {
+			t.Errorf("CleanPageData %v: want %s, got %s", clean, renderNode(want), got)
+		}
+	}
+}
+
+// TestOfflineMode tests that no request is sent in offline mode, while files
+// and cached pages can still be read.
+func TestOfflineMode(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte("<html><body><p>online</p></body></html>"))
+	}))
+	defer server.Close()
+	
+	defer func() { OfflineMode, PageCache = false, nil }()
+	OfflineMode = true
+	PageCache = DirCache(t.TempDir())
+	_, err := HTMLRoot(server.URL + "/page")
+	if !errors.Is(err, ErrOfflineMode) {
+		t.Fatalf("uncached URL: want ErrOfflineMode, got %v", err)
+	}
+	
+	err = PageCache.Put(server.URL+"/cached", CachedPage{Body: []byte("<html><body><p>cached</p></body></html>"), ETag: `"v1"`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := HTMLRoot(server.URL + "/cached")
+	if err != nil || !strings.Contains(renderNode(doc), "<p>cached</p>") {
+		t.Fatalf("cached URL: want the cached page, got %v", err)
+	}
+	
+	path := filepath.Join(t.TempDir(), "page.html")
+	err = ioutil.WriteFile(path, []byte("<html><body><p>file</p></body></html>"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = HTMLRoot(path)
+	if err != nil {
+		t.Fatalf("file: want no error, got %v", err)
+	}
+	
+	OfflineMode = false
+	ctx := WithConfig(context.Background(), Config{OfflineMode: true})
+	_, err = NewRequest(ctx, http.MethodGet, server.URL)
+	if !errors.Is(err, ErrOfflineMode) {
+		t.Fatalf("Config: want ErrOfflineMode, got %v", err)
+	}
+	if requests != 0 {
+		t.Fatalf("want no requests, got %d", requests)
+	}
+}
@@ -0,0 +1,116 @@
+package laroussefr
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ipaVowels are the IPA symbols treated as syllable nuclei by SplitSyllables,
+// including the Greek epsilon Larousse writes for [ɛ].
+const ipaVowels = "aeiouyɑɐɒæɔəɘɛɜɞɪʊʌʏøœɶɤɯɨʉɵε"
+
+// ipaStressMarks are the symbols which begin a stressed syllable.
+const ipaStressMarks = "ˈˌ'"
+
+// SplitSyllables splits ipa, a phonetic transcription such as a Header's
+// Phonetic, into syllables, e.g. "[mɑ̃ʒe]" becomes ["mɑ̃", "ʒe"]. It's meant
+// for reading aids, so it isn't linguistically perfect.
+// 
+// The surrounding brackets are removed, and if ipa lists the pronunciations of
+// several forms, e.g. "[kur, kurt]", only the first one is split. Words
+// separated by spaces are split separately. A syllable boundary is placed at
+// every dot and before every stress mark ("ˈ" or "ˌ"), which is kept at the
+// start of its syllable. Between those, each vowel begins a new syllable,
+// along with the consonant before it, or the two before it if they're a
+// consonant followed by "r" or "l", e.g. "[vənir]" becomes ["və", "nir"].
+func SplitSyllables(ipa string) []string {
+	ipa = strings.Trim(strings.TrimSpace(ipa), "[]/")
+	if i := strings.Index(ipa, ","); i >= 0 {
+		ipa = ipa[:i]
+	}
+	var out []string
+	for _, word := range strings.Fields(ipa) {
+		for _, chunk := range splitAtMarks(word) {
+			out = append(out, splitAtVowels(chunk)...)
+		}
+	}
+	return out
+}
+
+// splitAtMarks splits word at its dots and before its stress marks.
+func splitAtMarks(word string) []string {
+	var out []string
+	var cur []rune
+	for _, r := range word {
+		switch {
+			case r == '.':
+				out, cur = appendChunk(out, cur), nil
+			case strings.ContainsRune(ipaStressMarks, r):
+				out, cur = appendChunk(out, cur), []rune{r}
+			default:
+				cur = append(cur, r)
+		}
+	}
+	return appendChunk(out, cur)
+}
+
+// appendChunk appends chunk to out if it isn't empty.
+func appendChunk(out []string, chunk []rune) []string {
+	if len(chunk) > 0 {
+		out = append(out, string(chunk))
+	}
+	return out
+}
+
+// splitAtVowels splits chunk, which has no dots or stress marks besides a
+// leading one, into syllables which each hold one vowel, or one diphthong.
+func splitAtVowels(chunk string) []string {
+	// each segment is a symbol followed by its diacritics, e.g. "ɑ̃" or "iː"
+	var segs []string
+	for _, r := range chunk {
+		if len(segs) > 0 && (unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Lm, r)) {
+			segs[len(segs)-1] += string(r)
+		} else {
+			segs = append(segs, string(r))
+		}
+	}
+	
+	var nuclei []int
+	for i, s := range segs {
+		if !isIPAVowel(s) {
+			continue
+		}
+		// the second half of a diphthong, e.g. "eɪ" or "əʊ"
+		prev := len(nuclei) - 1
+		if prev >= 0 && nuclei[prev] == i-1 && strings.ContainsRune("ɪʊə", []rune(s)[0]) {
+			continue
+		}
+		nuclei = append(nuclei, i)
+	}
+	if len(nuclei) < 2 {
+		return []string{chunk}
+	}
+	
+	var out []string
+	start := 0
+	for _, n := range nuclei[1:] {
+		boundary := n
+		if n > 0 && !isIPAVowel(segs[n-1]) {
+			boundary = n - 1
+			if n > 1 && !isIPAVowel(segs[n-2]) && strings.ContainsAny(segs[n-1], "rlʁ") && !strings.ContainsAny(segs[n-2], "rlʁjwɥ") {
+				boundary = n - 2
+			}
+		}
+		if boundary > start {
+			out = append(out, strings.Join(segs[start:boundary], ""))
+			start = boundary
+		}
+	}
+	return append(out, strings.Join(segs[start:], ""))
+}
+
+// isIPAVowel returns true if seg, a symbol followed by its diacritics, is a
+// vowel.
+func isIPAVowel(seg string) bool {
+	return seg != "" && strings.ContainsRune(ipaVowels, []rune(seg)[0])
+}
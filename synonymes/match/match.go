@@ -0,0 +1,46 @@
+// Package match contains matcher functions to be used with package
+// github.com/yhat/scrape.
+package match
+
+import (
+	"github.com/yhat/scrape"
+	
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// class returns n's "class" attribute.
+func class(n *html.Node) string {
+	return scrape.Attr(n, "class")
+}
+
+// HeaderTexteNode returns true if n is the <h1> element holding the word.
+func HeaderTexteNode(n *html.Node) bool {
+	return n.DataAtom == atom.H1 && class(n) == "AdresseSynonyme"
+}
+
+// HeaderTypeNode returns true if n is the <p> element holding the word's type.
+func HeaderTypeNode(n *html.Node) bool {
+	return n.DataAtom == atom.P && class(n) == "CatgramSynonyme"
+}
+
+// GroupNode returns true if n is an item on the list of senses, each of which
+// groups the synonyms and antonyms for one meaning of the word.
+func GroupNode(n *html.Node) bool {
+	return n.DataAtom == atom.Li && class(n) == "DivisionSynonyme"
+}
+
+// GroupSensNode returns true if n holds the Sens field of a group.
+func GroupSensNode(n *html.Node) bool {
+	return n.DataAtom == atom.P && class(n) == "SensSynonyme"
+}
+
+// GroupSynonymesNode returns true if n holds the synonyms of a group.
+func GroupSynonymesNode(n *html.Node) bool {
+	return n.DataAtom == atom.P && class(n) == "ListeSynonymes"
+}
+
+// GroupContrairesNode returns true if n holds the antonyms of a group.
+func GroupContrairesNode(n *html.Node) bool {
+	return n.DataAtom == atom.P && class(n) == "ListeContraires"
+}
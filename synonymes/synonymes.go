@@ -0,0 +1,388 @@
+// Package synonymes provides functions for scraping Larousse's dictionary of
+// French synonyms.
+// 
+// A page from this dictionary shows a word and its type, followed by a list of
+// senses. Each sense groups the synonyms, and sometimes the antonyms, which
+// apply to that particular meaning of the word. This is represented by the
+// Group type.
+// 
+// For the shorter SYNONYMES ET CONTRAIRES section of a definition page, see
+// package definition.
+package synonymes
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	
+	"github.com/serope/laroussefr"
+	"github.com/serope/laroussefr/scrapeutil"
+	"github.com/serope/laroussefr/synonymes/match"
+	
+	"github.com/yhat/scrape"
+	"golang.org/x/net/html"
+)
+
+// ErrWordNotFound is laroussefr.ErrWordNotFound. The error returned by New or
+// NewFromFileOrURL if the requested word isn't found wraps it, so
+// errors.Is(err, ErrWordNotFound) detects a missing word in any package.
+var ErrWordNotFound = laroussefr.ErrWordNotFound
+
+// Type Result represents a page from Larousse's dictionary of synonyms.
+// 
+// FetchedAt is when the page was downloaded, and LastModified is the time in
+// the response's Last-Modified header, if Larousse sent one. For a page read
+// from a file, both are the file's modification time.
+type Result struct {
+	PageID       int
+	Texte        string
+	Type         string
+	Groups       []Group
+	SeeAlso      []string
+	FetchedAt    time.Time
+	LastModified time.Time
+}
+
+// equals compares r and q. If they're equal, an empty string and true are
+// returned. Otherwise, a message describing the inequality and false are
+// returned.
+func (r Result) equals(q Result) (string, bool) {
+	switch {
+	case r.PageID != q.PageID:           return fmt.Sprintf("PageID\nr: %d\nq: %d", r.PageID, q.PageID), false
+	case r.Texte != q.Texte:             return fmt.Sprintf("Texte: r:%s\nq:%s", r.Texte, q.Texte), false
+	case r.Type != q.Type:               return fmt.Sprintf("Type: r:%s\nq:%s", r.Type, q.Type), false
+	case len(r.Groups) != len(q.Groups): return fmt.Sprintf("len(Groups)\nr: %d\nq: %d", len(r.Groups), len(q.Groups)), false
+	}
+	for i := range r.Groups {
+		message, ok := r.Groups[i].equals(q.Groups[i])
+		if !ok {
+			return fmt.Sprintf("Groups[%d]: %s", i, message), false
+		}
+	}
+	return "", true
+}
+
+// Equal returns true if every field of r and q is equal. FetchedAt and
+// LastModified are compared with time.Time's Equal method, which ignores their
+// locations, so a Result decoded by FromGob equals the encoded one.
+func (r Result) Equal(q Result) bool {
+	if !r.FetchedAt.Equal(q.FetchedAt) || !r.LastModified.Equal(q.LastModified) {
+		return false
+	}
+	r.FetchedAt, r.LastModified = time.Time{}, time.Time{}
+	q.FetchedAt, q.LastModified = time.Time{}, time.Time{}
+	return reflect.DeepEqual(r, q)
+}
+
+// ToGob encodes r with encoding/gob, which is more compact and faster to
+// decode than JSON, e.g. for caching Results in a binary store. It's decoded
+// by FromGob.
+func (r Result) ToGob() ([]byte, error) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(r)
+	if err != nil {
+		return nil, laroussefr.NewError("ToGob", "", err.Error())
+	}
+	return buf.Bytes(), nil
+}
+
+// FromGob decodes a Result encoded by ToGob.
+func FromGob(data []byte) (Result, error) {
+	var r Result
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&r)
+	if err != nil {
+		return Result{}, laroussefr.NewKindError("FromGob", "", err.Error(), laroussefr.ErrInvalidInput)
+	}
+	return r, nil
+}
+
+// Type Group represents one sense of a word, along with its synonyms and
+// antonyms.
+// 
+// Sens is a short description of the meaning that the synonyms apply to. It
+// may be empty if the word only has one sense.
+type Group struct {
+	Sens       string
+	Synonymes  []string
+	Contraires []string
+}
+
+// equals returns true if g and h are identical.
+func (g Group) equals(h Group) (string, bool) {
+	switch {
+	case g.Sens != h.Sens:                       return fmt.Sprintf("Sens: g:%s\nh:%s", g.Sens, h.Sens), false
+	case len(g.Synonymes) != len(h.Synonymes):   return fmt.Sprintf("len(Synonymes)\ng: %d\nh: %d", len(g.Synonymes), len(h.Synonymes)), false
+	case len(g.Contraires) != len(h.Contraires): return fmt.Sprintf("len(Contraires)\ng: %d\nh: %d", len(g.Contraires), len(h.Contraires)), false
+	}
+	for i := range g.Synonymes {
+		if g.Synonymes[i] != h.Synonymes[i] {
+			return fmt.Sprintf("Synonymes[%d] \n g:%s \n h:%s", i, g.Synonymes[i], h.Synonymes[i]), false
+		}
+	}
+	for i := range g.Contraires {
+		if g.Contraires[i] != h.Contraires[i] {
+			return fmt.Sprintf("Contraires[%d] \n g:%s \n h:%s", i, g.Contraires[i], h.Contraires[i]), false
+		}
+	}
+	return "", true
+}
+
+
+// New takes a French word and searches for its synonyms on Larousse.
+// 
+// If the word doesn't exist, an error ErrWordNotFound is returned. If Larousse
+// provides search suggestions for this nonexistent word, they will be put into
+// the returned Result's SeeAlso slice.
+func New(word string) (Result, error) {
+	return NewContext(context.Background(), word)
+}
+
+// NewContext is like New, but the download is cancelled when ctx is done.
+func NewContext(ctx context.Context, word string) (Result, error) {
+	if word == "" {
+		return Result{}, laroussefr.NewKindError("New", word, "Empty string", laroussefr.ErrInvalidInput)
+	}
+	url := laroussefr.WordURL("synonymes", word)
+	return NewFromFileOrURLContext(ctx, url)
+}
+
+// NewFromFileOrURL scrapes a synonyms page given as either an HTML filepath or
+// a URL.
+// 
+// If the result is a "word not found" page, an error ErrWordNotFound is
+// returned along with an empty Result whose PageID is
+// laroussefr.NotFoundPageID. If the page provides search suggestions, they will
+// be put into the returned Result's SeeAlso slice.
+func NewFromFileOrURL(in string) (Result, error) {
+	return NewFromFileOrURLContext(context.Background(), in)
+}
+
+// NewFromFileOrURLContext is like NewFromFileOrURL, but if in is a URL, the
+// download is cancelled when ctx is done.
+func NewFromFileOrURLContext(ctx context.Context, in string) (Result, error) {
+	res, _, err := newWithDoc(ctx, in)
+	return res, err
+}
+
+// ParseDocument scrapes a synonyms page which has already been downloaded and
+// parsed, given as its root node, e.g. one returned by scrapeutil.HTMLRoot.
+// Nothing is read or downloaded. doc isn't modified.
+// 
+// As with NewFromFileOrURL, an error ErrWordNotFound is returned for a "word
+// not found" page.
+func ParseDocument(doc *html.Node) (Result, error) {
+	if laroussefr.IsWordNotFoundPage(doc) {
+		return notFoundResult(doc), laroussefr.NewKindError("ParseDocument", "", "ErrWordNotFound", laroussefr.ErrWordNotFound)
+	}
+	res, err := newResultFromRoot(doc)
+	if err != nil {
+		return Result{}, laroussefr.NewKindError("ParseDocument", "", "Scrape step: " + err.Error(), laroussefr.ErrParse)
+	}
+	return res, nil
+}
+
+// NewWithDoc is like NewFromFileOrURL, but also returns the page's parsed root
+// node, so that data which Result doesn't model can be scraped from it without
+// downloading and parsing the page again. The node is nil if the page couldn't
+// be downloaded. Mutating it is unsupported.
+func NewWithDoc(in string) (Result, *html.Node, error) {
+	return newWithDoc(context.Background(), in)
+}
+
+// newWithDoc implements NewFromFileOrURLContext and NewWithDoc.
+func newWithDoc(ctx context.Context, in string) (Result, *html.Node, error) {
+	if !scrapeutil.FileExists(in) {
+		ok, message := isURL(in)
+		if !ok {
+			return Result{}, nil, laroussefr.NewKindError("NewFromFileOrURL", in, "Bad URL: " + message, laroussefr.ErrInvalidInput)
+		}
+		in = laroussefr.NormalizeURL(in)
+	}
+	
+	doc, info, err := scrapeutil.HTMLRootInfo(ctx, in)
+	if err != nil {
+		return Result{}, nil, laroussefr.WrapError("NewFromFileOrURL", in, "Download step: ", err)
+	}
+	
+	if laroussefr.IsWordNotFoundPage(doc) {
+		res := notFoundResult(doc)
+		res.setPageInfo(info)
+		return res, doc, laroussefr.NewKindError("NewFromFileOrURL", in, "ErrWordNotFound", laroussefr.ErrWordNotFound)
+	}
+	
+	res, err := newResultFromRoot(doc)
+	res.setPageInfo(info)
+	for i := 0; err != nil && i < scrapeutil.RetriesFor(ctx, in); i++ {
+		res, doc, err = retryFromURL(ctx, in)
+	}
+	if err != nil {
+		return Result{}, doc, laroussefr.NewKindError("NewFromFileOrURL", in, "Scrape step: " + err.Error(), laroussefr.ErrParse)
+	}
+	return res, doc, err
+}
+
+// retryFromURL downloads in again and scrapes it, after a previous attempt
+// failed on what may have been a truncated page.
+func retryFromURL(ctx context.Context, in string) (Result, *html.Node, error) {
+	doc, info, err := scrapeutil.HTMLRootInfo(ctx, in)
+	if err != nil {
+		return Result{}, nil, laroussefr.WrapError("retryFromURL", in, "", err)
+	}
+	res, err := newResultFromRoot(doc)
+	res.setPageInfo(info)
+	return res, doc, err
+}
+
+// DiffItems returns r's content for laroussefr.DiffResults: its header and each
+// of its Groups.
+func (r Result) DiffItems() []laroussefr.DiffItem {
+	out := []laroussefr.DiffItem{laroussefr.NewDiffItem("Header", r.Texte, r.Type)}
+	for _, g := range r.Groups {
+		syn := laroussefr.DiffList("synonymes", g.Synonymes)
+		con := laroussefr.DiffList("contraires", g.Contraires)
+		out = append(out, laroussefr.NewDiffItem("Groups", g.Sens, syn, con))
+	}
+	return out
+}
+
+// IsEmpty returns true if r has no content, i.e. no text, type or groups.
+// PageID and SeeAlso aren't considered, so the Result returned with
+// ErrWordNotFound is empty even if it has search suggestions.
+func (r Result) IsEmpty() bool {
+	return r.Texte == "" && r.Type == "" && len(r.Groups) == 0
+}
+
+// Links returns every Larousse link referenced in r, i.e. its SeeAlso URLs, as
+// absolute URLs without duplicates. Groups have no links of their own, since
+// Larousse shows their synonyms and antonyms as plain text.
+func (r Result) Links() []string {
+	return laroussefr.UniqueLinks(r.SeeAlso)
+}
+
+// ID returns r's PageID. It implements laroussefr.Page.
+func (r Result) ID() int {
+	return r.PageID
+}
+
+// SeeAlsoURLs returns r's SeeAlso. It implements laroussefr.Page.
+func (r Result) SeeAlsoURLs() []string {
+	return r.SeeAlso
+}
+
+// NotFound returns true if r was returned with ErrWordNotFound. It implements
+// laroussefr.Page.
+func (r Result) NotFound() bool {
+	return r.PageID == laroussefr.NotFoundPageID
+}
+
+// HasAudio returns false, since pages from the dictionary of synonyms have no
+// audio clips. It implements laroussefr.Page.
+func (r Result) HasAudio() bool {
+	return false
+}
+
+// setPageInfo sets r's FetchedAt and LastModified from info.
+func (r *Result) setPageInfo(info scrapeutil.PageInfo) {
+	r.FetchedAt = info.FetchedAt
+	r.LastModified = info.LastModified
+}
+
+// isURL verifies if str is a valid URL to a synonyms page on Larousse. If it
+// is, then true and "" are returned. Otherwise, false and a message describing
+// the problem are returned.
+func isURL(str string) (bool, string) {
+	ok, message := laroussefr.IsURL(str)
+	if !ok {
+		return false, message
+	}
+	
+	substr := "/dictionnaires/synonymes/"
+	if !strings.Contains(str, substr) {
+		return false, fmt.Sprintf("Must contain \"%s\"", substr)
+	}
+	
+	if strings.HasSuffix(str, substr) {
+		return false, "Missing word after \"" + substr + "\""
+	}
+	return true, ""
+}
+
+// notFoundResult returns the Result for a "word not found" page, which is empty
+// apart from its search suggestions.
+func notFoundResult(doc *html.Node) Result {
+	return Result{
+		PageID:  laroussefr.NotFoundPageID,
+		SeeAlso: laroussefr.GetSearchSuggestions(doc),
+	}
+}
+
+// newResultFromRoot returns a new Result from an HTML root.
+func newResultFromRoot(doc *html.Node) (Result, error) {
+	pageID, err := laroussefr.GetPageID(doc)
+	if err != nil {
+		return Result{}, laroussefr.NewError("newResultFromRoot", "", err.Error())
+	}
+	
+	n, ok := scrape.Find(doc, match.HeaderTexteNode)
+	if !ok {
+		return Result{}, laroussefr.NewError("newResultFromRoot", "", "failed to find HeaderTexte node")
+	}
+	texte := laroussefr.CleanWordText(scrape.Text(n))
+	
+	var typ string // typ is optional, like in package definition
+	n, ok = scrape.Find(doc, match.HeaderTypeNode)
+	if ok {
+		typ = scrape.Text(n)
+	}
+	
+	groups := findGroups(doc)
+	
+	seeAlso, err := laroussefr.GetSimilarWords(doc)
+	if err != nil {
+		return Result{}, laroussefr.NewError("newResultFromRoot", "", err.Error())
+	}
+	
+	res := Result{PageID: pageID, Texte: texte, Type: typ, Groups: groups, SeeAlso: seeAlso}
+	return res, nil
+}
+
+// findGroups returns a word's list of senses and their synonyms.
+func findGroups(doc *html.Node) []Group {
+	var out []Group
+	nodes := scrape.FindAll(doc, match.GroupNode)
+	for _, n := range nodes {
+		var g Group
+		m, ok := scrape.Find(n, match.GroupSensNode)
+		if ok {
+			g.Sens = scrape.Text(m)
+		}
+		m, ok = scrape.Find(n, match.GroupSynonymesNode)
+		if ok {
+			g.Synonymes = splitList(scrape.Text(m))
+		}
+		m, ok = scrape.Find(n, match.GroupContrairesNode)
+		if ok {
+			g.Contraires = splitList(scrape.Text(m))
+		}
+		out = append(out, g)
+	}
+	return out
+}
+
+// splitList splits a list of words separated by " - " or ", ", as found in a
+// group's synonyms or antonyms.
+func splitList(str string) []string {
+	str = strings.ReplaceAll(str, ", ", " - ")
+	var out []string
+	for _, s := range strings.Split(str, " - ") {
+		s = strings.Trim(s, " .")
+		if s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
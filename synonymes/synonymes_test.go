@@ -0,0 +1,96 @@
+// synonymes_test.go contains unit tests for exported functions.
+package synonymes
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+// TestNewBad tests New on bad args.
+func TestNewBad(t *testing.T) {
+	badArgs := []string {
+		"",
+		"larousse.fr/dictionnaires/synonymes",
+		"https://www.larousse.fr/dictionnaires/synonymes/",
+		"https://www.larousse.fr/dictionnaires/francais/beau/8514",
+	}
+	
+	for _, b := range badArgs {
+		fmt.Print(b, "\t")
+		_, err := NewFromFileOrURL(b)
+		if err == nil {
+			fmt.Println("FAIL - should be rejected")
+			t.Fail()
+		} else {
+			fmt.Println("OK")
+		}
+	}
+}
+
+// TestNewFromFileOrURL tests NewFromFileOrURL on saved pages.
+func TestNewFromFileOrURL(t *testing.T) {
+	table := map[string]string {
+		"testdata/beau.html": "beau",
+	}
+	
+	for path, word := range table {
+		fmt.Print(path, "\t")
+		
+		got, err := NewFromFileOrURL(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		
+		want, err := getCorrectResult(word)
+		if err != nil {
+			t.Fatal(err)
+		}
+		
+		message, ok := want.equals(got)
+		if !ok {
+			fmt.Printf("FAIL\n%s\n\n", message)
+			t.Fail()
+		} else {
+			fmt.Println("OK")
+		}
+	}
+}
+
+// getCorrectResult returns the expected Result of a test word.
+func getCorrectResult(word string) (Result, error) {
+	var str string
+	switch word {
+		case "beau": str = `{"PageID": 2114,"Texte": "beau","Type": "adjectif","Groups": [{"Sens": "Qui plaît à l'œil","Synonymes": ["joli","ravissant","splendide","superbe"],"Contraires": ["laid","affreux"]},{"Sens": "Qui est d'une grande valeur morale","Synonymes": ["noble","élevé","généreux"],"Contraires": null},{"Sens": "En parlant du temps","Synonymes": ["clair","ensoleillé"],"Contraires": ["mauvais","pluvieux"]}],"SeeAlso": ["https://larousse.fr/dictionnaires/synonymes/beauté/2117"]}`
+	}
+	
+	var res Result
+	err := json.Unmarshal([]byte(str), &res)
+	return res, err
+}
+
+// TestGob tests that a Result survives a ToGob and FromGob round trip with
+// every field intact, as checked by Equal, which also tells apart Results that
+// differ only in their SeeAlso.
+func TestGob(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/beau.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := res.ToGob()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := FromGob(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(res) {
+		message, _ := res.equals(got)
+		t.Errorf("round trip changed the Result\n%s\nwant %+v\ngot  %+v", message, res, got)
+	}
+	got.SeeAlso = nil
+	if got.Equal(res) {
+		t.Error("want Results with different SeeAlso unequal")
+	}
+}
@@ -0,0 +1,38 @@
+package traduction
+
+import (
+	"context"
+
+	"github.com/serope/laroussefr/scrapeutil"
+)
+
+// NewBatch looks up each word in words, as New does, running the downloads
+// according to opts. The results and errors are in the same order as words.
+//
+// If ctx is done before every word is looked up, the words that were
+// finished keep their results and the others, including those cancelled
+// mid-download, have ctx.Err() as their error. A deadline on ctx thus caps the
+// whole batch, while opts.Timeout caps each word.
+// 
+// If opts.Checkpoint is set, each result is saved to it, keyed by its word and
+// languages (e.g. "francais-anglais/aire"), and words which already have a
+// saved result aren't looked up again.
+// 
+// If opts.FailFast is set, the first error other than ErrWordNotFound cancels
+// the words left, whose errors wrap scrapeutil.ErrAborted. Otherwise, each
+// word's error is independent of the others.
+func NewBatch(ctx context.Context, words []string, from, to Language, opts scrapeutil.BatchOptions) ([]Result, []error) {
+	res := make([]Result, len(words))
+	errs := scrapeutil.Batch(ctx, len(words), opts, func(ctx context.Context, i int) error {
+		key := from.String() + "-" + to.String() + "/" + words[i]
+		return scrapeutil.Checkpointed(opts.Checkpoint, key, &res[i], func() error {
+			var err error
+			res[i], err = NewContext(ctx, words[i], from, to)
+			if err != nil && res[i].NotFound() {
+				return scrapeutil.SoftError(err)
+			}
+			return err
+		})
+	})
+	return res, errs
+}
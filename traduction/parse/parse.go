@@ -13,26 +13,79 @@ import (
 
 // Traduction takes a "Traduction" node and returns its inner text.
 func Traduction(n *html.Node) string {
-	var out string
-	m := n.FirstChild
-	for m != nil {
-		text := scrape.Text(m)
+	out, _ := traduction(n, true)
+	return out
+}
+
+// TraductionGenre takes a "Traduction" node and returns its inner text without
+// the gender and number markers ("Genre" nodes, e.g. "m" or "fpl"), followed
+// by the markers themselves in the order they appear.
+func TraductionGenre(n *html.Node) (string, []string) {
+	return traduction(n, false)
+}
+
+// traduction returns the inner text of a "Traduction" node. If keepGenre is
+// false, "Genre" nodes are left out of the text and returned separately.
+func traduction(n *html.Node, keepGenre bool) (string, []string) {
+	parts, genres := traductionParts(n, keepGenre)
+	out := strings.Join(parts, " ou ")
+	if !keepGenre {
+		out = strings.ReplaceAll(strings.TrimSpace(out), " ,", ",")
+	}
+	return out, genres
+}
+
+// TraductionAlternatives takes a "Traduction" node and returns the alternative
+// translations within it, which Larousse separates with "ou" (an "oubien"
+// node). For example, a node displayed as "x ou y" returns ["x", "y"]. If
+// keepGenre is false, the gender and number markers are left out.
+// 
+// If the node contains no alternatives, a slice with a single string is
+// returned.
+func TraductionAlternatives(n *html.Node, keepGenre bool) []string {
+	parts, _ := traductionParts(n, keepGenre)
+	out := make([]string, len(parts))
+	for i, part := range parts {
+		out[i] = alternative(part)
+	}
+	return out
+}
+
+// traductionParts walks the children of a "Traduction" node and returns their
+// text split at its "oubien" nodes, untrimmed, so that joining the parts with
+// " ou " gives the node's whole text. If keepGenre is false, "Genre" nodes are
+// left out of the text and returned separately.
+func traductionParts(n *html.Node, keepGenre bool) ([]string, []string) {
+	var parts, genres []string
+	var cur string
+	for m := n.FirstChild; m != nil; m = m.NextSibling {
+		text := laroussefr.Text(m)
 		class := scrape.Attr(m, "class")
-		if class == "Genre" || strings.HasSuffix(out, ",") {
-			out += " "
+		if class == "Genre" && !keepGenre {
+			genres = append(genres, text)
+			continue
+		}
+		if class == "Genre" || strings.HasSuffix(cur, ",") {
+			cur += " "
 		}
 		
 		if isOuBienNode(m) {
-			out += " ou "
+			parts = append(parts, cur)
+			cur = ""
 		} else if class != "lienconj2" && class != "Metalangue2" {
 			if strings.HasPrefix(text, "(") {
-				out += " "
+				cur += " "
 			}
-			out += text
+			cur += text
 		}
-		m = m.NextSibling
 	}
-	return out
+	return append(parts, cur), genres
+}
+
+// alternative trims an alternative translation collected by
+// TraductionAlternatives.
+func alternative(str string) string {
+	return strings.ReplaceAll(strings.TrimSpace(str), " ,", ",")
 }
 
 // isOuBienNode is true if n is a <span class="oubien"> node.
@@ -72,7 +125,7 @@ func parseEntreeTexte(n *html.Node) (string, error) {
 	if !ok {
 		return "", laroussefr.NewError("parseEntreeTexte", "", "Failed to find Adresse node")
 	}
-	return scrape.Text(adresseNode), nil
+	return laroussefr.CleanWordText(scrape.Text(adresseNode)), nil
 }
 
 // parseEntreeTexteAlt takes a "ZoneEntree" node and returns the value to be
@@ -82,7 +135,7 @@ func parseEntreTexteAlt(n *html.Node) string {
 	if !ok {
 		return ""
 	}
-	str := scrape.Text(formeFlechieAdresseNode)
+	str := laroussefr.CleanWordText(scrape.Text(formeFlechieAdresseNode))
 	if strings.HasPrefix(str, "( ") {
 		str = "(" + str[2:]
 	}
@@ -102,12 +155,29 @@ func parseEntreePhonetique(n *html.Node) string {
 
 // parseEntreeAudio takes a "ZoneEntree" node and returns the value to be
 // assigned to the Audio field.
+// 
+// On some pages, mostly English headwords, the audio clip isn't right after the
+// "lienson" node, or there's no "lienson" node at all, in which case the first
+// <audio> node in the header is used.
 func parseEntreeAudio(n *html.Node) string {
 	lienson, ok := scrape.Find(n, scrape.ByClass("lienson"))
+	if ok {
+		if url := Lienson(lienson); url != "" {
+			return url
+		}
+	}
+	audio, ok := scrape.Find(n, scrape.ByTag(atom.Audio))
 	if !ok {
 		return ""
 	}
-	return Lienson(lienson)
+	return laroussefr.GetAudioURL(audio)
+}
+
+// EntreeAudioClips takes a "ZoneEntree" node and returns every audio clip in
+// it with its region label, if any, without duplicates, in the order they
+// appear. Some headwords have more than one, e.g. for regional pronunciations.
+func EntreeAudioClips(n *html.Node) []laroussefr.AudioClip {
+	return laroussefr.GetAudioClips(scrape.FindAll(n, scrape.ByTag(atom.Audio)))
 }
 
 // parseEntreeType takes a "ZoneEntree" node and returns the value to be
@@ -121,27 +191,83 @@ func parseEntreeType(n *html.Node) string {
 		}
 	}
 	out := scrape.Text(m)
+	if hint := conjugaisonHintText(n); hint != "" {
+		out = strings.Replace(out, hint, "", 1)
+	}
 	out = strings.ReplaceAll(out, "Conjugaison", "")
 	out = strings.ReplaceAll(out, "  ", " ")
 	out = strings.Trim(out, " ")
 	return out
 }
 
+// EntreeConjugaisonHint takes a "ZoneEntree" node and returns the conjugation
+// hint which follows the link to a verb's conjugation page, without its
+// parentheses, e.g. "auxiliaire être" or "irrégulier". If there's none, an
+// empty string is returned. The hint isn't part of the Type returned by
+// ZoneEntree.
+func EntreeConjugaisonHint(n *html.Node) string {
+	hint := conjugaisonHintText(n)
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(hint, "("), ")"))
+}
+
+// EntreeOrigine takes a "ZoneEntree" node and returns the headword's origin,
+// which a few bilingual pages show after its type, without its parentheses,
+// e.g. "mot inuit". If there's none, an empty string is returned.
+func EntreeOrigine(n *html.Node) string {
+	for _, class := range []string{"Origine", "OrigineDefinition", "Etymologie"} {
+		m, ok := scrape.Find(n, scrape.ByClass(class))
+		if ok {
+			origine := scrape.Text(m)
+			return strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(origine, "("), ")"))
+		}
+	}
+	return ""
+}
+
+// conjugaisonHintText takes a "ZoneEntree" node and returns the text of the
+// nodes after its "lienconj" link, as it appears in the text of the link's
+// parent.
+func conjugaisonHintText(n *html.Node) string {
+	lienconj, ok := scrape.Find(n, scrape.ByClass("lienconj"))
+	if !ok {
+		return ""
+	}
+	var texts []string
+	for m := lienconj.NextSibling; m != nil; m = m.NextSibling {
+		if text := scrape.Text(m); text != "" {
+			texts = append(texts, text)
+		}
+	}
+	return strings.Join(texts, " ")
+}
+
 // Lienson takes a "lienson", "lienson2" or "lienson3" span node and returns the
 // URL to the audio clip.
 // 
 // Note that the URL in the "src" attribute redirects to a voix.laroussefr.fr
 // address.
+// 
+// The <audio> node is usually n's next sibling, but it's sometimes nested
+// inside n instead.
 func Lienson(n *html.Node) string {
+	return LiensonClip(n).URL
+}
+
+// LiensonClip is like Lienson, but also returns the clip's region label, if
+// any, as described by laroussefr.GetAudioLabel.
+func LiensonClip(n *html.Node) laroussefr.AudioClip {
 	m := n.NextSibling
-	if m == nil {
-		return ""
-	} else if m.Type == html.TextNode {
+	for m != nil && m.Type == html.TextNode {
 		m = m.NextSibling
-	} else if m.DataAtom != atom.Audio {
-		return ""
 	}
-	return laroussefr.GetAudioURL(m)
+	if m != nil && m.DataAtom == atom.Audio {
+		return laroussefr.GetAudioClip(m)
+	}
+	audio, ok := scrape.Find(n, scrape.ByTag(atom.Audio))
+	if !ok {
+		return laroussefr.AudioClip{}
+	}
+	return laroussefr.GetAudioClip(audio)
 }
 
 // Adresse takes an "Adresse" node and returns the header values for a
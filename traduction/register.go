@@ -0,0 +1,113 @@
+package traduction
+
+import (
+	"strings"
+)
+
+// Type Register is an enum type for the usage labels that Larousse shows in
+// red parentheses, i.e. the RedMeta of a Meaning or Phrase.
+// 
+// Values: UnknownRegister, Familier, TresFamilier, Vulgaire, Argot,
+// Populaire, Vieilli, Litteraire, Soutenu, Regional, Pejoratif, Humoristique
+type Register int
+
+// Available values for Register. UnknownRegister is used for an empty RedMeta
+// and for labels which aren't recognized.
+const (
+	UnknownRegister Register = iota
+	Familier
+	TresFamilier
+	Vulgaire
+	Argot
+	Populaire
+	Vieilli
+	Litteraire
+	Soutenu
+	Regional
+	Pejoratif
+	Humoristique
+)
+
+func (reg Register) String() string {
+	switch reg {
+		case Familier:     return "familier"
+		case TresFamilier: return "très familier"
+		case Vulgaire:     return "vulgaire"
+		case Argot:        return "argot"
+		case Populaire:    return "populaire"
+		case Vieilli:      return "vieilli"
+		case Litteraire:   return "littéraire"
+		case Soutenu:      return "soutenu"
+		case Regional:     return "régional"
+		case Pejoratif:    return "péjoratif"
+		case Humoristique: return "humoristique"
+	}
+	return ""
+}
+
+// registerLabels maps the labels found in RedMeta, in either language and
+// with or without abbreviation, to their Register.
+var registerLabels = map[string]Register{
+	"familier":      Familier,
+	"fam":           Familier,
+	"informal":      Familier,
+	"inf":           Familier,
+	"très familier": TresFamilier,
+	"tres familier": TresFamilier,
+	"very informal": TresFamilier,
+	"vulgaire":      Vulgaire,
+	"vulg":          Vulgaire,
+	"vulgar":        Vulgaire,
+	"argot":         Argot,
+	"arg":           Argot,
+	"slang":         Argot,
+	"populaire":     Populaire,
+	"pop":           Populaire,
+	"vieilli":       Vieilli,
+	"vieux":         Vieilli,
+	"vx":            Vieilli,
+	"dated":         Vieilli,
+	"old-fashioned": Vieilli,
+	"archaïque":     Vieilli,
+	"archaic":       Vieilli,
+	"littéraire":    Litteraire,
+	"litt":          Litteraire,
+	"literary":      Litteraire,
+	"soutenu":       Soutenu,
+	"sout":          Soutenu,
+	"formal":        Soutenu,
+	"fml":           Soutenu,
+	"régional":      Regional,
+	"régionalisme":  Regional,
+	"rég":           Regional,
+	"regional":      Regional,
+	"péjoratif":     Pejoratif,
+	"péj":           Pejoratif,
+	"pejorative":    Pejoratif,
+	"pej":           Pejoratif,
+	"humoristique":  Humoristique,
+	"hum":           Humoristique,
+	"humorous":      Humoristique,
+}
+
+// ParseRegister returns the Register of a RedMeta string such as
+// "(familier)" or "(vieilli)". If str holds several labels, e.g.
+// "(familier & péjoratif)", the first one is used. UnknownRegister is returned
+// if no label is recognized.
+func ParseRegister(str string) Register {
+	str = strings.ToLower(strings.TrimSpace(str))
+	str = strings.Trim(str, "()[] ")
+	if reg, ok := registerLabels[strings.TrimSuffix(str, ".")]; ok {
+		return reg
+	}
+	labels := strings.FieldsFunc(str, func(r rune) bool {
+		return strings.ContainsRune(",;&/", r)
+	})
+	if len(labels) > 1 {
+		label := strings.TrimSuffix(strings.TrimSpace(labels[0]), ".")
+		if reg, ok := registerLabels[label]; ok {
+			return reg
+		}
+	}
+	return UnknownRegister
+}
@@ -0,0 +1,92 @@
+package traduction
+
+import (
+	"bytes"
+	"strings"
+	htmltemplate "html/template"
+)
+
+// htmlTemplate renders a Result as HTML. Every field is escaped by
+// html/template, since the text comes from a third-party page.
+var htmlTemplate = htmltemplate.Must(htmltemplate.New("traduction").Funcs(htmltemplate.FuncMap{
+	"join": strings.Join,
+}).Parse(`
+{{- define "red" -}}
+{{if .RedCaps}}<span class="lfr-red-caps">{{.RedCaps}}</span> {{end -}}
+{{if .RedBrac}}<span class="lfr-red-brac">{{.RedBrac}}</span> {{end -}}
+{{if .RedMeta}}<span class="lfr-red-meta">{{.RedMeta}}</span> {{end -}}
+{{- end -}}
+{{- define "audio" -}}
+{{if .}} <a class="lfr-audio" href="{{.}}">écouter</a>{{end -}}
+{{- end -}}
+{{- define "phrase" -}}
+<li{{if .IsBlue}} class="lfr-blue"{{end}}><span class="lfr-source">{{.Text1}}</span>{{if .Phonetic1}} <span class="lfr-phonetic">{{.Phonetic1}}</span>{{end}}{{template "audio" .Audio1}} {{template "red" .}}<span class="lfr-target">{{.Text2}}</span>{{if .Phonetic2}} <span class="lfr-phonetic">{{.Phonetic2}}</span>{{end}}{{template "audio" .Audio2}}
+{{- with .Subphrases}}
+<ol class="lfr-subphrases">
+{{- range .}}
+{{template "phrase" .}}
+{{- end}}
+</ol>
+{{- end -}}
+</li>
+{{- end -}}
+<article class="lfr-traduction">
+{{- range .Words}}
+<section class="lfr-word">
+<header class="lfr-header">
+<h1>{{.Header.Text}}{{if .Header.TextAlt}}, {{.Header.TextAlt}}{{end}}</h1>
+{{- if .Header.Phonetic}}
+<span class="lfr-phonetic">{{.Header.Phonetic}}</span>
+{{- end}}
+{{- if .Header.Audio}}
+<a class="lfr-audio" href="{{.Header.Audio}}">écouter</a>
+{{- end}}
+{{- if .Header.Type}}
+<p class="lfr-type">{{.Header.Type}}</p>
+{{- end}}
+</header>
+{{- range .Subheaders}}
+<div class="lfr-subheader">
+{{- if .Title}}
+<h2>{{.Title}}</h2>
+{{- end}}
+<ol>
+{{- range .Items}}
+<li>
+{{- if or .RedCaps .RedBrac .RedMeta}}
+<p class="lfr-item-context">{{template "red" .}}</p>
+{{- end}}
+{{- range .Meanings}}
+<p class="lfr-meaning">{{template "red" .}}{{.Text}}{{if .TargetGenre}} <span class="lfr-genre">{{join .TargetGenre ", "}}</span>{{end}}{{if .CrossRef}}{{if .Text}} {{end}}<span class="lfr-crossref">→ {{.CrossRef}}</span>{{end}}</p>
+{{- end}}
+{{- with .Phrases}}
+<ul class="lfr-phrases">
+{{- range .}}
+{{template "phrase" .}}
+{{- end}}
+</ul>
+{{- end}}
+</li>
+{{- end}}
+</ol>
+</div>
+{{- end}}
+</section>
+{{- end}}
+</article>
+`))
+
+// HTML renders r as semantic HTML for embedding in a web page. Red contexts,
+// blue expressions and audio links get the CSS classes lfr-red-caps,
+// lfr-red-brac, lfr-red-meta, lfr-blue and lfr-audio, so they can be styled
+// like Larousse's own pages. An Item's own red contexts are shown once, in a
+// paragraph of class lfr-item-context above its meanings. All scraped text is
+// HTML-escaped, and the output is the same for equal Results.
+func (r Result) HTML() (string, error) {
+	var buf bytes.Buffer
+	err := htmlTemplate.Execute(&buf, r)
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -3,8 +3,15 @@
 package traduction
 
 import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
 	"fmt"
+	"reflect"
 	"strings"
+	"time"
+	"unicode"
 	
 	"github.com/serope/laroussefr"
 	"github.com/serope/laroussefr/scrapeutil"
@@ -12,11 +19,79 @@ import (
 	
 	"github.com/yhat/scrape"
 	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
 )
 
-// ErrWordNotFound is returned by New or NewFromFileOrURL if the requested word
-// isn't found.
-var ErrWordNotFound error = laroussefr.ErrWordNotFound
+// ErrWordNotFound is laroussefr.ErrWordNotFound. The error returned by New or
+// NewFromFileOrURL if the requested word isn't found wraps it, so
+// errors.Is(err, ErrWordNotFound) detects a missing word in any package.
+var ErrWordNotFound = laroussefr.ErrWordNotFound
+
+// Diagnostics, if non-nil, is called with a short message whenever a scraper
+// skips a node it doesn't recognize or drops data, e.g. a node inside a phrase
+// whose class isn't handled. This is useful for discovering Larousse markup
+// which isn't supported yet.
+// 
+// It's nil by default. It shouldn't be changed while a page is being scraped.
+var Diagnostics func(message string)
+
+// IncludeRawHTML controls whether each Word's RawHTML field is set to the HTML
+// of the nodes it was scraped from, which is useful for reporting fields that
+// were scraped wrong. It's false by default to save memory.
+var IncludeRawHTML = false
+
+// StrictUnknownClasses controls whether a node whose class isn't handled by
+// the scrapers of meanings and phrases makes the whole scrape fail, instead of
+// being skipped. It's meant for tests and CI, where it catches data silently
+// lost to markup changes on Larousse's side. Such nodes are passed to
+// Diagnostics either way.
+// 
+// It's false by default. It shouldn't be changed while a page is being
+// scraped.
+var StrictUnknownClasses = false
+
+// TolerantParsing controls whether the Meanings of an item are scraped again
+// with a more forgiving method when the usual one finds no translation even
+// though the item has one. The usual method walks the item's child nodes in
+// order and stops at the first node it doesn't expect, so a stray element, e.g.
+// one added by the HTML parser to fix an unclosed tag, can hide every Meaning
+// after it. The fallback searches the whole item for the classes of a
+// Meaning's fields instead, wherever they are, outside of its phrases. Each
+// recovered item is passed to Diagnostics.
+// 
+// It's false by default. It shouldn't be changed while a page is being
+// scraped.
+var TolerantParsing = false
+
+// MergeGenderVariants controls whether adjacent Words which are the masculine
+// and feminine forms of the same entry are collapsed into one Word, as
+// Larousse does on most pages, e.g. "chanteur (chanteuse)". Two Words are
+// merged if they have the same Code, or if one's TextAlt names the other's
+// Text. The merged Word keeps the first one's Code and Header, with the
+// second one's Text, Phonetic, Type and AudioURLs added to it, followed by
+// the Subheaders of both.
+// 
+// It's false by default. It shouldn't be changed while a page is being
+// scraped.
+var MergeGenderVariants = false
+
+// diagnose formats a message and passes it to Diagnostics, if it's set.
+func diagnose(format string, a ...interface{}) {
+	if Diagnostics != nil {
+		Diagnostics(fmt.Sprintf(format, a...))
+	}
+}
+
+// unknownClass reports n, a node of a Meaning or Phrase (kind) whose class
+// isn't handled, to Diagnostics. If StrictUnknownClasses is true, an error is
+// returned as well.
+func unknownClass(kind, class string, n *html.Node) error {
+	diagnose("%s: skipped node of class %q: %q", kind, class, scrape.Text(n))
+	if StrictUnknownClasses {
+		return laroussefr.NewError(kind + ".update", class, "Unknown class: " + scrape.Text(n))
+	}
+	return nil
+}
 
 // Type Language is an enum type.
 // 
@@ -48,10 +123,31 @@ const (
 // SeeAlso is a slice of URLs of similar words found in the word carousel near
 // the bottom of the page. If a Result ends up being a "word not found" page,
 // then SeeAlso will contain search suggestions, if any are provided.
+// 
+// FetchedAt is when the page was downloaded, and LastModified is the time in
+// the response's Last-Modified header, if Larousse sent one. For a page read
+// from a file, both are the file's modification time.
+// 
+// RedirectTo is the entry that a redirect-only page refers to with an arrow
+// ("→"), e.g. "clé" for "clef". It's empty for other pages.
 type Result struct {
-	PageID  int
-	Words   []Word
-	SeeAlso []string
+	PageID       int
+	Words        []Word
+	SeeAlso      []string
+	FetchedAt    time.Time
+	LastModified time.Time
+	RedirectTo   Redirect
+}
+
+// Type Redirect is the entry referred to by a redirect-only page, i.e. one
+// whose only content is a cross-reference (see Meaning.CrossRef).
+// 
+// Word is the referred word, e.g. "clé", and URL is the absolute URL of its
+// page, resolved against laroussefr.BaseURL like SeeAlso. If the page doesn't
+// link to it, URL is the one New would download, which is under BaseURL too.
+type Redirect struct {
+	Word string
+	URL  string
 }
 
 // equals compares r and q. If they're equal, an empty string and true are
@@ -65,6 +161,9 @@ type Result struct {
 // 
 // http.Get -> https://larousse.fr/dictionnaires/francais-anglais/Airbag<sup>®</sup>/82998
 // wget     -> https://larousse.fr/dictionnaires/francais-anglais/AirbagAirbag/82998
+// 
+// Both forms are stored as ".../Airbag®/82998" (see GetSimilarWords), but the
+// page IDs remain the only part that identifies a page.
 func (r Result) equals(q Result) (string, bool) {
 	comparisonFuncs := []func(Result)(string,bool) {
 		r.equalPageIDs,
@@ -83,6 +182,40 @@ func (r Result) equals(q Result) (string, bool) {
 	return "", true
 }
 
+// Equal returns true if every field of r and q is equal. FetchedAt and
+// LastModified are compared with time.Time's Equal method, which ignores their
+// locations, so a Result decoded by FromGob equals the encoded one.
+func (r Result) Equal(q Result) bool {
+	if !r.FetchedAt.Equal(q.FetchedAt) || !r.LastModified.Equal(q.LastModified) {
+		return false
+	}
+	r.FetchedAt, r.LastModified = time.Time{}, time.Time{}
+	q.FetchedAt, q.LastModified = time.Time{}, time.Time{}
+	return reflect.DeepEqual(r, q)
+}
+
+// ToGob encodes r with encoding/gob, which is more compact and faster to
+// decode than JSON, e.g. for caching Results in a binary store. It's decoded
+// by FromGob.
+func (r Result) ToGob() ([]byte, error) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(r)
+	if err != nil {
+		return nil, laroussefr.NewError("ToGob", "", err.Error())
+	}
+	return buf.Bytes(), nil
+}
+
+// FromGob decodes a Result encoded by ToGob.
+func FromGob(data []byte) (Result, error) {
+	var r Result
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&r)
+	if err != nil {
+		return Result{}, laroussefr.NewKindError("FromGob", "", err.Error(), laroussefr.ErrInvalidInput)
+	}
+	return r, nil
+}
+
 // equalPageIDs returns true if r and q have identical page IDs.
 func (r Result) equalPageIDs(q Result) (string, bool) {
 	if r.PageID != q.PageID {
@@ -142,6 +275,243 @@ func (r Result) equalSeeAlsoIDs(q Result) (string, bool) {
 	return "", true
 }
 
+// IsEmpty returns true if r has no words. PageID and SeeAlso aren't
+// considered, so the Result returned with ErrWordNotFound is empty even if it
+// has search suggestions.
+func (r Result) IsEmpty() bool {
+	return len(r.Words) == 0
+}
+
+// Links returns every Larousse link referenced in r, i.e. its words'
+// conjugation links and cross-references, the page it redirects to and its
+// SeeAlso URLs, as absolute URLs without duplicates. Cross-references which
+// Larousse doesn't link, such as "coup de téléphone" on the page for "coup",
+// aren't included.
+func (r Result) Links() []string {
+	var urls []string
+	for _, w := range r.Words {
+		urls = append(urls, w.Header.InfinitiveURL)
+		for _, sub := range w.Subheaders {
+			for _, item := range sub.Items {
+				for _, m := range item.Meanings {
+					urls = append(urls, m.CrossRefURL)
+				}
+			}
+		}
+	}
+	urls = append(urls, r.RedirectTo.URL)
+	urls = append(urls, r.SeeAlso...)
+	return laroussefr.UniqueLinks(urls)
+}
+
+// ID returns r's PageID. It implements laroussefr.Page.
+func (r Result) ID() int {
+	return r.PageID
+}
+
+// SeeAlsoURLs returns r's SeeAlso. It implements laroussefr.Page.
+func (r Result) SeeAlsoURLs() []string {
+	return r.SeeAlso
+}
+
+// NotFound returns true if r was returned with ErrWordNotFound. It implements
+// laroussefr.Page.
+func (r Result) NotFound() bool {
+	return r.PageID == laroussefr.NotFoundPageID
+}
+
+// HasAudio returns true if any of r's Words has an audio clip.
+func (r Result) HasAudio() bool {
+	for _, w := range r.Words {
+		if w.HasAudio() {
+			return true
+		}
+	}
+	return false
+}
+
+// AudioURLs returns the URLs of every audio clip in r, from its Words' headers
+// and phrases, without duplicates, in page order.
+func (r Result) AudioURLs() []string {
+	var urls []string
+	for _, w := range r.Words {
+		urls = append(urls, w.Header.Audio)
+		urls = append(urls, w.Header.AudioURLs...)
+		for _, sub := range w.Subheaders {
+			for _, item := range sub.Items {
+				for _, p := range item.Phrases {
+					urls = p.appendAudioURLs(urls)
+				}
+			}
+		}
+	}
+	return laroussefr.UniqueLinks(urls)
+}
+
+// AssetCount returns the number of distinct audio clips in r, i.e. the number
+// of files that downloading all of its audio would take.
+func (r Result) AssetCount() (audioCount int) {
+	return len(r.AudioURLs())
+}
+
+// IsRedirect returns true if r is a redirect-only page, which has no content
+// of its own besides a reference to r.RedirectTo.
+func (r Result) IsRedirect() bool {
+	return r.RedirectTo.Word != ""
+}
+
+// PartsOfSpeech returns the grammatical categories in the Types of r's Words'
+// headers, without duplicates, in the order they first appear, as described
+// by laroussefr.PartsOfSpeech, e.g. ["nom masculin", "adjectif"].
+func (r Result) PartsOfSpeech() []string {
+	var types []string
+	for _, w := range r.Words {
+		types = append(types, w.Header.Type)
+	}
+	return laroussefr.PartsOfSpeech(types...)
+}
+
+// Lemma returns r's headword, which is the Text of its first Word's Header,
+// normalized with laroussefr.NormalizeLemma. For a redirect-only page, the
+// word it redirects to is returned instead, e.g. "clé" for "clef". It's empty
+// if r has no Words.
+func (r Result) Lemma() string {
+	switch {
+		case r.IsRedirect():
+			return laroussefr.NormalizeLemma(r.RedirectTo.Word)
+		case len(r.Words) == 0:
+			return ""
+	}
+	return laroussefr.NormalizeLemma(r.Words[0].Header.Text)
+}
+
+// Fingerprint returns a hash of r's content, which can be stored and compared
+// with a later scrape of the same page to detect whether its entry changed.
+// 
+// Only the text of each Word is hashed. PageID, word codes, audio URLs and
+// SeeAlso are left out, since they can change without the entry itself
+// changing.
+func (r Result) Fingerprint() string {
+	var strs []string
+	for _, w := range r.Words {
+		h := w.Header
+		strs = append(strs, "Word", h.Text, h.TextAlt, h.Phonetic, h.Type)
+		for _, sub := range w.Subheaders {
+			strs = append(strs, "Subheader", sub.Title)
+			for _, item := range sub.Items {
+				strs = append(strs, "Item", item.RedBrac, item.RedCaps, item.RedMeta)
+				for _, m := range item.Meanings {
+					strs = append(strs, "Meaning", m.Text, m.RedBrac, m.RedCaps, m.RedMeta, m.CrossRef)
+				}
+				for _, p := range item.Phrases {
+					strs = p.appendFingerprint(strs)
+				}
+			}
+		}
+	}
+	return laroussefr.HashStrings(strs)
+}
+
+// DiffItems returns r's content for laroussefr.DiffResults: the header of each
+// Word and each of its Meanings and Phrases, prefixed by the Word's Text and
+// its Subheader's Title, without audio URLs.
+func (r Result) DiffItems() []laroussefr.DiffItem {
+	var out []laroussefr.DiffItem
+	for _, w := range r.Words {
+		h := w.Header
+		out = append(out, laroussefr.NewDiffItem("Words", h.Text, h.TextAlt, h.Phonetic, h.Type))
+		for _, sub := range w.Subheaders {
+			for _, item := range sub.Items {
+				if item.context() != "" {
+					strs := []string{h.Text, sub.Title, item.RedBrac, item.RedCaps, item.RedMeta}
+					out = append(out, laroussefr.NewDiffItem("Items", strs...))
+				}
+				for _, m := range item.Meanings {
+					strs := []string{h.Text, sub.Title, m.RedBrac, m.RedCaps, m.RedMeta, m.Text, m.CrossRef}
+					out = append(out, laroussefr.NewDiffItem("Meanings", strs...))
+				}
+				for _, p := range item.Phrases {
+					out = p.appendDiffItems(out, h.Text, sub.Title)
+				}
+			}
+		}
+	}
+	return out
+}
+
+// FilterByType returns the Words in r whose Header.Type matches typ, e.g.
+// "nom" or "adjectif". The match is case-insensitive and ignores any gender or
+// number that follows, so "nom" matches "nom masculin" and "nom féminin
+// pluriel", while "nom masculin" only matches the former.
+func (r Result) FilterByType(typ string) []Word {
+	var out []Word
+	for _, w := range r.Words {
+		if typeMatches(w.Header.Type, typ) {
+			out = append(out, w)
+		}
+	}
+	return out
+}
+
+// PrimaryTranslations returns a map of each Word's Header.Text to the Text of
+// its primary Meaning (see Word.PrimaryMeaning). Words without a translation
+// are left out. If several Words share the same Text, as on the fr->en "court"
+// page, the first one is used.
+func (r Result) PrimaryTranslations() map[string]string {
+	out := make(map[string]string)
+	for _, w := range r.Words {
+		if _, ok := out[w.Header.Text]; ok {
+			continue
+		}
+		m, ok := w.PrimaryMeaning()
+		if ok {
+			out[w.Header.Text] = m.Text
+		}
+	}
+	return out
+}
+
+// Type SentencePair is an example phrase and its translation, as returned by
+// Result.SentencePairs. Src is a Phrase's Text1 and Tgt is its Text2.
+type SentencePair struct {
+	Src string
+	Tgt string
+}
+
+// SentencePairs returns the Text1 and Text2 of every Phrase in r, including
+// expressions and Subphrases, in document order, e.g. for building a parallel
+// corpus. Runs of whitespace are collapsed into single spaces and trimmed, and
+// pairs where either side is empty are skipped.
+func (r Result) SentencePairs() []SentencePair {
+	var out []SentencePair
+	for _, w := range r.Words {
+		for _, sub := range w.Subheaders {
+			for _, item := range sub.Items {
+				for _, p := range item.Phrases {
+					out = p.appendSentencePairs(out)
+				}
+			}
+		}
+	}
+	return out
+}
+
+// typeMatches returns true if a Header's Type typ begins with the words in
+// want.
+func typeMatches(typ, want string) bool {
+	typFields := strings.Fields(strings.ToLower(typ))
+	wantFields := strings.Fields(strings.ToLower(want))
+	if len(wantFields) == 0 || len(wantFields) > len(typFields) {
+		return false
+	}
+	for i := range wantFields {
+		if typFields[i] != wantFields[i] {
+			return false
+		}
+	}
+	return true
+}
+
 // Type Word represents a word, which consists of a code, a header, and
 // subheaders.
 // 
@@ -149,10 +519,14 @@ func (r Result) equalSeeAlsoIDs(q Result) (string, bool) {
 // The first word on a page will always have a code which is equivalent to the
 // page's ID, but subsequent words may have the same or different codes.
 // Larousse tends to be inconsistent in this regard.
+// 
+// RawHTML is the HTML of the word's "ZoneEntree" and "ZoneTexte" nodes if
+// IncludeRawHTML is true. Otherwise, it's empty.
 type Word struct {
 	Code       int
 	Header     Header
 	Subheaders []Subheader
+	RawHTML    string
 }
 
 // equals compares w and u. If they're equal, an empty string and true are
@@ -189,6 +563,77 @@ func (w Word) equalSubheaders(u Word) (string, bool) {
 	return "", true
 }
 
+// Permalink returns a link to w on the page at pageURL, which w was scraped
+// from, using w's Code as the fragment, e.g.
+// "https://www.larousse.fr/dictionnaires/francais-anglais/coup/19682#975605".
+// For the page's first word, whose Code is the page's ID, pageURL itself is
+// returned. Items and Meanings have no ID on Larousse, so a link to one of
+// them is a link to its Word.
+func (w Word) Permalink(pageURL string) string {
+	return laroussefr.Permalink(pageURL, w.Code)
+}
+
+// HasAudio returns true if w's Header or any of its Phrases has an audio clip.
+func (w Word) HasAudio() bool {
+	if w.Header.Audio != "" {
+		return true
+	}
+	for _, sub := range w.Subheaders {
+		for _, item := range sub.Items {
+			for _, p := range item.Phrases {
+				if p.hasAudio() {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// Type Pair is a flattened translation of a word, as returned by Word.Pairs.
+// 
+// Source is the word's Header.Text, Target is a Meaning's Text, and Context
+// is the red contexts of the Meaning's Item, followed by those of the Meaning,
+// joined by spaces.
+type Pair struct {
+	Source  string
+	Target  string
+	Context string
+}
+
+// Pairs returns every Meaning of w with a non-empty Text as a Pair, in
+// document order, ignoring the Subheader and Item hierarchy.
+func (w Word) Pairs() []Pair {
+	var out []Pair
+	for _, sub := range w.Subheaders {
+		for _, item := range sub.Items {
+			for _, m := range item.Meanings {
+				if m.Text == "" {
+					continue
+				}
+				red := joinContexts(item.context(), m.context())
+				out = append(out, Pair{w.Header.Text, m.Text, red})
+			}
+		}
+	}
+	return out
+}
+
+// PrimaryMeaning returns the first Meaning of w with a non-empty Text, in
+// document order, and true. If w has no such Meaning, false is returned.
+func (w Word) PrimaryMeaning() (Meaning, bool) {
+	for _, sub := range w.Subheaders {
+		for _, item := range sub.Items {
+			for _, m := range item.Meanings {
+				if m.Text != "" {
+					return m, true
+				}
+			}
+		}
+	}
+	return Meaning{}, false
+}
+
 // Type Header represents the header block of a word where its information is
 // displayed.
 // 
@@ -202,13 +647,42 @@ func (w Word) equalSubheaders(u Word) (string, bool) {
 //
 // Audio is the URL of the audio clip, if available.
 // 
+// AudioURLs holds the URL of every audio clip in the header, starting with
+// Audio's, for headwords with more than one pronunciation, e.g. a regional
+// variant.
+// 
+// AudioClips holds the same clips as AudioURLs, each with the region label
+// Larousse shows after it, if any, e.g. "Québec", so that users can pick an
+// accent.
+// 
 // Type is the word's grammatical type.
+// 
+// InfinitiveURL is the URL of the verb's conjugation page, if the header links
+// to one.
+// 
+// ConjugationHint is the note which some verbs have after the link to their
+// conjugation page, without its parentheses, e.g. "auxiliaire être" or
+// "irrégulier". It isn't part of Type.
+// 
+// Auxiliaries holds the auxiliary verbs named in ConjugationHint, in order,
+// e.g. ["être"], or ["avoir", "être"] for a verb which takes either. It's nil
+// if the hint doesn't name any.
+// 
+// Origine is the headword's origin or etymology, which a few bilingual pages
+// show in the header, without its parentheses, e.g. "mot inuit". It's empty if
+// the page doesn't show one.
 type Header struct {
-	Text     string
-	TextAlt  string
-	Phonetic string
-	Audio    string
-	Type     string
+	Text            string
+	TextAlt         string
+	Phonetic        string
+	Audio           string
+	Type            string
+	InfinitiveURL   string
+	AudioURLs       []string
+	ConjugationHint string
+	Auxiliaries     []string
+	Origine         string
+	AudioClips      []laroussefr.AudioClip
 }
 
 // equals compares h and i. If they're equal, an empty string and true are
@@ -226,10 +700,86 @@ func (h Header) equals(i Header) (string, bool) {
 			return fmt.Sprintf("Audio\nh: \"%s\"\ni: \"%s\"", h.Audio, i.Audio), false
 		case h.Type != i.Type:
 			return fmt.Sprintf("Type\nh: \"%s\"\ni: \"%s\"", h.Type, i.Type), false
+		case h.InfinitiveURL != i.InfinitiveURL:
+			return fmt.Sprintf("InfinitiveURL\nh: \"%s\"\ni: \"%s\"", h.InfinitiveURL, i.InfinitiveURL), false
+		case h.ConjugationHint != i.ConjugationHint:
+			return fmt.Sprintf("ConjugationHint\nh: \"%s\"\ni: \"%s\"", h.ConjugationHint, i.ConjugationHint), false
+		case h.Origine != i.Origine:
+			return fmt.Sprintf("Origine\nh: \"%s\"\ni: \"%s\"", h.Origine, i.Origine), false
 	}
 	return "", true
 }
 
+// auxiliaries returns the auxiliary verbs named in a ConjugationHint, in
+// order.
+func auxiliaries(hint string) []string {
+	var out []string
+	for _, f := range strings.FieldsFunc(hint, func(r rune) bool { return !unicode.IsLetter(r) }) {
+		f = strings.ToLower(f)
+		if f == "avoir" || f == "être" {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// IsVerb returns true if h's Type is a verb, e.g. "verbe transitif" or
+// "transitive verb".
+func (h Header) IsVerb() bool {
+	return laroussefr.IsVerbType(h.Type)
+}
+
+// FormPhonetics returns the pronunciations of h's Text and TextAlt, which
+// Phonetic lists together, e.g. "[vεr]" and "[vεrt]" for "vert" and
+// "(f verte)". If Phonetic has a single pronunciation, both are pronounced the
+// same. If TextAlt has several forms, such as the past tenses of an English
+// verb, textAlt holds all of their pronunciations, e.g. "[dræŋk, drʌŋk]".
+// textAlt is empty if TextAlt is.
+func (h Header) FormPhonetics() (text, textAlt string) {
+	parts := laroussefr.SplitPhonetic(h.Phonetic)
+	switch {
+		case len(parts) == 0:
+			return "", ""
+		case h.TextAlt == "":
+			return parts[0], ""
+		case len(parts) == 1:
+			return parts[0], parts[0]
+	}
+	var alts []string
+	for _, p := range parts[1:] {
+		alts = append(alts, strings.Trim(p, "[]"))
+	}
+	return parts[0], "[" + strings.Join(alts, ", ") + "]"
+}
+
+// IPA returns h's Phonetic without its surrounding square brackets and with
+// its whitespace normalized, e.g. "[εr]" becomes "εr". Phonetic itself is left
+// unchanged.
+func (h Header) IPA() string {
+	return h.ipa(true)
+}
+
+// IPAWithoutStress is like IPA, but also removes the primary and secondary
+// stress marks ("ˈ" and "ˌ") used in English phonetics, e.g. "[ˈmɒtɪveɪt]"
+// becomes "mɒtɪveɪt".
+func (h Header) IPAWithoutStress() string {
+	return h.ipa(false)
+}
+
+// ipa returns h's Phonetic without brackets, keeping its stress marks if
+// keepStress is true.
+func (h Header) ipa(keepStress bool) string {
+	removeThese := []string{"[", "]"}
+	if !keepStress {
+		removeThese = append(removeThese, "ˈ", "ˌ")
+	}
+	str := h.Phonetic
+	for _, r := range removeThese {
+		str = strings.ReplaceAll(str, r, "")
+	}
+	return strings.Join(strings.Fields(str), " ")
+}
+
 // Type Subheader represents a subheader. Most words in the French-English
 // dictionary have a single Subheader with an empty Title.
 type Subheader struct {
@@ -268,15 +818,29 @@ func (s Subheader) equalItems(t Subheader) (string, bool) {
 }
 
 // Type Item represents an item within a subheader.
+// 
+// RedBrac, RedCaps, RedMeta and Register are the item's own red contexts,
+// which apply to all of its Meanings, e.g. a domain label shown once above
+// several numbered senses. They're set instead of being attached to the first
+// Meaning, and are empty for most items.
 type Item struct {
 	Meanings []Meaning
 	Phrases  []Phrase
+	RedBrac  string   // Indicateur
+	RedCaps  string   // IndicateurDomaine
+	RedMeta  string   // Metalangue
+	Register Register // Metalangue
 }
 
 // equals compares i and t. If they're equal, an empty string and true are
 // returned. Otherwise, a message describing the inequality and false are
 // returned.
 func (i Item) equals(t Item) (string, bool) {
+	switch {
+		case i.RedBrac != t.RedBrac: return fmt.Sprintf("RedBrac\ni: \"%s\"\nt: \"%s\"", i.RedBrac, t.RedBrac), false
+		case i.RedCaps != t.RedCaps: return fmt.Sprintf("RedCaps\ni: \"%s\"\nt: \"%s\"", i.RedCaps, t.RedCaps), false
+		case i.RedMeta != t.RedMeta: return fmt.Sprintf("RedMeta\ni: \"%s\"\nt: \"%s\"", i.RedMeta, t.RedMeta), false
+	}
 	message, ok := i.equalLens(t)
 	if !ok {
 		return message, false
@@ -342,11 +906,38 @@ func (i Item) equalPhrases(t Item) (string, bool) {
 // RedMeta is the meaning's "meta" context, displayed in red parentheses. This
 // is usually used to indicate whether a term is formal or informal, or if it's
 // from a region-specific dialect.
+// 
+// Register is RedMeta parsed by ParseRegister.
+// 
+// Alternatives holds the distinct translations in Text if Larousse separates
+// them with "ou", e.g. ["x", "y"] for "x ou y". Otherwise, it's nil.
+// 
+// CrossRef is the word referred to by an arrow ("→") instead of a translation,
+// e.g. "coup de téléphone" for "coup de fil" on the fr->en "coup" page. Such
+// meanings have an empty Text.
+// 
+// CrossRefURL is the absolute URL of CrossRef's page if Larousse links to it,
+// e.g. on the page for "clef". Otherwise, it's empty.
+// 
+// TargetGenre holds the gender and number markers of the translations in Text,
+// in order, e.g. ["f"] for "boisson f" or ["m", "m"] for "bleu m, azur m".
+// The markers aren't included in Text or Alternatives.
+// 
+// Depth is the meaning's nesting level in the item's semantic divisions
+// ("division-semantique"), which Larousse shows as sub-numbered senses. The
+// first meaning of an item has a Depth of 0, its sub-senses have a Depth of 1,
+// their own sub-senses have a Depth of 2, and so on.
 type Meaning struct {
-	Text    string // Traduction
-	RedBrac string // Indicateur
-	RedCaps string // IndicateurDomaine
-	RedMeta string // Metalangue
+	Text         string   // Traduction
+	RedBrac      string   // Indicateur
+	RedCaps      string   // IndicateurDomaine
+	RedMeta      string   // Metalangue
+	Register     Register // Metalangue
+	Alternatives []string // Traduction split at oubien
+	CrossRef     string   // Renvois
+	CrossRefURL  string   // Renvois
+	TargetGenre  []string // Genre
+	Depth        int      // division-semantique
 }
 
 // equals compares m and n. If they're equal, an empty string and true are
@@ -362,26 +953,66 @@ func (m Meaning) equals(n Meaning) (string, bool) {
 			return fmt.Sprintf("RedCaps\nm: \"%s\"\nn: \"%s\"", m.RedCaps, n.RedCaps), false
 		case m.RedMeta != n.RedMeta:
 			return fmt.Sprintf("RedMeta\nm: \"%s\"\nn: \"%s\"", m.RedMeta, n.RedMeta),  false
+		case m.CrossRef != n.CrossRef:
+			return fmt.Sprintf("CrossRef\nm: \"%s\"\nn: \"%s\"", m.CrossRef, n.CrossRef), false
+		case strings.Join(m.TargetGenre, ",") != strings.Join(n.TargetGenre, ","):
+			return fmt.Sprintf("TargetGenre\nm: %v\nn: %v", m.TargetGenre, n.TargetGenre), false
 	}
 	return "", true
 }
 
 // isEmpty returns true if m consists entirely of empty strings.
 func (m Meaning) isEmpty() bool {
-	return m.Text=="" && m.RedBrac=="" && m.RedCaps=="" && m.RedMeta==""
+	return m.Text=="" && m.RedBrac=="" && m.RedCaps=="" && m.RedMeta=="" && m.CrossRef==""
 }
 
-// update takes a node containing a Meaning property and applies it to m.
-func (m *Meaning) update(n *html.Node) {
+// context returns m's non-empty red contexts joined by spaces.
+func (m Meaning) context() string {
+	return joinContexts(m.RedBrac, m.RedCaps, m.RedMeta)
+}
+
+// hasContext returns true if any of m's red contexts is non-empty.
+func (m Meaning) hasContext() bool {
+	return m.RedBrac != "" || m.RedCaps != "" || m.RedMeta != ""
+}
+
+// context returns i's non-empty red contexts joined by spaces.
+func (i Item) context() string {
+	return joinContexts(i.RedBrac, i.RedCaps, i.RedMeta)
+}
+
+// joinContexts returns the non-empty strings in contexts joined by spaces.
+func joinContexts(contexts ...string) string {
+	var out []string
+	for _, str := range contexts {
+		if str != "" {
+			out = append(out, str)
+		}
+	}
+	return strings.Join(out, " ")
+}
+
+// update takes a node containing a Meaning property and applies it to m. An
+// error is only returned for an unknown class, as described by
+// StrictUnknownClasses.
+func (m *Meaning) update(n *html.Node) error {
 	class := scrape.Attr(n, "class")
 	switch class {
-		case "Renvois":           m.Text = scrape.Text(n) // for "coup de fil" on fr->en coup
+		case "Renvois": // for "coup de fil" on fr->en coup
+			m.CrossRef    = scrape.Text(n)
+			m.CrossRefURL = renvoiURL(n)
 		case "Glose2":            m.Text = scrape.Text(n) // for en->fr "blue" POLITICS
 		case "Traduction":        m.updateFromTraductionNode(n)
 		case "Indicateur":        m.RedBrac = scrape.Text(n)
 		case "IndicateurDomaine": m.RedCaps = strings.ToUpper(scrape.Text(n))
-		case "Metalangue":        m.RedMeta = scrape.Text(n)
+		case "Metalangue":
+			m.RedMeta = scrape.Text(n)
+			m.Register = ParseRegister(m.RedMeta)
+		case "", "lienson2", "Indicateur2", "RegionSon":
+		default:
+			return unknownClass("Meaning", class, n)
 	}
+	return nil
 }
 
 // updateFromTraductionNode takes a "Traduction" node and applies it to m.
@@ -389,7 +1020,21 @@ func (m *Meaning) updateFromTraductionNode(n *html.Node) {
 		if m.Text != "" {
 			m.Text += " "
 		}
-		m.Text += parse.Traduction(n)
+		text, genres := parse.TraductionGenre(n)
+		m.Text += text
+		m.TargetGenre = append(m.TargetGenre, genres...)
+		m.Alternatives = appendAlternatives(m.Alternatives, n, false)
+}
+
+// appendAlternatives appends the alternative translations within a
+// "Traduction" node to alts, if there are any. keepGenre is passed on to
+// parse.TraductionAlternatives.
+func appendAlternatives(alts []string, n *html.Node, keepGenre bool) []string {
+	a := parse.TraductionAlternatives(n, keepGenre)
+	if len(a) > 1 {
+		alts = append(alts, a...)
+	}
+	return alts
 }
 
 // Type Phrase represents an example phrase.
@@ -400,6 +1045,10 @@ func (m *Meaning) updateFromTraductionNode(n *html.Node) {
 // Audio1 and Audio2 are the URLs of the TTS audio clips corresponding to
 // Text1 and Text2.
 // 
+// Phonetic1 and Phonetic2 are the phonetic transcriptions of Text1 and Text2,
+// where Larousse provides them, which is rare. A "Phonetique" node is assigned
+// to Phonetic1 if it precedes the translation, and to Phonetic2 otherwise.
+// 
 // RedBrac is the phrase's context, displayed in red square brackets.
 // 
 // RedCaps is the phrase's "domain" context, displayed in red all caps. This is
@@ -409,22 +1058,33 @@ func (m *Meaning) updateFromTraductionNode(n *html.Node) {
 // usually used to indicate whether a term is formal or informal, or if it's
 // from a region-specific dialect.
 // 
+// Register is RedMeta parsed by ParseRegister.
+// 
 // IsBlue is true if the phrase is an expression. An expression is merely a
 // phrase shown in a blue box with "EXPR" in the corner. If an expression has
 // subphrases, their IsBlue values are true as well.
 // 
 // Subphrases is a slice of subphrases, which appear in an alphabet-bullet list.
 // Each subphrase's Subphrases slice is nil.
+// 
+// Alternatives holds the distinct translations in Text2 if Larousse separates
+// them with "ou". Otherwise, it's nil.
 type Phrase struct {
-	Text1      string   // Locution2
-	Text2      string   // Traduction2, Metalangue2
-	Audio1     string   // lienson3
-	Audio2     string   // lienson2
-	RedBrac    string   // Indicateur
-	RedCaps    string   // IndicateurDomaine
-	RedMeta    string   // Metalangue
-	IsBlue     bool     // true if inside BlocExpression
-	Subphrases []Phrase // DivisionExpression
+	Text1        string   // Locution2
+	Text2        string   // Traduction2, Metalangue2
+	Audio1       string   // lienson3
+	Audio2       string   // lienson2
+	Phonetic1    string   // Phonetique
+	Phonetic2    string   // Phonetique
+	RedBrac      string   // Indicateur
+	RedCaps      string   // IndicateurDomaine
+	RedMeta      string   // Metalangue
+	Register     Register // Metalangue
+	IsBlue       bool     // true if inside BlocExpression
+	Subphrases   []Phrase // DivisionExpression
+	Alternatives []string // Traduction2 split at oubien
+	AudioClip1   laroussefr.AudioClip // lienson3, RegionSon
+	AudioClip2   laroussefr.AudioClip // lienson2, RegionSon
 }
 
 // equals compares p and q. If they're equal, an empty string and true are
@@ -448,14 +1108,16 @@ func (p Phrase) equals(q Phrase) (string, bool) {
 // equalStringFields returns true if the string fields of p and q are identical.
 func (p Phrase) equalStringFields(q Phrase) (string, bool) {
 	switch {
-		case p.Text1 != q.Text1:     return fmt.Sprintf("Text1\np: \"%s\"\nq: \"%s\"", p.Text1, q.Text1), false
-		case p.Text2 != q.Text2:     return fmt.Sprintf("Text2\np: \"%s\"\nq: \"%s\"", p.Text2, q.Text2), false
-		case p.Audio1 != q.Audio1:   return fmt.Sprintf("Audio1\np: \"%s\"\nq: \"%s\"", p.Audio1, q.Audio1), false
-		case p.Audio2 != q.Audio2:   return fmt.Sprintf("Audio2\np: \"%s\"\nq: \"%s\"", p.Audio2, q.Audio2), false
-		case p.RedBrac != q.RedBrac: return fmt.Sprintf("Text1\np: \"%s\"\nq: \"%s\"", p.RedBrac, q.RedBrac), false
-		case p.RedCaps != q.RedCaps: return fmt.Sprintf("RedCaps\np: \"%s\"\nq: \"%s\"", p.RedCaps, q.RedCaps), false
-		case p.RedMeta != q.RedMeta: return fmt.Sprintf("RedMeta\np: \"%s\"\nq: \"%s\"", p.RedMeta, q.RedMeta), false
-		case p.IsBlue != q.IsBlue:   return fmt.Sprintf("IsBlue\np: %v\nq: %v", p.IsBlue, q.IsBlue), false
+		case p.Text1 != q.Text1:         return fmt.Sprintf("Text1\np: \"%s\"\nq: \"%s\"", p.Text1, q.Text1), false
+		case p.Text2 != q.Text2:         return fmt.Sprintf("Text2\np: \"%s\"\nq: \"%s\"", p.Text2, q.Text2), false
+		case p.Audio1 != q.Audio1:       return fmt.Sprintf("Audio1\np: \"%s\"\nq: \"%s\"", p.Audio1, q.Audio1), false
+		case p.Audio2 != q.Audio2:       return fmt.Sprintf("Audio2\np: \"%s\"\nq: \"%s\"", p.Audio2, q.Audio2), false
+		case p.Phonetic1 != q.Phonetic1: return fmt.Sprintf("Phonetic1\np: \"%s\"\nq: \"%s\"", p.Phonetic1, q.Phonetic1), false
+		case p.Phonetic2 != q.Phonetic2: return fmt.Sprintf("Phonetic2\np: \"%s\"\nq: \"%s\"", p.Phonetic2, q.Phonetic2), false
+		case p.RedBrac != q.RedBrac:     return fmt.Sprintf("Text1\np: \"%s\"\nq: \"%s\"", p.RedBrac, q.RedBrac), false
+		case p.RedCaps != q.RedCaps:     return fmt.Sprintf("RedCaps\np: \"%s\"\nq: \"%s\"", p.RedCaps, q.RedCaps), false
+		case p.RedMeta != q.RedMeta:     return fmt.Sprintf("RedMeta\np: \"%s\"\nq: \"%s\"", p.RedMeta, q.RedMeta), false
+		case p.IsBlue != q.IsBlue:       return fmt.Sprintf("IsBlue\np: %v\nq: %v", p.IsBlue, q.IsBlue), false
 	}
 	return "", true
 }
@@ -473,38 +1135,140 @@ func (p Phrase) equalSubphrases(q Phrase) (string, bool) {
 	return "", true
 }
 
-// update takes a node containing a Phrase property and applies it to p.
-func (p *Phrase) update(n *html.Node) {
+// hasAudio returns true if p or any of its Subphrases has an audio clip.
+func (p Phrase) hasAudio() bool {
+	if p.Audio1 != "" || p.Audio2 != "" {
+		return true
+	}
+	for _, sub := range p.Subphrases {
+		if sub.hasAudio() {
+			return true
+		}
+	}
+	return false
+}
+
+// appendAudioURLs appends the audio URLs of p and its Subphrases to urls,
+// which may include empty strings.
+func (p Phrase) appendAudioURLs(urls []string) []string {
+	urls = append(urls, p.Audio1, p.Audio2)
+	for _, sub := range p.Subphrases {
+		urls = sub.appendAudioURLs(urls)
+	}
+	return urls
+}
+
+// appendFingerprint appends the strings which make up p's and its Subphrases'
+// part of a Result's Fingerprint to strs.
+func (p Phrase) appendFingerprint(strs []string) []string {
+	strs = append(strs, "Phrase", p.Text1, p.Text2, p.RedBrac, p.RedCaps, p.RedMeta, fmt.Sprint(p.IsBlue))
+	for _, sub := range p.Subphrases {
+		strs = sub.appendFingerprint(strs)
+	}
+	return strs
+}
+
+// appendSentencePairs appends p and its Subphrases to pairs, as in
+// Result.SentencePairs.
+func (p Phrase) appendSentencePairs(pairs []SentencePair) []SentencePair {
+	src := strings.Join(strings.Fields(p.Text1), " ")
+	tgt := strings.Join(strings.Fields(p.Text2), " ")
+	if src != "" && tgt != "" {
+		pairs = append(pairs, SentencePair{src, tgt})
+	}
+	for _, sub := range p.Subphrases {
+		pairs = sub.appendSentencePairs(pairs)
+	}
+	return pairs
+}
+
+// appendDiffItems appends p and its Subphrases to items, as in
+// Result.DiffItems.
+func (p Phrase) appendDiffItems(items []laroussefr.DiffItem, prefix ...string) []laroussefr.DiffItem {
+	strs := append(append([]string(nil), prefix...), p.Text1, p.RedBrac, p.RedCaps, p.RedMeta, p.Text2)
+	items = append(items, laroussefr.NewDiffItem("Phrases", strs...))
+	for _, sub := range p.Subphrases {
+		items = sub.appendDiffItems(items, prefix...)
+	}
+	return items
+}
+
+// update takes a node containing a Phrase property and applies it to p. An
+// error is only returned for an unknown class, as described by
+// StrictUnknownClasses.
+func (p *Phrase) update(n *html.Node) error {
 	class := scrape.Attr(n, "class")
 	switch class {
 		case "Locution2":
-			p.Text1   = scrape.Text(n)
-			audio1, ok := handleLocution2InnerLienson3(n)
+			p.Text1   = laroussefr.Text(n)
+			clip1, ok := handleLocution2InnerLienson3(n)
 			if ok {
-				p.Audio1 = audio1
+				p.Audio1, p.AudioClip1 = clip1.URL, clip1
 			}
 		case "Glose2":            fallthrough
 		case "Traduction2":       fallthrough
 		case "Renvois":           fallthrough
 		case "Metalangue2":
-			if p.Text2 != "" {
-				p.Text2 += " "
+			if p.IsBlue {
+				p.appendText2(parse.Traduction(n))
+			} else {
+				if p.Text2 != "" {
+					p.Text2 += " "
+				}
+				p.Text2 += parse.Traduction(n)
+			}
+			p.Alternatives = appendAlternatives(p.Alternatives, n, true)
+		case "Phonetique":
+			if p.Text2 == "" {
+				p.Phonetic1 = scrape.Text(n)
+			} else {
+				p.Phonetic2 = scrape.Text(n)
 			}
-			p.Text2 += parse.Traduction(n)
-		case "lienson3":          p.Audio1  = parse.Lienson(n)
-		case "lienson2":          p.Audio2  = parse.Lienson(n)
+		case "lienson3":
+			p.AudioClip1 = parse.LiensonClip(n)
+			p.Audio1     = p.AudioClip1.URL
+		case "lienson2":
+			p.AudioClip2 = parse.LiensonClip(n)
+			p.Audio2     = p.AudioClip2.URL
+		case "RegionSon": // scraped with its lienson node
 		case "Indicateur":        p.RedBrac = scrape.Text(n)
 		case "IndicateurDomaine": p.RedCaps = strings.ToUpper(scrape.Text(n))
-		case "Metalangue":        p.RedMeta = scrape.Text(n)
+		case "Metalangue":
+			p.RedMeta  = scrape.Text(n)
+			p.Register = ParseRegister(p.RedMeta)
+		case "DivisionExpression":
+		case "ZoneExpression2": // scraped separately by scrapeExpressions
+		case "":
+			// Larousse sometimes leaves part of an expression's long
+			// translation, e.g. the ", " between two of them or an
+			// explanation in italics, outside of its "Traduction2" nodes.
+			if p.IsBlue && p.Text2 != "" {
+				text := laroussefr.Text(n)
+				if n.Type == html.TextNode {
+					text = n.Data
+				}
+				p.appendText2(text)
+			} else if n.Type == html.TextNode && !isWhitespace(n.Data) {
+				diagnose("Phrase: skipped text %q", n.Data)
+			}
+		default:
+			return unknownClass("Phrase", class, n)
 	}
+	return nil
 }
 
-// setBlue sets the IsBlue value for p and all of its Subphrases.
-func (p *Phrase) setBlue(blue bool) {
-	p.IsBlue = blue
-	for i := range p.Subphrases {
-		p.Subphrases[i].IsBlue = blue
+// appendText2 appends text, a part of the translation of p, an expression, to
+// p.Text2, separated by a space unless text begins with punctuation such as a
+// comma.
+func (p *Phrase) appendText2(text string) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+	if p.Text2 != "" && !strings.ContainsAny(text[:1], ",;.)") {
+		p.Text2 += " "
 	}
+	p.Text2 += text
 }
 
 // handleLocution2nInnerLienson3 is used to find "lienson3" nodes buried within
@@ -517,12 +1281,12 @@ func (p *Phrase) setBlue(blue bool) {
 //    (https://www.larousse.fr/dictionnaires/francais-anglais/couper/19720)
 // 3. "il a essayé de me faire le coup de la panne"
 //    (https://www.larousse.fr/dictionnaires/francais-anglais/coup/19682)
-func handleLocution2InnerLienson3(locution2Node *html.Node) (string, bool) {
+func handleLocution2InnerLienson3(locution2Node *html.Node) (laroussefr.AudioClip, bool) {
 	lienson3, ok := scrape.Find(locution2Node, scrape.ByClass("lienson3"))
 	if ok {
-		return parse.Lienson(lienson3), true
+		return parse.LiensonClip(lienson3), true
 	}
-	return "", false
+	return laroussefr.AudioClip{}, false
 }
 
 
@@ -535,25 +1299,180 @@ func handleLocution2InnerLienson3(locution2Node *html.Node) (string, bool) {
 // provides search suggestions for this nonexistent word, they will be put into
 // the returned Result's SeeAlso slice.
 func New(word string, from, to Language) (Result, error) {
+	return NewContext(context.Background(), word, from, to)
+}
+
+// NewContext is like New, but the download is cancelled when ctx is done.
+func NewContext(ctx context.Context, word string, from, to Language) (Result, error) {
+	url, err := wordURL(word, from, to)
+	if err != nil {
+		return Result{}, laroussefr.WrapError("New", word, "", err)
+	}
+	return NewFromFileOrURLContext(ctx, url)
+}
+
+// wordURL returns the URL of word's page in the from-to dictionary.
+func wordURL(word string, from, to Language) (string, error) {
 	err := checkNewArgs(word, from, to)
 	if err != nil {
-		return Result{}, laroussefr.NewError("New", word, err.Error())
+		return "", err
 	}
-	if strings.ContainsRune(word, ' ') {
-		word = strings.ReplaceAll(word, " ", "-")
+	return laroussefr.WordURL(fmt.Sprintf("%s-%s", from, to), word), nil
+}
+
+// NewHeaderOnly looks up word like New, but only scrapes the Header of the
+// page's first word, skipping the rest of the page. This is much cheaper when
+// only the word's type and pronunciation are needed.
+// 
+// If the word doesn't exist, an error ErrWordNotFound is returned.
+func NewHeaderOnly(word string, from, to Language) (Header, error) {
+	return NewHeaderOnlyContext(context.Background(), word, from, to)
+}
+
+// NewHeaderOnlyContext is like NewHeaderOnly, but the download is cancelled
+// when ctx is done.
+func NewHeaderOnlyContext(ctx context.Context, word string, from, to Language) (Header, error) {
+	url, err := wordURL(word, from, to)
+	if err != nil {
+		return Header{}, laroussefr.WrapError("NewHeaderOnly", word, "", err)
 	}
-	url := fmt.Sprintf("https://www.larousse.fr/dictionnaires/%s-%s/%s", from, to, word)
-	return NewFromFileOrURL(url)
+	return HeaderFromFileOrURLContext(ctx, url)
+}
+
+// HeaderFromFileOrURL is like NewFromFileOrURL, but only scrapes the Header of
+// the page's first word.
+func HeaderFromFileOrURL(in string) (Header, error) {
+	return HeaderFromFileOrURLContext(context.Background(), in)
+}
+
+// HeaderFromFileOrURLContext is like HeaderFromFileOrURL, but if in is a URL,
+// the download is cancelled when ctx is done.
+func HeaderFromFileOrURLContext(ctx context.Context, in string) (Header, error) {
+	doc, err := partialDoc(ctx, "HeaderFromFileOrURL", in)
+	if err != nil {
+		return Header{}, err
+	}
+	zoneEntreeNode, ok := scrape.Find(doc, scrape.ByClass("ZoneEntree"))
+	if !ok {
+		return Header{}, laroussefr.NewKindError("HeaderFromFileOrURL", in, "Can't find ZoneEntree", laroussefr.ErrParse)
+	}
+	header, err := scrapeHeader(zoneEntreeNode)
+	if err != nil {
+		return Header{}, laroussefr.NewKindError("HeaderFromFileOrURL", in, err.Error(), laroussefr.ErrParse)
+	}
+	return header, nil
+}
+
+// Headwords scrapes the text of each word's header on an English-French or
+// French-English page, given as either an HTML filepath or a URL, without
+// scraping the rest of the page. Each headword is listed once, in the order
+// it first appears, e.g. ["court", "tout court"]. This is a cheap way to find
+// out what a page covers before deciding whether to scrape it fully.
+// 
+// If the page is a "word not found" page, an error ErrWordNotFound is
+// returned.
+func Headwords(in string) ([]string, error) {
+	doc, err := partialDoc(context.Background(), "Headwords", in)
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	seen := map[string]bool{}
+	for _, zoneEntreeNode := range scrape.FindAll(doc, scrape.ByClass("ZoneEntree")) {
+		adresseNode, ok := scrape.Find(zoneEntreeNode, scrape.ByClass("Adresse"))
+		if !ok {
+			continue
+		}
+		text := laroussefr.CleanWordText(scrape.Text(adresseNode))
+		if !seen[text] {
+			seen[text] = true
+			out = append(out, text)
+		}
+	}
+	if len(out) == 0 {
+		return nil, laroussefr.NewKindError("Headwords", in, "Can't find ZoneEntree", laroussefr.ErrParse)
+	}
+	return out, nil
+}
+
+// partialDoc downloads or reads the page in for function, which scrapes only
+// part of it, and returns its root. An error ErrWordNotFound is returned for a
+// "word not found" page. The download is cancelled when ctx is done.
+func partialDoc(ctx context.Context, function, in string) (*html.Node, error) {
+	if !scrapeutil.FileExists(in) {
+		ok, message := isURL(in)
+		if !ok {
+			return nil, laroussefr.NewKindError(function, in, "Bad URL: " + message, laroussefr.ErrInvalidInput)
+		}
+		in = laroussefr.NormalizeURL(in)
+	}
+	
+	doc, _, err := scrapeutil.HTMLRootInfo(ctx, in)
+	if err != nil {
+		return nil, laroussefr.WrapError(function, in, "Download step: ", err)
+	}
+	
+	if laroussefr.IsWordNotFoundPage(doc) {
+		return nil, laroussefr.NewKindError(function, in, "ErrWordNotFound", laroussefr.ErrWordNotFound)
+	}
+	return doc, nil
+}
+
+// NewAuto is like New, but for a word whose language isn't known. It searches
+// the French-English dictionary, then the English-French dictionary, and
+// returns the Result found along with the language it was found in.
+// 
+// If the word exists in both (e.g. "table"), the Result whose first Word's
+// Text matches word most closely is returned, preferring French if they match
+// equally well. An error ErrWordNotFound is returned only if neither
+// dictionary has the word, in which case the Result holds the French
+// dictionary's search suggestions, if any.
+func NewAuto(word string) (Result, Language, error) {
+	frRes, frErr := New(word, Fr, En)
+	frNotFound := errors.Is(frErr, laroussefr.ErrWordNotFound)
+	enRes, enErr := New(word, En, Fr)
+	enNotFound := errors.Is(enErr, laroussefr.ErrWordNotFound)
+	
+	switch {
+		case frErr == nil && enErr == nil:
+			if matchScore(enRes, word) > matchScore(frRes, word) {
+				return enRes, En, nil
+			}
+			return frRes, Fr, nil
+		case frErr == nil:
+			return frRes, Fr, nil
+		case enErr == nil:
+			return enRes, En, nil
+		case frNotFound && enNotFound:
+			return frRes, Fr, laroussefr.NewKindError("NewAuto", word, "ErrWordNotFound", laroussefr.ErrWordNotFound)
+		case !frNotFound:
+			return Result{}, Fr, laroussefr.WrapError("NewAuto", word, "", frErr)
+	}
+	return Result{}, En, laroussefr.WrapError("NewAuto", word, "", enErr)
+}
+
+// matchScore returns how closely the first Word of r matches word: 2 for an
+// exact match, 1 for a case-insensitive match, and 0 otherwise.
+func matchScore(r Result, word string) int {
+	if len(r.Words) == 0 {
+		return 0
+	}
+	text := r.Words[0].Header.Text
+	switch {
+		case text == word:                 return 2
+		case strings.EqualFold(text, word): return 1
+	}
+	return 0
 }
 
 // checkNewArgs checks the arguments passed to New, returning a non-nil error if
 // they're invalid.
 func checkNewArgs(word string, from, to Language) error {
 	switch {
-		case word == "":          return laroussefr.NewError("checkNewArgs", word, "Empty string")
-		case from.String() == "": return laroussefr.NewError("checkNewArgs", word, "Unknown 'from' language")
-		case to.String() == "":   return laroussefr.NewError("checkNewArgs", word, "Unknown 'to' language")
-		case from == to:          return laroussefr.NewError("checkNewArgs", word, "Same 'from' and 'to' language: " + from.String())
+		case word == "":          return laroussefr.NewKindError("checkNewArgs", word, "Empty string", laroussefr.ErrInvalidInput)
+		case from.String() == "": return laroussefr.NewKindError("checkNewArgs", word, "Unknown 'from' language", laroussefr.ErrInvalidInput)
+		case to.String() == "":   return laroussefr.NewKindError("checkNewArgs", word, "Unknown 'to' language", laroussefr.ErrInvalidInput)
+		case from == to:          return laroussefr.NewKindError("checkNewArgs", word, "Same 'from' and 'to' language: " + from.String(), laroussefr.ErrInvalidInput)
 	}
 	return nil
 }
@@ -562,33 +1481,121 @@ func checkNewArgs(word string, from, to Language) error {
 // either an HTML filepath or a URL.
 // 
 // If the result is a "word not found" page, an error ErrWordNotFound is
-// returned. If the page provides search suggestions, they will be put into the
-// returned Result's SeeAlso slice.
+// returned along with an empty Result whose PageID is
+// laroussefr.NotFoundPageID. If the page provides search suggestions, they will
+// be put into the returned Result's SeeAlso slice.
 func NewFromFileOrURL(in string) (Result, error) {
+	return NewFromFileOrURLContext(context.Background(), in)
+}
+
+// NewFromFileOrURLContext is like NewFromFileOrURL, but if in is a URL, the
+// download is cancelled when ctx is done.
+func NewFromFileOrURLContext(ctx context.Context, in string) (Result, error) {
+	res, _, err := newWithDoc(ctx, in)
+	return res, err
+}
+
+// NewFromHTML is like NewFromFileOrURL, but scrapes a page of the from-to
+// dictionary given as its HTML, e.g. markup copied from a browser's developer
+// tools, without reading a file or downloading anything. An error is returned
+// if the page's canonical URL shows that it's from another dictionary.
+func NewFromHTML(s string, from, to Language) (Result, error) {
+	err := checkNewArgs("html", from, to)
+	if err != nil {
+		return Result{}, laroussefr.WrapError("NewFromHTML", "", "", err)
+	}
+	doc, err := scrapeutil.HTMLRootFromString(s)
+	if err != nil {
+		return Result{}, laroussefr.WrapError("NewFromHTML", "", "", err)
+	}
+	if laroussefr.IsWordNotFoundPage(doc) {
+		return ParseDocument(doc)
+	}
+	
+	dict := fmt.Sprintf("%s-%s", from, to)
+	canonical, ok := scrape.Find(doc, scrapeutil.IsCanonicalLink)
+	if ok && laroussefr.DictionaryName(scrape.Attr(canonical, "href")) != dict {
+		return Result{}, laroussefr.NewKindError("NewFromHTML", "", "Page isn't from " + dict + ": " + scrape.Attr(canonical, "href"), laroussefr.ErrInvalidInput)
+	}
+	
+	return ParseDocument(doc)
+}
+
+// ParseDocument scrapes an English-French or French-English page which has
+// already been downloaded and parsed, given as its root node, e.g. one returned
+// by scrapeutil.HTMLRoot. Nothing is read or downloaded, which makes it
+// suitable for benchmarking and testing the scrapers on their own. doc isn't
+// modified.
+// 
+// As with NewFromFileOrURL, an error ErrWordNotFound is returned for a "word
+// not found" page.
+func ParseDocument(doc *html.Node) (Result, error) {
+	if laroussefr.IsWordNotFoundPage(doc) {
+		return notFoundResult(doc), laroussefr.NewKindError("ParseDocument", "", "ErrWordNotFound", laroussefr.ErrWordNotFound)
+	}
+	res, err := newResultFromRoot(doc)
+	if err != nil {
+		return Result{}, laroussefr.NewKindError("ParseDocument", "", "Scrape step: " + err.Error(), laroussefr.ErrParse)
+	}
+	return res, nil
+}
+
+// NewWithDoc is like NewFromFileOrURL, but also returns the page's parsed root
+// node, so that data which Result doesn't model can be scraped from it without
+// downloading and parsing the page again. The node is nil if the page couldn't
+// be downloaded. Mutating it is unsupported.
+func NewWithDoc(in string) (Result, *html.Node, error) {
+	return newWithDoc(context.Background(), in)
+}
+
+// newWithDoc implements NewFromFileOrURLContext and NewWithDoc.
+func newWithDoc(ctx context.Context, in string) (Result, *html.Node, error) {
 	if !scrapeutil.FileExists(in) {
 		ok, message := isURL(in)
 		if !ok {
-			return Result{}, laroussefr.NewError("NewFromFileOrURL", in, "Bad URL: " + message)
+			return Result{}, nil, laroussefr.NewKindError("NewFromFileOrURL", in, "Bad URL: " + message, laroussefr.ErrInvalidInput)
 		}
+		in = laroussefr.NormalizeURL(in)
 	}
 	
-	doc, err := scrapeutil.HTMLRoot(in)
+	doc, info, err := scrapeutil.HTMLRootInfo(ctx, in)
 	if err != nil {
-		return Result{}, laroussefr.NewError("NewFromFileOrURL", in, "Download step: " + err.Error())
+		return Result{}, nil, laroussefr.WrapError("NewFromFileOrURL", in, "Download step: ", err)
 	}
 	
 	if laroussefr.IsWordNotFoundPage(doc) {
-		ErrWordNotFound = laroussefr.NewError("NewFromFileOrURL", in, "ErrWordNotFound")
-		seeAlso := laroussefr.GetSearchSuggestions(doc)
-		result := Result{-1, nil, seeAlso}
-		return result, ErrWordNotFound
+		res := notFoundResult(doc)
+		res.setPageInfo(info)
+		return res, doc, laroussefr.NewKindError("NewFromFileOrURL", in, "ErrWordNotFound", laroussefr.ErrWordNotFound)
 	}
 	
 	result, err := newResultFromRoot(doc)
+	result.setPageInfo(info)
+	for i := 0; err != nil && i < scrapeutil.RetriesFor(ctx, in); i++ {
+		result, doc, err = retryFromURL(ctx, in)
+	}
 	if err != nil {
-		return Result{}, laroussefr.NewError("NewFromFileOrURL", in, "Scrape step: " + err.Error())
+		return Result{}, doc, laroussefr.NewKindError("NewFromFileOrURL", in, "Scrape step: " + err.Error(), laroussefr.ErrParse)
 	}
-	return result, err
+	return result, doc, err
+}
+
+// retryFromURL downloads in again and scrapes it, after a previous attempt
+// failed on what may have been a truncated page.
+func retryFromURL(ctx context.Context, in string) (Result, *html.Node, error) {
+	doc, info, err := scrapeutil.HTMLRootInfo(ctx, in)
+	if err != nil {
+		return Result{}, nil, laroussefr.WrapError("retryFromURL", in, "", err)
+	}
+	res, err := newResultFromRoot(doc)
+	res.setPageInfo(info)
+	return res, doc, err
+}
+
+// setPageInfo sets r's FetchedAt and LastModified from info.
+func (r *Result) setPageInfo(info scrapeutil.PageInfo) {
+	r.FetchedAt = info.FetchedAt
+	r.LastModified = info.LastModified
 }
 
 // isURL verifies if str is a valid URL to a French-English or English-French
@@ -601,8 +1608,8 @@ func isURL(str string) (bool, string) {
 	}
 	
 	sl := [2]string{
-		"larousse.fr/dictionnaires/francais-anglais/",
-		"larousse.fr/dictionnaires/anglais-francais/",
+		"/dictionnaires/francais-anglais/",
+		"/dictionnaires/anglais-francais/",
 	}
 	for _, s := range sl {
 		if strings.Contains(str, s) && !strings.HasSuffix(str, s) {
@@ -612,6 +1619,15 @@ func isURL(str string) (bool, string) {
 	return false, fmt.Sprintf("Must contain \"%s\" or \"%s\"", sl[0], sl[1])
 }
 
+// notFoundResult returns the Result for a "word not found" page, which is empty
+// apart from its search suggestions.
+func notFoundResult(doc *html.Node) Result {
+	return Result{
+		PageID:  laroussefr.NotFoundPageID,
+		SeeAlso: laroussefr.GetSearchSuggestions(doc),
+	}
+}
+
 // newResultFromRoot returns a new Result from an HTML root.
 func newResultFromRoot(doc *html.Node) (Result, error) {
 	pageID, err := laroussefr.GetPageID(doc)
@@ -626,10 +1642,62 @@ func newResultFromRoot(doc *html.Node) (Result, error) {
 	if err != nil {
 		return Result{}, laroussefr.NewError("newResultFromRoot", "", err.Error())
 	}
-	result := Result{pageID, words, seeAlso}
+	if MergeGenderVariants {
+		words = mergeGenderVariants(words)
+	}
+	result := Result{PageID: pageID, Words: words, SeeAlso: seeAlso}
+	result.RedirectTo = scrapeRedirect(doc, words)
 	return result, nil
 }
 
+// scrapeRedirect takes a page root and its scraped words, and returns the
+// entry the page redirects to if its words have no translations or phrases,
+// only a single word they all refer to. Otherwise, an empty Redirect is
+// returned.
+func scrapeRedirect(doc *html.Node, words []Word) Redirect {
+	var target, url string
+	for _, w := range words {
+		for _, sub := range w.Subheaders {
+			for _, item := range sub.Items {
+				if len(item.Phrases) > 0 {
+					return Redirect{}
+				}
+				for _, m := range item.Meanings {
+					switch {
+						case m.Text != "":
+							return Redirect{}
+						case m.CrossRef == "":
+						case target != "" && m.CrossRef != target:
+							return Redirect{}
+						default:
+							target = m.CrossRef
+							if url == "" {
+								url = m.CrossRefURL
+							}
+					}
+				}
+			}
+		}
+	}
+	if target == "" {
+		return Redirect{}
+	}
+	if dict := laroussefr.GetPageDictionary(doc); url == "" && dict != "" {
+		url = laroussefr.WordURL(dict, target)
+	}
+	return Redirect{target, url}
+}
+
+// renvoiURL takes a "Renvois" node and returns the absolute URL of the page it
+// links to, or "" if it isn't a link.
+func renvoiURL(n *html.Node) string {
+	a, ok := scrape.Find(n, scrape.ByTag(atom.A))
+	if !ok || scrape.Attr(a, "href") == "" {
+		return ""
+	}
+	return laroussefr.AbsoluteURL(scrape.Attr(a, "href"))
+}
+
 // scrapeWords takes a page root and scrapes all of its bigWords and smallWords
 // into a Word slice.
 func scrapeWords(doc *html.Node) ([]Word, error) {
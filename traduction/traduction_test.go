@@ -2,9 +2,20 @@
 package traduction
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
 	"testing"
+	
+	"github.com/serope/laroussefr"
+	"github.com/serope/laroussefr/scrapeutil"
+	
+	"github.com/yhat/scrape"
 )
 
 // Type newArg represents args passed to New.
@@ -183,6 +194,513 @@ func TestNewFromFileOrURL(t *testing.T) {
 	}
 }
 
+// TestNewFromFileOrURLZoneTexteSibling tests a page with whitespace between a
+// ZoneEntree node and its ZoneTexte node.
+func TestNewFromFileOrURLZoneTexteSibling(t *testing.T) {
+	got, err := NewFromFileOrURL("testdata/aire.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Words) != 1 {
+		t.Fatalf("len(Words): want 1, got %d", len(got.Words))
+	}
+	items := got.Words[0].Subheaders[0].Items
+	if len(items) != 2 {
+		t.Fatalf("len(Items): want 2, got %d", len(items))
+	}
+	if items[0].Meanings[0].Text != "area" {
+		t.Fatalf("Meanings[0].Text: want \"area\", got \"%s\"", items[0].Meanings[0].Text)
+	}
+}
+
+// TestZoneTexteStrayElement tests that an element between a ZoneEntree node
+// and its ZoneTexte node is skipped, and that a word without a ZoneTexte node
+// is an error rather than scraping the wrong node.
+func TestZoneTexteStrayElement(t *testing.T) {
+	const page = `<html><head><link rel="canonical" href="https://www.larousse.fr/dictionnaires/francais-anglais/aire/1944"></head><body><div class="article_bilingue">` +
+		`<div class="ZoneEntree"><span class="Adresse">aire</span> <span class="CategorieGrammaticale">nom féminin</span></div>` +
+		`%s<div class="ZoneTexte"><div class="itemZONESEM"><span class="Traduction">area</span></div></div></div></body></html>`
+	res, err := NewFromHTML(fmt.Sprintf(page, `<div class="pub"><p>publicité</p></div>`), Fr, En)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := res.Words[0].Subheaders[0].Items[0].Meanings[0].Text; got != "area" {
+		t.Fatalf("stray element: want \"area\", got %q", got)
+	}
+	
+	doc, err := scrapeutil.HTMLRootFromString(`<html><body><div class="ZoneEntree"></div><div class="pub"></div><div class="ZoneEntree"></div><div class="ZoneTexte"></div></body></html>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zoneEntree, _ := scrape.Find(doc, scrape.ByClass("ZoneEntree"))
+	if _, err := getZoneTexteNode(zoneEntree); err == nil {
+		t.Fatal("missing ZoneTexte: want an error")
+	}
+}
+
+// TestHasAudio tests HasAudio on Words with and without audio clips.
+func TestHasAudio(t *testing.T) {
+	cases := map[string]struct {
+		word Word
+		want bool
+	}{
+		"none":      {Word{}, false},
+		"header":    {Word{Header: Header{Audio: "https://voix.larousse.fr/francais/16869A.mp3"}}, true},
+		"subphrase": {Word{Subheaders: []Subheader{{Items: []Item{{Phrases: []Phrase{{Subphrases: []Phrase{{Audio2: "https://voix.larousse.fr/anglais/40132ang2.mp3"}}}}}}}}}, true},
+	}
+	
+	for k, v := range cases {
+		fmt.Print(k, "\t")
+		if v.word.HasAudio() != v.want {
+			fmt.Println("FAIL")
+			t.Fail()
+		} else {
+			fmt.Println("OK")
+		}
+	}
+	
+	if !(Result{Words: []Word{cases["none"].word, cases["header"].word}}).HasAudio() {
+		t.Fatal("Result.HasAudio: want true")
+	}
+}
+
+// TestIPA tests IPA and IPAWithoutStress on various phonetics.
+func TestIPA(t *testing.T) {
+	cases := map[string][2]string {
+		"":                {"", ""},
+		"[εr]":            {"εr", "εr"},
+		"[ˈmɒtɪveɪt]":     {"ˈmɒtɪveɪt", "mɒtɪveɪt"},
+		" [ˌɪnfəˈmeɪʃn] ": {"ˌɪnfəˈmeɪʃn", "ɪnfəmeɪʃn"},
+		"[vεr,  vεrt]":    {"vεr, vεrt", "vεr, vεrt"},
+	}
+	
+	for k, v := range cases {
+		fmt.Print(k, "\t")
+		h := Header{Phonetic: k}
+		if h.IPA() != v[0] || h.IPAWithoutStress() != v[1] {
+			fmt.Printf("FAIL - got \"%s\", \"%s\"\n", h.IPA(), h.IPAWithoutStress())
+			t.Fail()
+		} else {
+			fmt.Println("OK")
+		}
+	}
+}
+
+// TestAlternatives tests that translations separated by "ou" are split into
+// a Phrase's Alternatives.
+func TestAlternatives(t *testing.T) {
+	got, err := NewFromFileOrURL("testdata/aire.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := got.Words[0].Subheaders[0].Items[0].Phrases[1]
+	if p.Text2 != "rest area ou lay-by" {
+		t.Fatalf("Text2: got \"%s\"", p.Text2)
+	}
+	if len(p.Alternatives) != 2 || p.Alternatives[0] != "rest area" || p.Alternatives[1] != "lay-by" {
+		t.Fatalf("Alternatives: got %q", p.Alternatives)
+	}
+	if got.Words[0].Subheaders[0].Items[0].Phrases[0].Alternatives != nil {
+		t.Fatal("Alternatives: want nil for a phrase without \"ou\"")
+	}
+}
+
+// TestAlternativesEmphasis tests that Alternatives keep the same emphasis
+// markup as Text when laroussefr.PreserveEmphasis is true.
+func TestAlternativesEmphasis(t *testing.T) {
+	defer func() { laroussefr.PreserveEmphasis = false }()
+	laroussefr.PreserveEmphasis = true
+	res, err := NewFromHTML(`<html><head><link rel="canonical" href="https://www.larousse.fr/dictionnaires/francais-anglais/verre/80711"></head><body><div class="article_bilingue">` +
+		`<div class="ZoneEntree"><span class="Adresse">verre</span></div>` +
+		`<div class="ZoneTexte"><div class="itemZONESEM"><span class="Traduction"><i>glass</i> <span class="oubien">ou</span> <b>drink</b></span></div></div>` +
+		`</div></body></html>`, Fr, En)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := res.Words[0].Subheaders[0].Items[0].Meanings[0]
+	want := []string{"*glass*", "**drink**"}
+	if !strings.Contains(m.Text, "*glass*") || !reflect.DeepEqual(m.Alternatives, want) {
+		t.Fatalf("want Text with emphasis and Alternatives %q, got %q and %q", want, m.Text, m.Alternatives)
+	}
+}
+
+// TestSeeAlsoCopyright tests that a SeeAlso link with a registered trademark
+// symbol is stored as a usable URL, both in a page downloaded by http.Get and
+// in one saved with wget.
+func TestSeeAlsoCopyright(t *testing.T) {
+	want := []string{
+		"https://larousse.fr/dictionnaires/francais-anglais/Airbag®/82998",
+		"https://larousse.fr/dictionnaires/francais-anglais/airelle/1945",
+	}
+	for _, path := range []string{"testdata/aire.html", "testdata/aire-wget.html"} {
+		got, err := NewFromFileOrURL(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got.SeeAlso) != len(want) {
+			t.Fatalf("%s SeeAlso: got %q", path, got.SeeAlso)
+		}
+		for i := range want {
+			if got.SeeAlso[i] != want[i] {
+				t.Fatalf("%s SeeAlso[%d]\nwant: %s\ngot:  %s", path, i, want[i], got.SeeAlso[i])
+			}
+		}
+	}
+}
+
+// TestStripTrademarks tests that laroussefr.StripTrademarks removes the
+// registered trademark symbol from SeeAlso links and header text.
+func TestStripTrademarks(t *testing.T) {
+	defer func() { laroussefr.StripTrademarks = false }()
+	laroussefr.StripTrademarks = true
+	got, err := NewFromFileOrURL("testdata/aire.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "https://larousse.fr/dictionnaires/francais-anglais/Airbag/82998"
+	if len(got.SeeAlso) == 0 || got.SeeAlso[0] != want {
+		t.Fatalf("SeeAlso: want %s first, got %q", want, got.SeeAlso)
+	}
+	
+	if s := laroussefr.CleanWordText("Airbag® (Frisbee™)"); s != "Airbag (Frisbee)" {
+		t.Fatalf("CleanWordText: want \"Airbag (Frisbee)\", got %q", s)
+	}
+	laroussefr.StripTrademarks = false
+	if s := laroussefr.CleanWordText("Airbag®"); s != "Airbag®" {
+		t.Fatalf("CleanWordText: want \"Airbag®\" when disabled, got %q", s)
+	}
+}
+
+// TestPairs tests Word.Pairs on a saved page.
+func TestPairs(t *testing.T) {
+	got, err := NewFromFileOrURL("testdata/aire.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pairs := got.Words[0].Pairs()
+	want := []Pair{{"aire", "area", "[terrain]"}}
+	if len(pairs) != len(want) || pairs[0] != want[0] {
+		t.Fatalf("want %v, got %v", want, pairs)
+	}
+}
+
+// TestFilterByType tests FilterByType on a page with several parts of speech.
+func TestFilterByType(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/court.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	
+	cases := map[string]int {
+		"adjectif":     1,
+		"Adverbe":      1,
+		"nom":          1,
+		"nom masculin": 1,
+		"nom féminin":  0,
+		"verbe":        0,
+		"":             0,
+	}
+	
+	for k, v := range cases {
+		fmt.Print(k, "\t")
+		got := res.FilterByType(k)
+		if len(got) != v {
+			fmt.Printf("FAIL - want %d, got %d\n", v, len(got))
+			t.Fail()
+		} else {
+			fmt.Println("OK")
+		}
+	}
+}
+
+// TestDiagnostics tests that Diagnostics is told about unrecognized nodes.
+func TestDiagnostics(t *testing.T) {
+	var messages []string
+	defer func() { Diagnostics = nil }()
+	Diagnostics = func(message string) {
+		messages = append(messages, message)
+	}
+	
+	_, err := NewFromFileOrURL("testdata/court.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `Phrase: skipped node of class "Exemple2": "(figuratif)"`
+	if len(messages) != 1 || messages[0] != want {
+		t.Fatalf("want [%s], got %q", want, messages)
+	}
+}
+
+// TestFingerprint tests that Fingerprint ignores audio URLs and page IDs but
+// not content.
+func TestFingerprint(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/aire.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := res.Fingerprint()
+	
+	same, _ := NewFromFileOrURL("testdata/aire.html")
+	same.PageID = 1
+	same.Words[0].Header.Audio = "https://voix.larousse.fr/francais/00000.mp3"
+	same.Words[0].Subheaders[0].Items[0].Phrases[0].Audio2 = ""
+	if same.Fingerprint() != want {
+		t.Fatal("Fingerprint changed after changing PageID and audio")
+	}
+	
+	different, _ := NewFromFileOrURL("testdata/aire.html")
+	different.Words[0].Subheaders[0].Items[0].Meanings[0].Text = "zone"
+	if different.Fingerprint() == want {
+		t.Fatal("Fingerprint didn't change after changing a Meaning")
+	}
+}
+
+// TestPrimaryTranslations tests PrimaryTranslations on a page with several
+// Words sharing the same Text.
+func TestPrimaryTranslations(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/court.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := res.PrimaryTranslations()
+	if len(got) != 1 || got["court"] != "short" {
+		t.Fatalf("want map[court:short], got %v", got)
+	}
+	
+	_, ok := Word{}.PrimaryMeaning()
+	if ok {
+		t.Fatal("PrimaryMeaning: want false for an empty Word")
+	}
+}
+
+// TestCrossRef tests that a meaning which only refers to another word has its
+// CrossRef set instead of its Text.
+func TestCrossRef(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/coup.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Words) != 2 {
+		t.Fatalf("len(Words): want 2, got %d", len(res.Words))
+	}
+	
+	want := []Meaning{
+		{CrossRef: "coup de téléphone"},
+		{Text: "draught, haul", RedBrac: "[poissons]"},
+	}
+	for i, w := range res.Words {
+		got := w.Subheaders[0].Items[0].Meanings[0]
+		message, ok := want[i].equals(got)
+		if !ok {
+			t.Fatalf("Words[%d]: %s", i, message)
+		}
+	}
+}
+
+// TestTargetGenre tests that gender markers are moved from a meaning's Text
+// to its TargetGenre.
+func TestTargetGenre(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/drink.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Meaning{
+		{Text: "boisson", RedBrac: "[gen]", TargetGenre: []string{"f"}},
+		{Text: "alcool, boisson alcoolisée", RedBrac: "[alcohol]", TargetGenre: []string{"m", "f"}},
+		{Text: "verre ou coup", RedBrac: "[glass]", TargetGenre: []string{"m", "m"}},
+	}
+	items := res.Words[0].Subheaders[0].Items
+	if len(items) != len(want) {
+		t.Fatalf("len(Items): want %d, got %d", len(want), len(items))
+	}
+	for i, item := range items {
+		got := item.Meanings[0]
+		message, ok := want[i].equals(got)
+		if !ok {
+			t.Fatalf("Items[%d]: %s", i, message)
+		}
+	}
+	alts := items[2].Meanings[0].Alternatives
+	if strings.Join(alts, "|") != "verre|coup" {
+		t.Fatalf("Alternatives: want [verre coup], got %q", alts)
+	}
+}
+
+// TestBigWordsItemBLSEM tests a word whose subheaders are in "itemBLSEM" nodes
+// rather than "itemBLSEM1" nodes.
+func TestBigWordsItemBLSEM(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/make.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	subs := res.Words[0].Subheaders
+	if len(subs) != 2 {
+		t.Fatalf("len(Subheaders): want 2, got %d", len(subs))
+	}
+	if subs[0].Title != "transitive verb" || subs[1].Title != "intransitive verb" {
+		t.Fatalf("Titles: got \"%s\", \"%s\"", subs[0].Title, subs[1].Title)
+	}
+	if len(subs[0].Items) != 2 || len(subs[1].Items) != 1 {
+		t.Fatalf("len(Items): got %d, %d", len(subs[0].Items), len(subs[1].Items))
+	}
+}
+
+// TestNewAuto tests NewAuto on an English word, which isn't found in the
+// French-English dictionary.
+func TestNewAuto(t *testing.T) {
+	client := scrapeutil.Client
+	defer func() { scrapeutil.Client = client }()
+	scrapeutil.Client = fixtureClient(map[string]string {
+		"/dictionnaires/francais-anglais/make": "testdata/notfound.html",
+		"/dictionnaires/anglais-francais/make": "testdata/make.html",
+	})
+	
+	res, from, err := NewAuto("make")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if from != En || res.PageID != 593701 {
+		t.Fatalf("want En and 593701, got %s and %d", from, res.PageID)
+	}
+	
+	_, _, err = NewAuto("mxke")
+	if !errors.Is(err, ErrWordNotFound) {
+		t.Fatalf("want ErrWordNotFound, got %v", err)
+	}
+}
+
+// TestNewHeaderOnly tests that the first word's header is scraped on its own.
+func TestNewHeaderOnly(t *testing.T) {
+	client := scrapeutil.Client
+	defer func() { scrapeutil.Client = client }()
+	scrapeutil.Client = fixtureClient(map[string]string {
+		"/dictionnaires/anglais-francais/make": "testdata/make.html",
+	})
+	
+	got, err := NewHeaderOnly("make", En, Fr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := NewFromFileOrURL("testdata/make.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	message, ok := res.Words[0].Header.equals(got)
+	if !ok {
+		t.Fatal(message)
+	}
+	
+	_, err = NewHeaderOnly("mxke", En, Fr)
+	if !errors.Is(err, ErrWordNotFound) {
+		t.Fatalf("want ErrWordNotFound, got %v", err)
+	}
+}
+
+// fixtureClient returns an http.Client which serves the files in paths,
+// keyed by URL path, instead of using the network. Other paths are served the
+// "word not found" page.
+func fixtureClient(paths map[string]string) *http.Client {
+	return &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		path, ok := paths[strings.TrimSuffix(req.URL.Path, "/")]
+		if !ok {
+			path = "testdata/notfound.html"
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewReader(data)),
+			Header:     make(http.Header),
+			Request:    req,
+		}, nil
+	})}
+}
+
+// TestHTML tests Result.HTML against a golden file.
+func TestHTML(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/coup.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := res.HTML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := ioutil.ReadFile("testdata/coup.golden.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Fatalf("want:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+// TestHTMLEscape tests that Result.HTML escapes scraped text and URLs.
+func TestHTMLEscape(t *testing.T) {
+	res := Result{Words: []Word{{Header: Header{Text: "<script>alert(1)</script>", Audio: "javascript:alert(1)"}}}}
+	got, err := res.HTML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(got, "<script>") || strings.Contains(got, "javascript:") {
+		t.Fatalf("unescaped output:\n%s", got)
+	}
+	if !strings.Contains(got, "&lt;script&gt;alert(1)&lt;/script&gt;") {
+		t.Fatalf("missing escaped text:\n%s", got)
+	}
+}
+
+// TestNotFoundResult tests the Result returned with ErrWordNotFound.
+func TestNotFoundResult(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/notfound.html")
+	if !errors.Is(err, ErrWordNotFound) {
+		t.Fatalf("want ErrWordNotFound, got %v", err)
+	}
+	if res.PageID != laroussefr.NotFoundPageID || !res.IsEmpty() || len(res.SeeAlso) != 2 {
+		t.Fatalf("want an empty Result with 2 suggestions, got %+v", res)
+	}
+	
+	res, err = NewFromFileOrURL("testdata/coup.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.IsEmpty() {
+		t.Fatal("IsEmpty: want false, got true")
+	}
+}
+
+// TestIncludeRawHTML tests that the source HTML is kept only if
+// IncludeRawHTML is true.
+func TestIncludeRawHTML(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/coup.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Words[0].RawHTML != "" {
+		t.Fatalf("RawHTML: want empty by default, got %s", res.Words[0].RawHTML)
+	}
+	
+	defer func() { IncludeRawHTML = false }()
+	IncludeRawHTML = true
+	res, err = NewFromFileOrURL("testdata/coup.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(res.Words[1].RawHTML, `<div class="ZoneEntree">`) {
+		t.Fatalf("RawHTML: got %s", res.Words[1].RawHTML)
+	}
+}
+
+// roundTripFunc is an http.RoundTripper which serves responses from a function
+// instead of the network.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
 // getCorrectResult returns the expected Result of a test word.
 func getCorrectResult(word string) (Result, error) {
 	var str string
@@ -190,20 +708,847 @@ func getCorrectResult(word string) (Result, error) {
 		case "aire":       str = `{"PageID": 1944,"Words": [{"Code": 1944,"Header": {"Text": "aire","TextAlt": "","Phonetic": "[εr]","Audio": "https://voix.larousse.fr/francais/16869A.mp3","Type": "nom féminin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "area","RedBrac": "[terrain]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "aire de jeu","Text2": "playground","Audio1": "https://voix.larousse.fr/francais/110957fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/40132ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "aires de repos","Text2": "rest areas (along a road), lay-bys  (UK)","Audio1": "https://voix.larousse.fr/francais/229830fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/171753ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "aire de stationnement","Text2": "parking area","Audio1": "https://voix.larousse.fr/francais/110958fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/171754ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "AÉRONAUTIQUE \u0026 ASTRONOMIE","RedMeta": ""}  ],"Phrases": [{"Text1": "aire d'atterrissage","Text2": "landing area","Audio1": "https://voix.larousse.fr/francais/25684fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/171755ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "aire d'embarquement","Text2": "boarding area","Audio1": "https://voix.larousse.fr/francais/229831fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/171756ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "aire de lancement","Text2": "launching site","Audio1": "https://voix.larousse.fr/francais/229832fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/171757ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "GÉOLOGIE","RedMeta": ""}  ],"Phrases": [{"Text1": "aire continentale","Text2": "continental shield","Audio1": "https://voix.larousse.fr/francais/229833fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/171758ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "area","RedBrac": "","RedCaps": "MATHÉMATIQUES","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": "floor","RedBrac": "","RedCaps": "AGRICULTURE","RedMeta": ""}  ],"Phrases": [{"Text1": "aire de battage","Text2": "threshing floor","Audio1": "https://voix.larousse.fr/francais/229834fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/171759ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "eyrie","RedBrac": "[nid d'aigle]","RedCaps": "","RedMeta": ""}  ],"Phrases": null}  ]}  ]}  ],"SeeAlso": ["https://larousse.fr/dictionnaires/francais-anglais/airelle/1947","https://larousse.fr/dictionnaires/francais-anglais/aisance/1950","https://larousse.fr/dictionnaires/francais-anglais/aise/1954","https://larousse.fr/dictionnaires/francais-anglais/aisé/1955","https://larousse.fr/dictionnaires/francais-anglais/aisément/1956","https://larousse.fr/dictionnaires/francais-anglais/aïoli/543836","https://larousse.fr/dictionnaires/francais-anglais/air/1941","https://larousse.fr/dictionnaires/francais-anglais/airain/1943","https://larousse.fr/dictionnaires/francais-anglais/Airbag\u003csup\u003e®\u003c/sup\u003e/82998","https://larousse.fr/dictionnaires/francais-anglais/Airbus\u003csup\u003e®\u003c/sup\u003e/82999"  ]}`
 		case "bois":       str = `{"PageID": 9914,"Words": [{"Code": 9914,"Header": {"Text": "bois","TextAlt": "","Phonetic": "[bwa]","Audio": "https://voix.larousse.fr/francais/01656.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "wood, wooded area","RedBrac": "[de grands arbres]","RedCaps": "","RedMeta": ""},{"Text": "thicket, copse, coppice","RedBrac": "[de jeunes ou petits arbres]","RedCaps": "","RedMeta": ""},{"Text": "grove","RedBrac": "[d'arbres plantés]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "un bois de pins","Text2": "a pine grove","Audio1": "https://voix.larousse.fr/francais/287341fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/258679ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "wood","RedBrac": "[matière]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "en bois","Text2": "wooden","Audio1": "https://voix.larousse.fr/francais/21408fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/52802ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "bois à brûler ou de chauffage","Text2": "firewood","Audio1": "https://voix.larousse.fr/francais/287342fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/27682ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "bois blanc","Text2": "whitewood","Audio1": "https://voix.larousse.fr/francais/287343fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/258680ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "bois de charpente","Text2": "timber","Audio1": "https://voix.larousse.fr/francais/287344fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/49334ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "bois debout","Text2": "standing timber","Audio1": "https://voix.larousse.fr/francais/287345fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/122198ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "bois d'ébène","Text2": "","Audio1": "https://voix.larousse.fr/francais/287346fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": [{"Text1": "","Text2": "ebony","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/25589ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(sens propre)","IsBlue": true,"Subphrases": null},{"Text1": "","Text2": "black gold","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/216840ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": true,"Subphrases": null}  ]},{"Text1": "bois des îles","Text2": "tropical hardwood","Audio1": "https://voix.larousse.fr/francais/287347fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/258681ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "bois de rose","Text2": "rosewood","Audio1": "https://voix.larousse.fr/francais/287348fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/43650ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "bois mort","Text2": "deadwood","Audio1": "https://voix.larousse.fr/francais/21410fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/23470ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "petit bois","Text2": "kindling","Audio1": "https://voix.larousse.fr/francais/78981fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/33717ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "il est du bois dont on fait les flûtes","Text2": "he's very easy-going","Audio1": "https://voix.larousse.fr/francais/287349fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/212576ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "il est du bois dont on fait les héros","Text2": "he's got the stuff of heroes","Audio1": "https://voix.larousse.fr/francais/287350fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/258682ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "faire feu ou flèche de tout bois","Text2": "to use all available means","Audio1": "https://voix.larousse.fr/francais/287351fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/258683ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "touchons ou je touche du bois","Text2": "touch wood","Audio1": "https://voix.larousse.fr/francais/287352fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/258684ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "je vais leur montrer de quel bois je me chauffe !","Text2": "I'll show them what I'm made of !","Audio1": "https://voix.larousse.fr/francais/287353fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/92840ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(familier)","IsBlue": true,"Subphrases": null}  ]},{"Meanings": [{"Text": "frame","RedBrac": "[d'une raquette]","RedCaps": "","RedMeta": ""},{"Text": "wood","RedBrac": "[d'un club de golf]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "faire un bois","Text2": "to hit the ball off the wood","Audio1": "https://voix.larousse.fr/francais/114466fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/258685ang2.mp3","RedBrac": "[au tennis]","RedCaps": "","RedMeta": "(familier)","IsBlue": false,"Subphrases": null},{"Text1": "bois de lit","Text2": "bedstead","Audio1": "https://voix.larousse.fr/francais/287354fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/61852ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "ART","RedMeta": ""}  ],"Phrases": [{"Text1": "bois (gravé)","Text2": "woodcut","Audio1": "https://voix.larousse.fr/francais/287355fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/129327ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 9915,"Header": {"Text": "bois","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/01656.mp3","Type": "nom masculin pluriel"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "antlers","RedBrac": "","RedCaps": "ZOOLOGIE","RedMeta": ""},{"Text": "goalposts","RedBrac": "","RedCaps": "FOOTBALL","RedMeta": ""},{"Text": "woodwind section ou instruments","RedBrac": "","RedCaps": "MUSIQUE","RedMeta": ""}  ],"Phrases": null}  ]}  ]},{"Code": 647158,"Header": {"Text": "de bois","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/210120fra2.mp3","Type": "locution adjectivale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "wooden","RedBrac": "[charpente, jouet, meuble]","RedCaps": "","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": "","RedBrac": "[impassible]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "je ne suis pas de bois","Text2": "I'm only human","Audio1": "https://voix.larousse.fr/francais/287356fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/258687ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]}  ],"SeeAlso": ["https://larousse.fr/dictionnaires/francais-anglais/boisage/9916","https://larousse.fr/dictionnaires/francais-anglais/boisé/647159","https://larousse.fr/dictionnaires/francais-anglais/boisement/9917","https://larousse.fr/dictionnaires/francais-anglais/boiser/88056","https://larousse.fr/dictionnaires/francais-anglais/boiserie/9918","https://larousse.fr/dictionnaires/francais-anglais/Bohême/88018","https://larousse.fr/dictionnaires/francais-anglais/bohémien/9908","https://larousse.fr/dictionnaires/francais-anglais/boille/545500","https://larousse.fr/dictionnaires/francais-anglais/boire/9912","https://larousse.fr/dictionnaires/francais-anglais/boire/150908"  ]}`
 		case "certificat": str = `{"PageID": 648464,"Words": [{"Code": 648464,"Header": {"Text": "certificat","TextAlt": "","Phonetic": "[sεrtifika]","Audio": "https://voix.larousse.fr/francais/02416.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "certificate","RedBrac": "[attestation]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "certificat de bonne vie et de bonnes mœurs","Text2": "character reference","Audio1": "https://voix.larousse.fr/francais/267519fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/229074ang2.mp3","RedBrac": "","RedCaps": "DROIT","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "certificat médical","Text2": "doctor's certificate","Audio1": "https://voix.larousse.fr/francais/23257fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/148228ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "certificat de naissance","Text2": "birth certificate","Audio1": "https://voix.larousse.fr/francais/267520fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/15261ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "certificat d'origine","Text2": "certificate of origin","Audio1": "https://voix.larousse.fr/francais/84444fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/65856ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "certificat de sécurité","Text2": "security certficate","Audio1": "https://voix.larousse.fr/francais/267521fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/229075ang2.mp3","RedBrac": "","RedCaps": "INFORMATIQUE","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "certificat de scolarité","Text2": "","Audio1": "https://voix.larousse.fr/francais/212177fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "school attendance certificate","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/229076ang2.mp3","RedBrac": "","RedCaps": "ÉDUCATION","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "university attendance certificate","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/229077ang2.mp3","RedBrac": "","RedCaps": "UNIVERSITÉ","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Text1": "certificat de travail","Text2": "P 45  (UK) , attestation of employment","Audio1": "https://voix.larousse.fr/francais/267522fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/229078ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "certificat de vaccination","Text2": "vaccination certificate","Audio1": "https://voix.larousse.fr/francais/267523fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/142962ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "diploma, certificate","RedBrac": "[diplôme]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "certificat d'aptitude professionnelle","Text2": "CAP","Audio1": "https://voix.larousse.fr/francais/891fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "certificat d'aptitude au professorat de l'enseignement du second degré","Text2": "CAPES","Audio1": "https://voix.larousse.fr/francais/267524fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "certificat d'aptitude au professorat de l'enseignement technique","Text2": "CAPET","Audio1": "https://voix.larousse.fr/francais/267525fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "certificat d'études (primaires)","Text2": "basic school-leaving qualification (abolished in Metropolitan France in 1989)","Audio1": "https://voix.larousse.fr/francais/267526fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/229080ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]}  ],"SeeAlso": ["https://larousse.fr/dictionnaires/francais-anglais/certifié/14157","https://larousse.fr/dictionnaires/francais-anglais/certifier/14158","https://larousse.fr/dictionnaires/francais-anglais/certitude/14160","https://larousse.fr/dictionnaires/francais-anglais/cérumen/14165","https://larousse.fr/dictionnaires/francais-anglais/cerveau/14171","https://larousse.fr/dictionnaires/francais-anglais/certain/14146","https://larousse.fr/dictionnaires/francais-anglais/certain/648461","https://larousse.fr/dictionnaires/francais-anglais/certainement/14151","https://larousse.fr/dictionnaires/francais-anglais/certes/14152","https://larousse.fr/dictionnaires/francais-anglais/certif/648463"  ]}`
-		case "coup":       str = `{"PageID": 19682,"Words": [{"Code": 19682,"Header": {"Text": "coup","TextAlt": "","Phonetic": "[ku]","Audio": "https://voix.larousse.fr/francais/03280.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "[HEURT, DÉFLAGRATION]","Items": [{"Meanings": [{"Text": "blow, knock","RedBrac": "[généralement]","RedCaps": "","RedMeta": ""},{"Text": "punch, blow","RedBrac": "[avec le poing]","RedCaps": "","RedMeta": ""},{"Text": "kick","RedBrac": "[avec le pied]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "un coup violent","Text2": "a hard knock","Audio1": "https://voix.larousse.fr/francais/188339fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172047ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "elle a failli mourir sous ses coups","Text2": "he thrashed her to within an inch of her life, he nearly battered her to death","Audio1": "https://voix.larousse.fr/francais/230024fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172048ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "frapper à coups redoublés","Text2": "to hit twice as hard","Audio1": "https://voix.larousse.fr/francais/17467fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172049ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "donner un petit coup à ou sur quelque chose","Text2": "to tap something lightly","Audio1": "https://voix.larousse.fr/francais/230025fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172050ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "donner un coup sec sur quelque chose","Text2": "to give something a (hard ou smart) tap","Audio1": "https://voix.larousse.fr/francais/230026fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172051ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "il frappait sur la porte à grands coups/à petits coups","Text2": "he banged on the door/he knocked gently at the door","Audio1": "https://voix.larousse.fr/francais/230027fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172052ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "donner un coup sur la table","Text2": "to bang one's fist (down) on the table","Audio1": "https://voix.larousse.fr/francais/230028fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172053ang2.mp3","RedBrac": "[avec le poing]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "en arriver ou en venir aux coups","Text2": "to come to blows","Audio1": "https://voix.larousse.fr/francais/230029fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/16856ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "j'ai pris un coup sur la tête","Text2": "I got a knock ou a bang on the head","Audio1": "https://voix.larousse.fr/francais/230030fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172054ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "prendre des coups","Text2": "to get knocked about","Audio1": "https://voix.larousse.fr/francais/230031fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172055ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "recevoir un coup","Text2": "to get hit","Audio1": "https://voix.larousse.fr/francais/230032fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172056ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "rendre coup pour coup","Text2": "to hit back, to give as good as one gets","Audio1": "https://voix.larousse.fr/francais/150005fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172057ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(sens propre \u0026 figuré)","IsBlue": false,"Subphrases": null},{"Text1": "coups et blessures","Text2": "grievous bodily harm","Audio1": "https://voix.larousse.fr/francais/119088fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/3624ang2.mp3","RedBrac": "","RedCaps": "DROIT","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "porter un coup à quelqu'un","Text2": "to deal somebody a blow","Audio1": "https://voix.larousse.fr/francais/230033fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/70754ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(sens propre \u0026 figuré)","IsBlue": true,"Subphrases": null},{"Text1": "les grandes surfaces ont porté un (rude) coup au petit commerce","Text2": "small traders have been dealt a (severe) blow by large retail chains","Audio1": "https://voix.larousse.fr/francais/230034fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172058ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": true,"Subphrases": null},{"Text1": "le coup a porté","Text2": "the blow struck home","Audio1": "https://voix.larousse.fr/francais/230035fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172059ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(sens propre \u0026 figuré)","IsBlue": true,"Subphrases": null}  ]},{"Meanings": [{"Text": "blow, shock","RedBrac": "[attaque, choc]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "ça m'a fait un coup","Text2": "","Audio1": "https://voix.larousse.fr/francais/230036fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "it gave me a shock","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172061ang2.mp3","RedBrac": "[émotion]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "it was a blow","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172062ang2.mp3","RedBrac": "[déception]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Text1": "sale coup (pour la fanfare) !","Text2": "that's a bit of a blow ou downer !","Audio1": "https://voix.larousse.fr/francais/230037fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172063ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(familier)","IsBlue": true,"Subphrases": null},{"Text1": "trois échecs d'affilée, son moral en a pris un coup","Text2": "with three successive failures, her morale has taken a bit of a bashing","Audio1": "https://voix.larousse.fr/francais/230038fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172064ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(familier)","IsBlue": true,"Subphrases": null},{"Text1": "avec le krack boursier, l'économie en a pris un coup","Text2": "the economy has suffered a great deal from the crash","Audio1": "https://voix.larousse.fr/francais/230039fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172065ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "j'ai trop de travail, je ne sais pas si je tiendrai le coup","Text2": "I've got too much work, I don't know if I'll be able to cope","Audio1": "https://voix.larousse.fr/francais/230040fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172066ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}  ]},{"Meanings": [{"Text": "punch, blow","RedBrac": "[en boxe]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "coup bas","Text2": "","Audio1": "https://voix.larousse.fr/francais/2361fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "blow ou punch below the belt","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172067ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(sens propre)","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "blow below the belt","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172068ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": false,"Subphrases": null}  ]},{"Text1": "c'est un coup bas","Text2": "that's below the belt","Audio1": "https://voix.larousse.fr/francais/230041fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172069ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "tous les coups sont permis","Text2": " (there are) no holds barred","Audio1": "https://voix.larousse.fr/francais/155196fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172070ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(sens propre \u0026 figuré)","IsBlue": false,"Subphrases": null},{"Text1": "compter les coups","Text2": "to keep score","Audio1": "https://voix.larousse.fr/francais/230042fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/169178ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(sens propre \u0026 figuré)","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "shot, blast","RedBrac": "","RedCaps": "ARMEMENT","RedMeta": ""}  ],"Phrases": [{"Text1": "un coup de revolver","Text2": "a shot, a gunshot","Audio1": "https://voix.larousse.fr/francais/230043fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172072ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "le coup est parti","Text2": "","Audio1": "https://voix.larousse.fr/francais/230044fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "the gun went off","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172073ang2.mp3","RedBrac": "[revolver]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "the rifle went off","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172074ang2.mp3","RedBrac": "[fusil]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Text1": "tirer un coup de canon","Text2": "to fire ou to blast a cannon","Audio1": "https://voix.larousse.fr/francais/98322fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172075ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "(revolver à) six coups","Text2": "six-shot gun","Audio1": "https://voix.larousse.fr/francais/230045fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172076ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "faire coup double","Text2": "","Audio1": "https://voix.larousse.fr/francais/230046fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": [{"Text1": "","Text2": "to do a right and left","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172077ang2.mp3","RedBrac": "","RedCaps": "CHASSE","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "","Text2": "to kill two birds with one stone","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/15181ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": true,"Subphrases": null}  ]}  ]},{"Meanings": [{"Text": "knock","RedBrac": "[bruit - généralement]","RedCaps": "","RedMeta": ""},{"Text": "rap","RedBrac": "[ - sec]","RedCaps": "","RedMeta": ""},{"Text": "snap","RedBrac": "[craquement]","RedCaps": "","RedMeta": ""},{"Text": "stroke","RedBrac": "[heure sonnée]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "des coups au carreau","Text2": "knocking ou knocks on the window","Audio1": "https://voix.larousse.fr/francais/230047fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172078ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "un coup de gong","Text2": "a bang on a gong","Audio1": "https://voix.larousse.fr/francais/230048fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172079ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "","RedBrac": "[éjaculation]","RedCaps": "","RedMeta": "(vulgaire)"}  ],"Phrases": [{"Text1": "tirer un ou son coup","Text2": "to shoot one's load","Audio1": "https://voix.larousse.fr/francais/230049fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/91679ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]},{"Title": "[GESTE, ACTION]","Items": [{"Meanings": [{"Text": "","RedBrac": "[mouvement d'une partie du corps]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "un coup de corne","Text2": "a butt","Audio1": "https://voix.larousse.fr/francais/230050fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172080ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "un coup de langue","Text2": "a lick","Audio1": "https://voix.larousse.fr/francais/230051fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172081ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "elle nettoyait ses chatons à (grands) coups de langue","Text2": "she was licking the kittens clean","Audio1": "https://voix.larousse.fr/francais/230052fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172082ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "un coup de bec","Text2": "","Audio1": "https://voix.larousse.fr/francais/230053fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": [{"Text1": "","Text2": "a peck","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172083ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(sens propre)","IsBlue": true,"Subphrases": null},{"Text1": "","Text2": "cutting remark","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/160151ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": true,"Subphrases": null}  ]},{"Text1": "un coup de dent","Text2": "","Audio1": "https://voix.larousse.fr/francais/230054fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": [{"Text1": "","Text2": "a bite","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172084ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(sens propre)","IsBlue": true,"Subphrases": null},{"Text1": "","Text2": "cutting remark","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/160151ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": true,"Subphrases": null}  ]},{"Text1": "coup de griffe ou patte","Text2": "","Audio1": "https://voix.larousse.fr/francais/230055fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": [{"Text1": "","Text2": "swipe of the paw","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172085ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(sens propre)","IsBlue": true,"Subphrases": null},{"Text1": "","Text2": "cutting remark","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/160151ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": true,"Subphrases": null}  ]}  ]},{"Meanings": [{"Text": "","RedBrac": "[emploi d'un instrument]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "donner un (petit) coup de brosse/chiffon à quelque chose","Text2": "to give something a (quick) brush/wipe","Audio1": "https://voix.larousse.fr/francais/230056fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172086ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "je vais me donner un coup de peigne","Text2": "I'll just comb my hair ou give my hair a (quick) comb","Audio1": "https://voix.larousse.fr/francais/230057fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172087ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "je viens pour un coup de peigne","Text2": "I just want a quick comb through","Audio1": "https://voix.larousse.fr/francais/230058fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172088ang2.mp3","RedBrac": "[chez le coiffeur]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "passe un coup d'aspirateur au salon","Text2": "give the living room a quick vacuum","Audio1": "https://voix.larousse.fr/francais/230059fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172089ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "passe un coup d'éponge sur la table","Text2": "give the table a wipe (with the sponge)","Audio1": "https://voix.larousse.fr/francais/230060fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172090ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "un coup de marteau","Text2": "a blow with a hammer","Audio1": "https://voix.larousse.fr/francais/230061fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172091ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "il s'est donné un coup de marteau sur le doigt","Text2": "he hit his finger with a hammer","Audio1": "https://voix.larousse.fr/francais/230062fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172092ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "en deux coups de rame nous pouvons traverser la rivière","Text2": "we can cross the river in a couple of strokes","Audio1": "https://voix.larousse.fr/francais/230063fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172093ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "passe un coup dans la salle de bains","Text2": "give the bathroom a going-over","Audio1": "https://voix.larousse.fr/francais/230064fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172094ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(familier)","IsBlue": false,"Subphrases": null},{"Text1": "en donner ou ficher (familier) ou mettre (familier) un coup","Text2": "to get down to business","Audio1": "https://voix.larousse.fr/francais/230065fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/29214ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "il a fallu qu'ils en mettent un sacré coup","Text2": "they really had to pull out the stops","Audio1": "https://voix.larousse.fr/francais/230066fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172095ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}  ]},{"Meanings": [{"Text": "stroke","RedBrac": "[au golf, au billard]","RedCaps": "","RedMeta": ""},{"Text": "shot, stroke","RedBrac": "","RedCaps": "TENNIS","RedMeta": ""}  ],"Phrases": [{"Text1": "coup droit","Text2": " (forehand) drive","Audio1": "https://voix.larousse.fr/francais/212923fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172097ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "knack","RedBrac": "[savoir-faire]","RedCaps": "","RedMeta": "(familier)"}  ],"Phrases": [{"Text1": "ah, tu as le coup pour mettre la pagaille !","Text2": "you really have a gift ou a knack for creating havoc, don't you !","Audio1": "https://voix.larousse.fr/francais/230067fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172098ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "une fois que tu auras pris le coup, ça ira tout seul !","Text2": "you'll find it's very easy once you get used to it ou once you've got the knack !","Audio1": "https://voix.larousse.fr/francais/230068fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172099ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "MÉTÉOROLOGIE","RedMeta": ""}  ],"Phrases": [{"Text1": "coup de chaleur","Text2": "heatwave","Audio1": "https://voix.larousse.fr/francais/230069fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/142524ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "coup de mer","Text2": "heavy swell","Audio1": "https://voix.larousse.fr/francais/87491fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172100ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "coup de vent","Text2": "gust of wind","Audio1": "https://voix.larousse.fr/francais/212921fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/155843ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "wave","RedBrac": "[effet soudain]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "j'ai un coup de cafard","Text2": "I feel down all of a sudden","Audio1": "https://voix.larousse.fr/francais/230070fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172101ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "j'ai eu un coup de fatigue","Text2": "I suddenly felt tired, a wave of tiredness came over me","Audio1": "https://voix.larousse.fr/francais/230071fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172102ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "il a eu un coup de folie et a acheté une Rolls","Text2": "he went mad and bought himself a Rolls-Royce","Audio1": "https://voix.larousse.fr/francais/230072fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172103ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "avoir un coup de chaleur","Text2": "to feel the beginnings of sunstroke","Audio1": "https://voix.larousse.fr/francais/230073fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172104ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}  ]},{"Meanings": [{"Text": "drink","RedBrac": "[boisson]","RedCaps": "","RedMeta": "(familier)"}  ],"Phrases": [{"Text1": "j'ai le hoquet — bois un coup","Text2": "I've got (the) hiccups — drink something ou have a drink","Audio1": "https://voix.larousse.fr/francais/230074fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172105ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "tu me sers un coup (à boire) ?","Text2": "could you pour me a drink ?","Audio1": "https://voix.larousse.fr/francais/230075fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172106ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "boire un coup de trop","Text2": "to have one too many","Audio1": "https://voix.larousse.fr/francais/60547fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/98325ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "un coup de rouge","Text2": "a glass of red wine","Audio1": "https://voix.larousse.fr/francais/230076fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172107ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "throw","RedBrac": "[lancer]","RedCaps": "","RedMeta": ""},{"Text": "throw (of the dice)","RedBrac": "[aux dés]","RedCaps": "","RedMeta": ""},{"Text": "move","RedBrac": "[action]","RedCaps": "JEUX","RedMeta": ""},{"Text": "go","RedBrac": "","RedCaps": "CARTES","RedMeta": ""}  ],"Phrases": [{"Text1": "elle a renversé toutes les boîtes de conserve en un seul coup","Text2": "she knocked down all the cans in one throw","Audio1": "https://voix.larousse.fr/francais/230077fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172108ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "c'est un coup pour rien","Text2": "","Audio1": "https://voix.larousse.fr/francais/230078fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": [{"Text1": "","Text2": "it's a trial run","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172110ang2.mp3","RedBrac": "[essai]","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "","Text2": "it's a failure","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172111ang2.mp3","RedBrac": "[échec]","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}  ]}  ]}  ]},{"Title": "[ACTE OU SITUATION EXCEPTIONNELS]","Items": [{"Meanings": [{"Text": "trick","RedBrac": "[mauvais tour]","RedCaps": "","RedMeta": "(familier)"}  ],"Phrases": [{"Text1": "il prépare un coup","Text2": "he's up to something","Audio1": "https://voix.larousse.fr/francais/230079fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172112ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "(faire) un mauvais ou sale coup (à quelqu'un)","Text2": " (to play) a dirty trick (on somebody)","Audio1": "https://voix.larousse.fr/francais/230080fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172113ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "je parie que c'est un coup de Julie !","Text2": "I bet Julie's behind this !","Audio1": "https://voix.larousse.fr/francais/230081fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172114ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "coup en traître","Text2": "stab in the back","Audio1": "https://voix.larousse.fr/francais/230082fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172115ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "monter un coup contre quelqu'un","Text2": "to set somebody up, to frame somebody","Audio1": "https://voix.larousse.fr/francais/230083fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172116ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "il nous a encore fait le coup","Text2": "he's pulled the same (old) trick on us again","Audio1": "https://voix.larousse.fr/francais/230084fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172117ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "il a essayé de me faire le coup de la panne","Text2": "he tried to pull the old running-out-of-petrol trick on me","Audio1": "https://voix.larousse.fr/francais/230086fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172118ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "ne me fais pas le coup de ne pas venir !","Text2": "now don't stand me up, will you !","Audio1": "https://voix.larousse.fr/francais/230087fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172119ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "coup monté","Text2": "put-up job, frame-up","Audio1": "https://voix.larousse.fr/francais/212924fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172120ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "il fait toujours ses coups en douce","Text2": "he's always going behind people's backs","Audio1": "https://voix.larousse.fr/francais/230089fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172121ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}  ]},{"Meanings": [{"Text": "job","RedBrac": "[vol, escroquerie]","RedCaps": "","RedMeta": "(très familier, argot milieu)"}  ],"Phrases": null},{"Meanings": [{"Text": "","RedBrac": "[affaire]","RedCaps": "","RedMeta": "(familier)"},{"Text": "","RedBrac": "[personne - sexuellement]","RedCaps": "","RedMeta": "(vulgaire)"}  ],"Phrases": [{"Text1": "je suis sur un coup","Text2": "I'm onto something","Audio1": "https://voix.larousse.fr/francais/230090fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172122ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "je veux l'acheter mais on est plusieurs sur le coup","Text2": "I want to buy it but there are several people interested","Audio1": "https://voix.larousse.fr/francais/230091fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172123ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "expliquer le coup à quelqu'un","Text2": "to explain the situation ou set-up to somebody","Audio1": "https://voix.larousse.fr/francais/230092fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172124ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "être dans tous les coups","Text2": "to have a finger in every pie","Audio1": "https://voix.larousse.fr/francais/230093fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/78035ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "rattraper le coup","Text2": "to sort things out","Audio1": "https://voix.larousse.fr/francais/230094fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172125ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "il a manqué ou raté son coup","Text2": "he didn't pull it off","Audio1": "https://voix.larousse.fr/francais/230095fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172126ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "elle a réussi son coup","Text2": "she pulled it off","Audio1": "https://voix.larousse.fr/francais/230096fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/133652ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "c'est un coup à avoir un accident, ça !","Text2": "that's the sort of thing that causes accidents !","Audio1": "https://voix.larousse.fr/francais/230097fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172127ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "combien crois-tu que ça va coûter ? — oh, c'est un coup de 3 000 euros","Text2": "how much do you think it will cost ? — oh, about 3,000 euros","Audio1": "https://voix.larousse.fr/francais/230098fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172128ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "c'est un bon coup","Text2": "he/she's a good lay","Audio1": "https://voix.larousse.fr/francais/161019fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172129ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "coup","RedBrac": "[action remarquable, risquée]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "faire un beau ou joli coup","Text2": "to pull a (real) coup","Audio1": "https://voix.larousse.fr/francais/230099fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172130ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "quand il s'agit d'un gros coup, elle met la main à la pâte","Text2": "when it's something really important, she lends a hand","Audio1": "https://voix.larousse.fr/francais/230100fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172131ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "tenter le coup","Text2": "to have a go, to give it a try","Audio1": "https://voix.larousse.fr/francais/2392fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172132ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "c'est un coup à faire ou tenter","Text2": "it's worth trying ou a try","Audio1": "https://voix.larousse.fr/francais/230101fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172133ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "","RedBrac": "[circonstance marquante]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "marquer le coup","Text2": "to mark the occasion","Audio1": "https://voix.larousse.fr/francais/2390fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172134ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "un coup de chance ou de pot (familier) ou de bol (familier)","Text2": "a stroke of luck, a lucky break","Audio1": "https://voix.larousse.fr/francais/230102fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172135ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]},{"Title": "[fois]","Items": [{"Meanings": [{"Text": "time, go","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "du premier coup","Text2": "first time, at the first attempt","Audio1": "https://voix.larousse.fr/francais/2400fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172137ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "au prochain coup, tu vas y arriver","Text2": "you'll do it next time ou at your next go","Audio1": "https://voix.larousse.fr/francais/230103fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172138ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "essaie encore un coup","Text2": "have another go","Audio1": "https://voix.larousse.fr/francais/230104fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172139ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "ce coup-ci, on s'en va","Text2": "this time, we're off","Audio1": "https://voix.larousse.fr/francais/230105fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172140ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "pour un coup","Text2": "just for (this) once","Audio1": "https://voix.larousse.fr/francais/230106fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172141ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(familier)","IsBlue": false,"Subphrases": null},{"Text1": "c'est ça, pleure un bon coup","Text2": "that's it, have a good cry","Audio1": "https://voix.larousse.fr/francais/230108fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172142ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(familier)","IsBlue": false,"Subphrases": null},{"Text1": "vous devriez vous expliquer un bon coup !","Text2": "you should have it out once and for all !","Audio1": "https://voix.larousse.fr/francais/230109fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172143ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "souffle un grand coup !","Text2": "blow hard !","Audio1": "https://voix.larousse.fr/francais/230110fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172144ang2.mp3","RedBrac": "[en se mouchant, sur des bougies]","RedCaps": "","RedMeta": "(familier)","IsBlue": false,"Subphrases": null},{"Text1": "respire un grand coup","Text2": "take a deep breath","Audio1": "https://voix.larousse.fr/francais/230111fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172145ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 19682,"Header": {"Text": "à coups de","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/230112fra2.mp3","Type": "locution prépositionnelle"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "démoli à coups de marteau","Text2": "smashed to pieces with a hammer","Audio1": "https://voix.larousse.fr/francais/230113fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172146ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "la productivité a été augmentée à coups de primes spéciales","Text2": "productivity was increased through ou by dint of special bonuses","Audio1": "https://voix.larousse.fr/francais/230114fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172147ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 153981,"Header": {"Text": "à coup sûr","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23691.mp3","Type": "locution adverbiale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "undoubtedly, certainly, for sure","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "elle ne s'engage qu'à coup sûr","Text2": "she only commits herself when she's certain of the outcome","Audio1": "https://voix.larousse.fr/francais/230115fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172149ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 153983,"Header": {"Text": "après coup","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/8346fra2.mp3","Type": "locution adverbiale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "afterwards, later on","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "son attitude, après coup, s'expliquait bien","Text2": "it was easy to explain her attitude afterwards ou in retrospect","Audio1": "https://voix.larousse.fr/francais/230116fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172151ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650541,"Header": {"Text": "à tous les coups","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23692.mp3","Type": "locution adverbiale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "every time","RedBrac": "[chaque fois]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "ça marche à tous les coups","Text2": "it never fails","Audio1": "https://voix.larousse.fr/francais/230117fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/76652ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "","RedBrac": "[sans aucun doute]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "à tous les coups, il a oublié","Text2": "he's bound to have forgotten","Audio1": "https://voix.larousse.fr/francais/230118fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172152ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 153984,"Header": {"Text": "au coup par coup","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23693.mp3","Type": "locution adverbiale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "bit by bit","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "négocier au coup par coup","Text2": "to have piecemeal negotiations","Audio1": "https://voix.larousse.fr/francais/230119fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172153ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 153997,"Header": {"Text": "coup sur coup","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23694.mp3","Type": "locution adverbiale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "one after the other, in quick succession","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": null}  ]}  ]},{"Code": 650542,"Header": {"Text": "dans le coup","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23695.mp3","Type": "locution adjectivale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "elle est dans le coup","Text2": "","Audio1": "https://voix.larousse.fr/francais/230120fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "she's in on it ou involved in it","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172155ang2.mp3","RedBrac": "[complice]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "she knows all about it","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172156ang2.mp3","RedBrac": "[au courant]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "she's hip ou with it","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172157ang2.mp3","RedBrac": "[à la page]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Text1": "moi, je ne suis plus dans le coup","Text2": "","Audio1": "https://voix.larousse.fr/francais/230121fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "count me out ou leave me out of it","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172158ang2.mp3","RedBrac": "[dans l'affaire]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "I'm a bit out of touch ou out of it","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172159ang2.mp3","RedBrac": "[au courant]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]}  ]},{"Code": 910189,"Header": {"Text": "dans le coup","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23695.mp3","Type": "locution adverbiale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "mettre quelqu'un dans le coup","Text2": "to let somebody in on the act","Audio1": "https://voix.larousse.fr/francais/230122fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172160ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 154001,"Header": {"Text": "du coup","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/2399fra2.mp3","Type": "locution adverbiale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "so, as a result","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "elle ne pouvait pas venir, du coup j'ai reporté le dîner","Text2": "as she couldn't come, I put the dinner off, she couldn't come so I put the dinner off","Audio1": "https://voix.larousse.fr/francais/230123fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172162ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 153999,"Header": {"Text": "d'un (seul) coup","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/230124fra2.mp3","Type": "locution adverbiale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "in one (go), all at once","RedBrac": "[en une seule fois]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "il a tout bu d'un coup","Text2": "he drank the whole lot in one go, he downed it in one","Audio1": "https://voix.larousse.fr/francais/230125fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172164ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "all of a sudden","RedBrac": "[soudainement]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "j'ai eu envie de pleurer/de le gifler, ça m'a pris d'un coup","Text2": "I got a sudden urge to cry/to slap him","Audio1": "https://voix.larousse.fr/francais/230126fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172165ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(familier)","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 154020,"Header": {"Text": "pour le coup","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23696.mp3","Type": "locution adverbiale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "pour le coup, je ne savais plus quoi faire","Text2": "at that point, I didn't know what to do next","Audio1": "https://voix.larousse.fr/francais/230127fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172166ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "j'ai aussi failli renverser le lait, c'est pour le coup qu'il aurait été en colère !","Text2": "I nearly spilt the milk as well, he really would have been furious then !","Audio1": "https://voix.larousse.fr/francais/230128fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172167ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(familier)","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 154026,"Header": {"Text": "sous le coup de","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23697.mp3","Type": "locution prépositionnelle"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "faire quelque chose sous le coup de la colère","Text2": "to do something in anger","Audio1": "https://voix.larousse.fr/francais/230129fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172168ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "sous le coup de la colère, on dit des choses qu'on regrette après","Text2": "you often say things in anger which you regret later","Audio1": "https://voix.larousse.fr/francais/230130fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172169ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "il est encore sous le coup de l'émotion","Text2": "he still hasn't got over the shock","Audio1": "https://voix.larousse.fr/francais/230131fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172170ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "tomber sous le coup de quelque chose","Text2": "to come within the scope of something","Audio1": "https://voix.larousse.fr/francais/230132fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172171ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "tomber sous le coup de la loi","Text2": "to be punishable by law","Audio1": "https://voix.larousse.fr/francais/212931fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172172ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 154027,"Header": {"Text": "sur le coup","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23698.mp3","Type": "locution adverbiale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "instantly","RedBrac": "[mourir]","RedCaps": "","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": "straightaway, there and then","RedBrac": "[à ce moment-là]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "je n'ai pas compris sur le coup","Text2": "I didn't understand immediately ou straightaway","Audio1": "https://voix.larousse.fr/francais/230133fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172174ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 154028,"Header": {"Text": "sur le coup de","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23699.mp3","Type": "locution prépositionnelle"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "sur le coup de 6 h/de midi","Text2": "roundabout ou around 6 o'clock/midday","Audio1": "https://voix.larousse.fr/francais/230134fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172175ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650543,"Header": {"Text": "coup d'aile","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/230135fra2.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "tous les moineaux se sont envolés d'un coup d'aile","Text2": "all the sparrows took wing suddenly","Audio1": "https://voix.larousse.fr/francais/230136fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172176ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "Paris-Bruxelles en un coup d'aile","Text2": "Paris-Brussels in one short hop","Audio1": "https://voix.larousse.fr/francais/230137fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172177ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650544,"Header": {"Text": "coup de balai","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23700.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "donner un coup de balai","Text2": "to sweep up","Audio1": "https://voix.larousse.fr/francais/25778fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/138771ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "je vais donner un coup de balai dans la cuisine","Text2": "I'm going to sweep (out) the kitchen","Audio1": "https://voix.larousse.fr/francais/230138fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172178ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "la cuisine a besoin d'un bon coup de balai","Text2": "the kitchen needs a good sweep","Audio1": "https://voix.larousse.fr/francais/230139fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172179ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "le comité aurait besoin d'un bon coup de balai","Text2": "the committee could do with a shake-up","Audio1": "https://voix.larousse.fr/francais/230140fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172180ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650545,"Header": {"Text": "coup de barre","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23701.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "j'ai le coup de barre","Text2": "I feel tired all of a sudden","Audio1": "https://voix.larousse.fr/francais/230141fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172181ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650546,"Header": {"Text": "coup de chapeau","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23702.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "praise","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "donner un coup de chapeau à quelqu'un","Text2": "to praise somebody","Audio1": "https://voix.larousse.fr/francais/230142fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172182ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "son livre mérite un coup de chapeau","Text2": "his book deserves some recognition","Audio1": "https://voix.larousse.fr/francais/230143fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172183ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650547,"Header": {"Text": "coup de cœur","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23703.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": ""},{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "(comme adjectif invariable)"}  ],"Phrases": [{"Text1": "avoir un ou le coup de cœur pour quelque chose","Text2": "to fall in love with something, to be really taken with something","Audio1": "https://voix.larousse.fr/francais/230144fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172184ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "voici nos coups de cœur dans la collection de printemps","Text2": "here are our favourite spring outfits","Audio1": "https://voix.larousse.fr/francais/230145fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172185ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "des prix coup de cœur","Text2": "special offers","Audio1": "https://voix.larousse.fr/francais/230146fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172186ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650548,"Header": {"Text": "coup de coude","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23704.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "donner un coup de coude à quelqu'un","Text2": "","Audio1": "https://voix.larousse.fr/francais/212918fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "to nudge somebody","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172187ang2.mp3","RedBrac": "[en signe]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "to dig one's elbow into somebody","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172188ang2.mp3","RedBrac": "[agressivement]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]}  ]},{"Code": 650549,"Header": {"Text": "coup d'éclat","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/2394fra2.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "feat","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "faire un coup d'éclat","Text2": "to pull off a coup","Audio1": "https://voix.larousse.fr/francais/230147fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/69199ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650550,"Header": {"Text": "coup d'État","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/2395fra2.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "coup (d'état)","RedBrac": "[putsch]","RedCaps": "","RedMeta": ""}  ],"Phrases": null}  ]}  ]},{"Code": 650551,"Header": {"Text": "coup de feu","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23705.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "shot","RedBrac": "[tir]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "tirer un coup de feu","Text2": "to fire a shot, to shoot","Audio1": "https://voix.larousse.fr/francais/103280fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172189ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "on a entendu des coups de feu","Text2": "we heard shots being fired ou gunshots","Audio1": "https://voix.larousse.fr/francais/230148fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172190ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "(figuré)"}  ],"Phrases": [{"Text1": "c'est le coup de feu","Text2": "there's a sudden rush on","Audio1": "https://voix.larousse.fr/francais/230149fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172191ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 153992,"Header": {"Text": "coup de fil","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23706.mp3","Type": ""  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "coup de téléphone","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": null}  ]}  ]},{"Code": 650552,"Header": {"Text": "coup de filet","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23707.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "draught, haul","RedBrac": "[poissons]","RedCaps": "","RedMeta": ""},{"Text": "haul","RedBrac": "[suspects]","RedCaps": "","RedMeta": ""}  ],"Phrases": null}  ]}  ]},{"Code": 650553,"Header": {"Text": "coup de foudre","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23708.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "flash of lightning","RedBrac": "","RedCaps": "MÉTÉOROLOGIE","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": "love at first sight","RedBrac": "","RedCaps": "","RedMeta": "(figuré)"}  ],"Phrases": [{"Text1": "ça a été le coup de foudre","Text2": "it was love at first sight","Audio1": "https://voix.larousse.fr/francais/230150fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/14622ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650554,"Header": {"Text": "coup de fouet","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23709.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "donner un coup de fouet à quelqu'un","Text2": "","Audio1": "https://voix.larousse.fr/francais/230151fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "to lash ou to whip somebody","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172193ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(sens propre)","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "to give somebody a boost","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/51017ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": false,"Subphrases": null}  ]}  ]}  ]}  ]},{"Code": 650555,"Header": {"Text": "coup fourré","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/2380fra2.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "low trick","RedBrac": "","RedCaps": "","RedMeta": "(figuré)"}  ],"Phrases": null}  ]}  ]},{"Code": 154041,"Header": {"Text": "coup franc","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/2363fra2.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "free kick","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": null}  ]}  ]},{"Code": 650556,"Header": {"Text": "coup de fusil","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23710.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "shot","RedBrac": "[acte]","RedCaps": "","RedMeta": ""},{"Text": "shot, gunshot","RedBrac": "[bruit]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "on entendait des coups de fusil","Text2": "you could hear shooting ou shots being fired","Audio1": "https://voix.larousse.fr/francais/230152fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172195ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "recevoir un coup de fusil","Text2": "to get shot","Audio1": "https://voix.larousse.fr/francais/230153fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172196ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "(figuré)"}  ],"Phrases": [{"Text1": "on y mange bien, mais après c'est le coup de fusil !","Text2": "it's a good restaurant, but the bill is a bit of a shock !","Audio1": "https://voix.larousse.fr/francais/230154fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172197ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650557,"Header": {"Text": "coup de grâce","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23711.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "coup de grâce, deathblow","RedBrac": "","RedCaps": "","RedMeta": "(sens propre \u0026 figuré)"}  ],"Phrases": null}  ]}  ]},{"Code": 650558,"Header": {"Text": "coup du lapin","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/216181fra2.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "rabbit punch","RedBrac": "[coup]","RedCaps": "","RedMeta": ""},{"Text": "whiplash","RedBrac": "[dans un accident de voiture]","RedCaps": "","RedMeta": ""}  ],"Phrases": null}  ]}  ]},{"Code": 650559,"Header": {"Text": "coup de main","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23712.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "smash-and-grab (attack)","RedBrac": "[raid]","RedCaps": "","RedMeta": ""},{"Text": "coup de main","RedBrac": "","RedCaps": "MILITAIRE","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": "","RedBrac": "[aide]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "donner un coup de main à quelqu'un","Text2": "to give ou to lend somebody a hand","Audio1": "https://voix.larousse.fr/francais/2385fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172201ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "","RedBrac": "[savoir-faire]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "avoir le coup de main","Text2": "to have the knack ou the touch","Audio1": "https://voix.larousse.fr/francais/230155fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172202ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650560,"Header": {"Text": "coup d'œil","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/230156fra2.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "look, glance","RedBrac": "[regard]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "elle s'en rendit compte au premier coup d'œil","Text2": "she noticed straight away ou immediately ou at a glance","Audio1": "https://voix.larousse.fr/francais/230157fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172204ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "donner ou jeter un petit coup d'œil à","Text2": "to have a quick look ou glance at","Audio1": "https://voix.larousse.fr/francais/230158fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172205ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "d'un coup d'œil, il embrassa le tableau","Text2": "he took in the situation at a glance","Audio1": "https://voix.larousse.fr/francais/230159fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172206ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "avoir le coup d'œil","Text2": "to have a good eye","Audio1": "https://voix.larousse.fr/francais/93554fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172207ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "valoir le coup d'œil","Text2": "to be (well) worth seeing","Audio1": "https://voix.larousse.fr/francais/230160fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172208ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}  ]},{"Meanings": [{"Text": "view","RedBrac": "[panorama]","RedCaps": "","RedMeta": ""}  ],"Phrases": null}  ]}  ]},{"Code": 650561,"Header": {"Text": "coup de pied","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23713.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "kick","RedBrac": "[d'une personne, d'un cheval]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "le coup de pied de l'âne","Text2": "the parting shot","Audio1": "https://voix.larousse.fr/francais/230161fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172209ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": false,"Subphrases": null},{"Text1": "donner un coup de pied à quelqu'un/dans quelque chose","Text2": "to kick somebody/something","Audio1": "https://voix.larousse.fr/francais/230162fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172210ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650562,"Header": {"Text": "coup de poing","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23714.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "punch","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "donner un coup de poing à quelqu'un","Text2": "to give somebody a punch, to punch somebody","Audio1": "https://voix.larousse.fr/francais/230163fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172211ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "faire le coup de poing","Text2": "to brawl, to fight","Audio1": "https://voix.larousse.fr/francais/230164fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172212ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 910190,"Header": {"Text": "coup de poing","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23714.mp3","Type": "adjectif invariable"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "‘opération coup de poing’","Text2": "‘prices slashed’","Audio1": "https://voix.larousse.fr/francais/230165fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172213ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650563,"Header": {"Text": "coup de poker","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23715.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": " (bit of a) gamble","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "on peut tenter la chose, mais c'est un coup de poker","Text2": "we can try it but it's a bit risky","Audio1": "https://voix.larousse.fr/francais/230166fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172215ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650564,"Header": {"Text": "coup de pompe","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23716.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "sudden feeling of exhaustion","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "j'ai un coup de pompe","Text2": "I suddenly feel completely shattered ou beat","Audio1": "https://voix.larousse.fr/francais/230167fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172217ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650565,"Header": {"Text": "coup de pouce","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23717.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "bit of help","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "donner un coup de pouce à quelqu'un","Text2": "to pull (a few) strings for somebody","Audio1": "https://voix.larousse.fr/francais/230168fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172219ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "donner un coup de pouce à quelque chose","Text2": "to give something a bit of a boost","Audio1": "https://voix.larousse.fr/francais/230169fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172220ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650566,"Header": {"Text": "coup de sang","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23718.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "stroke","RedBrac": "","RedCaps": "MÉDECINE","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": "angry outburst","RedBrac": "","RedCaps": "","RedMeta": "(figuré)"}  ],"Phrases": [{"Text1": "elle a eu un coup de sang","Text2": "she exploded (with rage)","Audio1": "https://voix.larousse.fr/francais/230170fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172222ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650567,"Header": {"Text": "coup de soleil","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23719.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "sunburn","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "prendre ou attraper un coup de soleil","Text2": "to get sunburnt","Audio1": "https://voix.larousse.fr/francais/230171fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172223ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650568,"Header": {"Text": "coup du sort","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/230172fra2.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "stroke of luck","RedBrac": "[favorable]","RedCaps": "","RedMeta": ""},{"Text": "stroke of bad luck","RedBrac": "[défavorable]","RedCaps": "","RedMeta": ""}  ],"Phrases": null}  ]}  ]},{"Code": 650569,"Header": {"Text": "coup de téléphone","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23720.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": " (phone) call","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "donner ou passer un coup de téléphone","Text2": "to make a call","Audio1": "https://voix.larousse.fr/francais/2397fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/65010ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "donner ou passer un coup de téléphone à quelqu'un","Text2": "to phone ou to call ou to ringsomebody","Audio1": "https://voix.larousse.fr/francais/230173fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172225ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "recevoir un coup de téléphone","Text2": "to receive ou to get a phone call","Audio1": "https://voix.larousse.fr/francais/230174fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172226ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650570,"Header": {"Text": "coup de tête","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23721.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "head butt","RedBrac": "[dans une bagarre]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "donner un coup de tête à quelqu'un","Text2": "to head-butt somebody","Audio1": "https://voix.larousse.fr/francais/230175fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172228ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "header","RedBrac": "","RedCaps": "SPORT","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": " (sudden) impulse","RedBrac": "","RedCaps": "","RedMeta": "(figuré)"}  ],"Phrases": [{"Text1": "sur un coup de tête","Text2": "on (a sudden) impulse","Audio1": "https://voix.larousse.fr/francais/170584fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172230ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650571,"Header": {"Text": "coup de théâtre","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23722.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "coup de théâtre, sudden twist in the action","RedBrac": "","RedCaps": "THÉÂTRE","RedMeta": ""},{"Text": "sudden turn of events","RedBrac": "","RedCaps": "","RedMeta": "(figuré)"}  ],"Phrases": [{"Text1": "et alors, coup de théâtre, on lui demande de démissionner","Text2": "and then, out of the blue, he was asked to resign","Audio1": "https://voix.larousse.fr/francais/230176fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172233ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650572,"Header": {"Text": "coup de torchon","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23723.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "fist-fight","RedBrac": "[bagarre]","RedCaps": "","RedMeta": ""},{"Text": "clear-out cleanup","RedBrac": "[nettoyage]","RedCaps": "","RedMeta": ""}  ],"Phrases": null}  ]}  ]},{"Code": 650573,"Header": {"Text": "coup de vent","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23724.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "gust (of wind)","RedBrac": "[rafale]","RedCaps": "","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "(locution)"}  ],"Phrases": [{"Text1": "en coup de vent","Text2": "in a flash ou a whirl","Audio1": "https://voix.larousse.fr/francais/230177fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172237ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "entrer/partir en coup de vent","Text2": "to rush in/off","Audio1": "https://voix.larousse.fr/francais/230178fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172238ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "elle est passée par Lausanne en coup de vent","Text2": "she paid a flying visit to Lausanne","Audio1": "https://voix.larousse.fr/francais/230179fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172239ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "manger en coup de vent","Text2": "to grab something to eat","Audio1": "https://voix.larousse.fr/francais/230180fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172240ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]}  ],"SeeAlso": ["https://larousse.fr/dictionnaires/francais-anglais/coupable/19683","https://larousse.fr/dictionnaires/francais-anglais/coupant/19688","https://larousse.fr/dictionnaires/francais-anglais/coup-de-poing/19690","https://larousse.fr/dictionnaires/francais-anglais/coupe/19691","https://larousse.fr/dictionnaires/francais-anglais/coupé/19693","https://larousse.fr/dictionnaires/francais-anglais/couloir/19664","https://larousse.fr/dictionnaires/francais-anglais/coulommiers/19671","https://larousse.fr/dictionnaires/francais-anglais/coulpe/19673","https://larousse.fr/dictionnaires/francais-anglais/coulure/19674","https://larousse.fr/dictionnaires/francais-anglais/country/19681"  ]}`
+		case "coup":       str = `{"PageID": 19682,"Words": [{"Code": 19682,"Header": {"Text": "coup","TextAlt": "","Phonetic": "[ku]","Audio": "https://voix.larousse.fr/francais/03280.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "[HEURT, DÉFLAGRATION]","Items": [{"Meanings": [{"Text": "blow, knock","RedBrac": "[généralement]","RedCaps": "","RedMeta": ""},{"Text": "punch, blow","RedBrac": "[avec le poing]","RedCaps": "","RedMeta": ""},{"Text": "kick","RedBrac": "[avec le pied]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "un coup violent","Text2": "a hard knock","Audio1": "https://voix.larousse.fr/francais/188339fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172047ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "elle a failli mourir sous ses coups","Text2": "he thrashed her to within an inch of her life, he nearly battered her to death","Audio1": "https://voix.larousse.fr/francais/230024fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172048ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "frapper à coups redoublés","Text2": "to hit twice as hard","Audio1": "https://voix.larousse.fr/francais/17467fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172049ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "donner un petit coup à ou sur quelque chose","Text2": "to tap something lightly","Audio1": "https://voix.larousse.fr/francais/230025fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172050ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "donner un coup sec sur quelque chose","Text2": "to give something a (hard ou smart) tap","Audio1": "https://voix.larousse.fr/francais/230026fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172051ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "il frappait sur la porte à grands coups/à petits coups","Text2": "he banged on the door/he knocked gently at the door","Audio1": "https://voix.larousse.fr/francais/230027fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172052ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "donner un coup sur la table","Text2": "to bang one's fist (down) on the table","Audio1": "https://voix.larousse.fr/francais/230028fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172053ang2.mp3","RedBrac": "[avec le poing]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "en arriver ou en venir aux coups","Text2": "to come to blows","Audio1": "https://voix.larousse.fr/francais/230029fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/16856ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "j'ai pris un coup sur la tête","Text2": "I got a knock ou a bang on the head","Audio1": "https://voix.larousse.fr/francais/230030fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172054ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "prendre des coups","Text2": "to get knocked about","Audio1": "https://voix.larousse.fr/francais/230031fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172055ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "recevoir un coup","Text2": "to get hit","Audio1": "https://voix.larousse.fr/francais/230032fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172056ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "rendre coup pour coup","Text2": "to hit back, to give as good as one gets","Audio1": "https://voix.larousse.fr/francais/150005fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172057ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(sens propre \u0026 figuré)","IsBlue": false,"Subphrases": null},{"Text1": "coups et blessures","Text2": "grievous bodily harm","Audio1": "https://voix.larousse.fr/francais/119088fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/3624ang2.mp3","RedBrac": "","RedCaps": "DROIT","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "porter un coup à quelqu'un","Text2": "to deal somebody a blow","Audio1": "https://voix.larousse.fr/francais/230033fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/70754ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(sens propre \u0026 figuré)","IsBlue": true,"Subphrases": null},{"Text1": "les grandes surfaces ont porté un (rude) coup au petit commerce","Text2": "small traders have been dealt a (severe) blow by large retail chains","Audio1": "https://voix.larousse.fr/francais/230034fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172058ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": true,"Subphrases": null},{"Text1": "le coup a porté","Text2": "the blow struck home","Audio1": "https://voix.larousse.fr/francais/230035fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172059ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(sens propre \u0026 figuré)","IsBlue": true,"Subphrases": null}  ]},{"Meanings": [{"Text": "blow, shock","RedBrac": "[attaque, choc]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "ça m'a fait un coup","Text2": "","Audio1": "https://voix.larousse.fr/francais/230036fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "it gave me a shock","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172061ang2.mp3","RedBrac": "[émotion]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "it was a blow","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172062ang2.mp3","RedBrac": "[déception]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Text1": "sale coup (pour la fanfare) !","Text2": "that's a bit of a blow ou downer !","Audio1": "https://voix.larousse.fr/francais/230037fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172063ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(familier)","IsBlue": true,"Subphrases": null},{"Text1": "trois échecs d'affilée, son moral en a pris un coup","Text2": "with three successive failures, her morale has taken a bit of a bashing","Audio1": "https://voix.larousse.fr/francais/230038fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172064ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(familier)","IsBlue": true,"Subphrases": null},{"Text1": "avec le krack boursier, l'économie en a pris un coup","Text2": "the economy has suffered a great deal from the crash","Audio1": "https://voix.larousse.fr/francais/230039fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172065ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "j'ai trop de travail, je ne sais pas si je tiendrai le coup","Text2": "I've got too much work, I don't know if I'll be able to cope","Audio1": "https://voix.larousse.fr/francais/230040fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172066ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}  ]},{"Meanings": [{"Text": "punch, blow","RedBrac": "[en boxe]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "coup bas","Text2": "","Audio1": "https://voix.larousse.fr/francais/2361fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "blow ou punch below the belt","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172067ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(sens propre)","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "blow below the belt","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172068ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": false,"Subphrases": null}  ]},{"Text1": "c'est un coup bas","Text2": "that's below the belt","Audio1": "https://voix.larousse.fr/francais/230041fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172069ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "tous les coups sont permis","Text2": " (there are) no holds barred","Audio1": "https://voix.larousse.fr/francais/155196fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172070ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(sens propre \u0026 figuré)","IsBlue": false,"Subphrases": null},{"Text1": "compter les coups","Text2": "to keep score","Audio1": "https://voix.larousse.fr/francais/230042fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/169178ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(sens propre \u0026 figuré)","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "shot, blast","RedBrac": "","RedCaps": "ARMEMENT","RedMeta": ""}  ],"Phrases": [{"Text1": "un coup de revolver","Text2": "a shot, a gunshot","Audio1": "https://voix.larousse.fr/francais/230043fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172072ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "le coup est parti","Text2": "","Audio1": "https://voix.larousse.fr/francais/230044fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "the gun went off","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172073ang2.mp3","RedBrac": "[revolver]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "the rifle went off","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172074ang2.mp3","RedBrac": "[fusil]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Text1": "tirer un coup de canon","Text2": "to fire ou to blast a cannon","Audio1": "https://voix.larousse.fr/francais/98322fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172075ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "(revolver à) six coups","Text2": "six-shot gun","Audio1": "https://voix.larousse.fr/francais/230045fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172076ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "faire coup double","Text2": "","Audio1": "https://voix.larousse.fr/francais/230046fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": [{"Text1": "","Text2": "to do a right and left","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172077ang2.mp3","RedBrac": "","RedCaps": "CHASSE","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "","Text2": "to kill two birds with one stone","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/15181ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": true,"Subphrases": null}  ]}  ]},{"Meanings": [{"Text": "knock","RedBrac": "[bruit - généralement]","RedCaps": "","RedMeta": ""},{"Text": "rap","RedBrac": "[ - sec]","RedCaps": "","RedMeta": ""},{"Text": "snap","RedBrac": "[craquement]","RedCaps": "","RedMeta": ""},{"Text": "stroke","RedBrac": "[heure sonnée]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "des coups au carreau","Text2": "knocking ou knocks on the window","Audio1": "https://voix.larousse.fr/francais/230047fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172078ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "un coup de gong","Text2": "a bang on a gong","Audio1": "https://voix.larousse.fr/francais/230048fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172079ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "","RedBrac": "[éjaculation]","RedCaps": "","RedMeta": "(vulgaire)"}  ],"Phrases": [{"Text1": "tirer un ou son coup","Text2": "to shoot one's load","Audio1": "https://voix.larousse.fr/francais/230049fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/91679ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]},{"Title": "[GESTE, ACTION]","Items": [{"Meanings": [{"Text": "","RedBrac": "[mouvement d'une partie du corps]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "un coup de corne","Text2": "a butt","Audio1": "https://voix.larousse.fr/francais/230050fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172080ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "un coup de langue","Text2": "a lick","Audio1": "https://voix.larousse.fr/francais/230051fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172081ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "elle nettoyait ses chatons à (grands) coups de langue","Text2": "she was licking the kittens clean","Audio1": "https://voix.larousse.fr/francais/230052fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172082ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "un coup de bec","Text2": "","Audio1": "https://voix.larousse.fr/francais/230053fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": [{"Text1": "","Text2": "a peck","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172083ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(sens propre)","IsBlue": true,"Subphrases": null},{"Text1": "","Text2": "cutting remark","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/160151ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": true,"Subphrases": null}  ]},{"Text1": "un coup de dent","Text2": "","Audio1": "https://voix.larousse.fr/francais/230054fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": [{"Text1": "","Text2": "a bite","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172084ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(sens propre)","IsBlue": true,"Subphrases": null},{"Text1": "","Text2": "cutting remark","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/160151ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": true,"Subphrases": null}  ]},{"Text1": "coup de griffe ou patte","Text2": "","Audio1": "https://voix.larousse.fr/francais/230055fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": [{"Text1": "","Text2": "swipe of the paw","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172085ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(sens propre)","IsBlue": true,"Subphrases": null},{"Text1": "","Text2": "cutting remark","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/160151ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": true,"Subphrases": null}  ]}  ]},{"Meanings": [{"Text": "","RedBrac": "[emploi d'un instrument]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "donner un (petit) coup de brosse/chiffon à quelque chose","Text2": "to give something a (quick) brush/wipe","Audio1": "https://voix.larousse.fr/francais/230056fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172086ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "je vais me donner un coup de peigne","Text2": "I'll just comb my hair ou give my hair a (quick) comb","Audio1": "https://voix.larousse.fr/francais/230057fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172087ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "je viens pour un coup de peigne","Text2": "I just want a quick comb through","Audio1": "https://voix.larousse.fr/francais/230058fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172088ang2.mp3","RedBrac": "[chez le coiffeur]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "passe un coup d'aspirateur au salon","Text2": "give the living room a quick vacuum","Audio1": "https://voix.larousse.fr/francais/230059fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172089ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "passe un coup d'éponge sur la table","Text2": "give the table a wipe (with the sponge)","Audio1": "https://voix.larousse.fr/francais/230060fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172090ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "un coup de marteau","Text2": "a blow with a hammer","Audio1": "https://voix.larousse.fr/francais/230061fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172091ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "il s'est donné un coup de marteau sur le doigt","Text2": "he hit his finger with a hammer","Audio1": "https://voix.larousse.fr/francais/230062fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172092ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "en deux coups de rame nous pouvons traverser la rivière","Text2": "we can cross the river in a couple of strokes","Audio1": "https://voix.larousse.fr/francais/230063fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172093ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "passe un coup dans la salle de bains","Text2": "give the bathroom a going-over","Audio1": "https://voix.larousse.fr/francais/230064fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172094ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(familier)","IsBlue": false,"Subphrases": null},{"Text1": "en donner ou ficher (familier) ou mettre (familier) un coup","Text2": "to get down to business","Audio1": "https://voix.larousse.fr/francais/230065fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/29214ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "il a fallu qu'ils en mettent un sacré coup","Text2": "they really had to pull out the stops","Audio1": "https://voix.larousse.fr/francais/230066fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172095ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}  ]},{"Meanings": [{"Text": "stroke","RedBrac": "[au golf, au billard]","RedCaps": "","RedMeta": ""},{"Text": "shot, stroke","RedBrac": "","RedCaps": "TENNIS","RedMeta": ""}  ],"Phrases": [{"Text1": "coup droit","Text2": " (forehand) drive","Audio1": "https://voix.larousse.fr/francais/212923fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172097ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "knack","RedBrac": "[savoir-faire]","RedCaps": "","RedMeta": "(familier)"}  ],"Phrases": [{"Text1": "ah, tu as le coup pour mettre la pagaille !","Text2": "you really have a gift ou a knack for creating havoc, don't you !","Audio1": "https://voix.larousse.fr/francais/230067fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172098ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "une fois que tu auras pris le coup, ça ira tout seul !","Text2": "you'll find it's very easy once you get used to it ou once you've got the knack !","Audio1": "https://voix.larousse.fr/francais/230068fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172099ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "MÉTÉOROLOGIE","RedMeta": ""}  ],"Phrases": [{"Text1": "coup de chaleur","Text2": "heatwave","Audio1": "https://voix.larousse.fr/francais/230069fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/142524ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "coup de mer","Text2": "heavy swell","Audio1": "https://voix.larousse.fr/francais/87491fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172100ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "coup de vent","Text2": "gust of wind","Audio1": "https://voix.larousse.fr/francais/212921fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/155843ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "wave","RedBrac": "[effet soudain]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "j'ai un coup de cafard","Text2": "I feel down all of a sudden","Audio1": "https://voix.larousse.fr/francais/230070fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172101ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "j'ai eu un coup de fatigue","Text2": "I suddenly felt tired, a wave of tiredness came over me","Audio1": "https://voix.larousse.fr/francais/230071fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172102ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "il a eu un coup de folie et a acheté une Rolls","Text2": "he went mad and bought himself a Rolls-Royce","Audio1": "https://voix.larousse.fr/francais/230072fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172103ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "avoir un coup de chaleur","Text2": "to feel the beginnings of sunstroke","Audio1": "https://voix.larousse.fr/francais/230073fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172104ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}  ]},{"Meanings": [{"Text": "drink","RedBrac": "[boisson]","RedCaps": "","RedMeta": "(familier)"}  ],"Phrases": [{"Text1": "j'ai le hoquet — bois un coup","Text2": "I've got (the) hiccups — drink something ou have a drink","Audio1": "https://voix.larousse.fr/francais/230074fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172105ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "tu me sers un coup (à boire) ?","Text2": "could you pour me a drink ?","Audio1": "https://voix.larousse.fr/francais/230075fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172106ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "boire un coup de trop","Text2": "to have one too many","Audio1": "https://voix.larousse.fr/francais/60547fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/98325ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "un coup de rouge","Text2": "a glass of red wine","Audio1": "https://voix.larousse.fr/francais/230076fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172107ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "throw","RedBrac": "[lancer]","RedCaps": "","RedMeta": ""},{"Text": "throw (of the dice)","RedBrac": "[aux dés]","RedCaps": "","RedMeta": ""},{"Text": "move","RedBrac": "[action]","RedCaps": "JEUX","RedMeta": ""},{"Text": "go","RedBrac": "","RedCaps": "CARTES","RedMeta": ""}  ],"Phrases": [{"Text1": "elle a renversé toutes les boîtes de conserve en un seul coup","Text2": "she knocked down all the cans in one throw","Audio1": "https://voix.larousse.fr/francais/230077fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172108ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "c'est un coup pour rien","Text2": "","Audio1": "https://voix.larousse.fr/francais/230078fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": [{"Text1": "","Text2": "it's a trial run","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172110ang2.mp3","RedBrac": "[essai]","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "","Text2": "it's a failure","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172111ang2.mp3","RedBrac": "[échec]","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}  ]}  ]}  ]},{"Title": "[ACTE OU SITUATION EXCEPTIONNELS]","Items": [{"Meanings": [{"Text": "trick","RedBrac": "[mauvais tour]","RedCaps": "","RedMeta": "(familier)"}  ],"Phrases": [{"Text1": "il prépare un coup","Text2": "he's up to something","Audio1": "https://voix.larousse.fr/francais/230079fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172112ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "(faire) un mauvais ou sale coup (à quelqu'un)","Text2": " (to play) a dirty trick (on somebody)","Audio1": "https://voix.larousse.fr/francais/230080fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172113ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "je parie que c'est un coup de Julie !","Text2": "I bet Julie's behind this !","Audio1": "https://voix.larousse.fr/francais/230081fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172114ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "coup en traître","Text2": "stab in the back","Audio1": "https://voix.larousse.fr/francais/230082fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172115ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "monter un coup contre quelqu'un","Text2": "to set somebody up, to frame somebody","Audio1": "https://voix.larousse.fr/francais/230083fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172116ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "il nous a encore fait le coup","Text2": "he's pulled the same (old) trick on us again","Audio1": "https://voix.larousse.fr/francais/230084fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172117ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "il a essayé de me faire le coup de la panne","Text2": "he tried to pull the old running-out-of-petrol trick on me","Audio1": "https://voix.larousse.fr/francais/230086fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172118ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "ne me fais pas le coup de ne pas venir !","Text2": "now don't stand me up, will you !","Audio1": "https://voix.larousse.fr/francais/230087fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172119ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "coup monté","Text2": "put-up job, frame-up","Audio1": "https://voix.larousse.fr/francais/212924fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172120ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "il fait toujours ses coups en douce","Text2": "he's always going behind people's backs","Audio1": "https://voix.larousse.fr/francais/230089fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172121ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}  ]},{"Meanings": [{"Text": "job","RedBrac": "[vol, escroquerie]","RedCaps": "","RedMeta": "(très familier, argot milieu)"}  ],"Phrases": null},{"Meanings": [{"Text": "","RedBrac": "[affaire]","RedCaps": "","RedMeta": "(familier)"},{"Text": "","RedBrac": "[personne - sexuellement]","RedCaps": "","RedMeta": "(vulgaire)"}  ],"Phrases": [{"Text1": "je suis sur un coup","Text2": "I'm onto something","Audio1": "https://voix.larousse.fr/francais/230090fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172122ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "je veux l'acheter mais on est plusieurs sur le coup","Text2": "I want to buy it but there are several people interested","Audio1": "https://voix.larousse.fr/francais/230091fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172123ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "expliquer le coup à quelqu'un","Text2": "to explain the situation ou set-up to somebody","Audio1": "https://voix.larousse.fr/francais/230092fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172124ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "être dans tous les coups","Text2": "to have a finger in every pie","Audio1": "https://voix.larousse.fr/francais/230093fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/78035ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "rattraper le coup","Text2": "to sort things out","Audio1": "https://voix.larousse.fr/francais/230094fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172125ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "il a manqué ou raté son coup","Text2": "he didn't pull it off","Audio1": "https://voix.larousse.fr/francais/230095fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172126ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "elle a réussi son coup","Text2": "she pulled it off","Audio1": "https://voix.larousse.fr/francais/230096fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/133652ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "c'est un coup à avoir un accident, ça !","Text2": "that's the sort of thing that causes accidents !","Audio1": "https://voix.larousse.fr/francais/230097fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172127ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "combien crois-tu que ça va coûter ? — oh, c'est un coup de 3 000 euros","Text2": "how much do you think it will cost ? — oh, about 3,000 euros","Audio1": "https://voix.larousse.fr/francais/230098fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172128ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "c'est un bon coup","Text2": "he/she's a good lay","Audio1": "https://voix.larousse.fr/francais/161019fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172129ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "coup","RedBrac": "[action remarquable, risquée]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "faire un beau ou joli coup","Text2": "to pull a (real) coup","Audio1": "https://voix.larousse.fr/francais/230099fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172130ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "quand il s'agit d'un gros coup, elle met la main à la pâte","Text2": "when it's something really important, she lends a hand","Audio1": "https://voix.larousse.fr/francais/230100fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172131ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "tenter le coup","Text2": "to have a go, to give it a try","Audio1": "https://voix.larousse.fr/francais/2392fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172132ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "c'est un coup à faire ou tenter","Text2": "it's worth trying ou a try","Audio1": "https://voix.larousse.fr/francais/230101fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172133ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "","RedBrac": "[circonstance marquante]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "marquer le coup","Text2": "to mark the occasion","Audio1": "https://voix.larousse.fr/francais/2390fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172134ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "un coup de chance ou de pot (familier) ou de bol (familier)","Text2": "a stroke of luck, a lucky break","Audio1": "https://voix.larousse.fr/francais/230102fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172135ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]},{"Title": "[fois]","Items": [{"Meanings": [{"Text": "time, go","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "du premier coup","Text2": "first time, at the first attempt","Audio1": "https://voix.larousse.fr/francais/2400fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172137ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "au prochain coup, tu vas y arriver","Text2": "you'll do it next time ou at your next go","Audio1": "https://voix.larousse.fr/francais/230103fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172138ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "essaie encore un coup","Text2": "have another go","Audio1": "https://voix.larousse.fr/francais/230104fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172139ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "ce coup-ci, on s'en va","Text2": "this time, we're off","Audio1": "https://voix.larousse.fr/francais/230105fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172140ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "pour un coup","Text2": "just for (this) once","Audio1": "https://voix.larousse.fr/francais/230106fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172141ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(familier)","IsBlue": false,"Subphrases": null},{"Text1": "c'est ça, pleure un bon coup","Text2": "that's it, have a good cry","Audio1": "https://voix.larousse.fr/francais/230108fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172142ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(familier)","IsBlue": false,"Subphrases": null},{"Text1": "vous devriez vous expliquer un bon coup !","Text2": "you should have it out once and for all !","Audio1": "https://voix.larousse.fr/francais/230109fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172143ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "souffle un grand coup !","Text2": "blow hard !","Audio1": "https://voix.larousse.fr/francais/230110fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172144ang2.mp3","RedBrac": "[en se mouchant, sur des bougies]","RedCaps": "","RedMeta": "(familier)","IsBlue": false,"Subphrases": null},{"Text1": "respire un grand coup","Text2": "take a deep breath","Audio1": "https://voix.larousse.fr/francais/230111fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172145ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 19682,"Header": {"Text": "à coups de","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/230112fra2.mp3","Type": "locution prépositionnelle"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "démoli à coups de marteau","Text2": "smashed to pieces with a hammer","Audio1": "https://voix.larousse.fr/francais/230113fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172146ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "la productivité a été augmentée à coups de primes spéciales","Text2": "productivity was increased through ou by dint of special bonuses","Audio1": "https://voix.larousse.fr/francais/230114fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172147ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 153981,"Header": {"Text": "à coup sûr","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23691.mp3","Type": "locution adverbiale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "undoubtedly, certainly, for sure","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "elle ne s'engage qu'à coup sûr","Text2": "she only commits herself when she's certain of the outcome","Audio1": "https://voix.larousse.fr/francais/230115fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172149ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 153983,"Header": {"Text": "après coup","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/8346fra2.mp3","Type": "locution adverbiale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "afterwards, later on","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "son attitude, après coup, s'expliquait bien","Text2": "it was easy to explain her attitude afterwards ou in retrospect","Audio1": "https://voix.larousse.fr/francais/230116fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172151ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650541,"Header": {"Text": "à tous les coups","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23692.mp3","Type": "locution adverbiale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "every time","RedBrac": "[chaque fois]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "ça marche à tous les coups","Text2": "it never fails","Audio1": "https://voix.larousse.fr/francais/230117fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/76652ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "","RedBrac": "[sans aucun doute]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "à tous les coups, il a oublié","Text2": "he's bound to have forgotten","Audio1": "https://voix.larousse.fr/francais/230118fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172152ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 153984,"Header": {"Text": "au coup par coup","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23693.mp3","Type": "locution adverbiale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "bit by bit","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "négocier au coup par coup","Text2": "to have piecemeal negotiations","Audio1": "https://voix.larousse.fr/francais/230119fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172153ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 153997,"Header": {"Text": "coup sur coup","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23694.mp3","Type": "locution adverbiale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "one after the other, in quick succession","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": null}  ]}  ]},{"Code": 650542,"Header": {"Text": "dans le coup","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23695.mp3","Type": "locution adjectivale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "elle est dans le coup","Text2": "","Audio1": "https://voix.larousse.fr/francais/230120fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "she's in on it ou involved in it","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172155ang2.mp3","RedBrac": "[complice]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "she knows all about it","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172156ang2.mp3","RedBrac": "[au courant]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "she's hip ou with it","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172157ang2.mp3","RedBrac": "[à la page]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Text1": "moi, je ne suis plus dans le coup","Text2": "","Audio1": "https://voix.larousse.fr/francais/230121fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "count me out ou leave me out of it","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172158ang2.mp3","RedBrac": "[dans l'affaire]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "I'm a bit out of touch ou out of it","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172159ang2.mp3","RedBrac": "[au courant]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]}  ]},{"Code": 910189,"Header": {"Text": "dans le coup","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23695.mp3","Type": "locution adverbiale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "mettre quelqu'un dans le coup","Text2": "to let somebody in on the act","Audio1": "https://voix.larousse.fr/francais/230122fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172160ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 154001,"Header": {"Text": "du coup","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/2399fra2.mp3","Type": "locution adverbiale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "so, as a result","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "elle ne pouvait pas venir, du coup j'ai reporté le dîner","Text2": "as she couldn't come, I put the dinner off, she couldn't come so I put the dinner off","Audio1": "https://voix.larousse.fr/francais/230123fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172162ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 153999,"Header": {"Text": "d'un (seul) coup","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/230124fra2.mp3","Type": "locution adverbiale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "in one (go), all at once","RedBrac": "[en une seule fois]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "il a tout bu d'un coup","Text2": "he drank the whole lot in one go, he downed it in one","Audio1": "https://voix.larousse.fr/francais/230125fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172164ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "all of a sudden","RedBrac": "[soudainement]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "j'ai eu envie de pleurer/de le gifler, ça m'a pris d'un coup","Text2": "I got a sudden urge to cry/to slap him","Audio1": "https://voix.larousse.fr/francais/230126fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172165ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(familier)","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 154020,"Header": {"Text": "pour le coup","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23696.mp3","Type": "locution adverbiale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "pour le coup, je ne savais plus quoi faire","Text2": "at that point, I didn't know what to do next","Audio1": "https://voix.larousse.fr/francais/230127fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172166ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "j'ai aussi failli renverser le lait, c'est pour le coup qu'il aurait été en colère !","Text2": "I nearly spilt the milk as well, he really would have been furious then !","Audio1": "https://voix.larousse.fr/francais/230128fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172167ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(familier)","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 154026,"Header": {"Text": "sous le coup de","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23697.mp3","Type": "locution prépositionnelle"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "faire quelque chose sous le coup de la colère","Text2": "to do something in anger","Audio1": "https://voix.larousse.fr/francais/230129fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172168ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "sous le coup de la colère, on dit des choses qu'on regrette après","Text2": "you often say things in anger which you regret later","Audio1": "https://voix.larousse.fr/francais/230130fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172169ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "il est encore sous le coup de l'émotion","Text2": "he still hasn't got over the shock","Audio1": "https://voix.larousse.fr/francais/230131fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172170ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "tomber sous le coup de quelque chose","Text2": "to come within the scope of something","Audio1": "https://voix.larousse.fr/francais/230132fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172171ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "tomber sous le coup de la loi","Text2": "to be punishable by law","Audio1": "https://voix.larousse.fr/francais/212931fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172172ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 154027,"Header": {"Text": "sur le coup","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23698.mp3","Type": "locution adverbiale"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "instantly","RedBrac": "[mourir]","RedCaps": "","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": "straightaway, there and then","RedBrac": "[à ce moment-là]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "je n'ai pas compris sur le coup","Text2": "I didn't understand immediately ou straightaway","Audio1": "https://voix.larousse.fr/francais/230133fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172174ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 154028,"Header": {"Text": "sur le coup de","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23699.mp3","Type": "locution prépositionnelle"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "sur le coup de 6 h/de midi","Text2": "roundabout ou around 6 o'clock/midday","Audio1": "https://voix.larousse.fr/francais/230134fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172175ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650543,"Header": {"Text": "coup d'aile","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/230135fra2.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "tous les moineaux se sont envolés d'un coup d'aile","Text2": "all the sparrows took wing suddenly","Audio1": "https://voix.larousse.fr/francais/230136fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172176ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "Paris-Bruxelles en un coup d'aile","Text2": "Paris-Brussels in one short hop","Audio1": "https://voix.larousse.fr/francais/230137fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172177ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650544,"Header": {"Text": "coup de balai","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23700.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "donner un coup de balai","Text2": "to sweep up","Audio1": "https://voix.larousse.fr/francais/25778fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/138771ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "je vais donner un coup de balai dans la cuisine","Text2": "I'm going to sweep (out) the kitchen","Audio1": "https://voix.larousse.fr/francais/230138fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172178ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "la cuisine a besoin d'un bon coup de balai","Text2": "the kitchen needs a good sweep","Audio1": "https://voix.larousse.fr/francais/230139fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172179ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "le comité aurait besoin d'un bon coup de balai","Text2": "the committee could do with a shake-up","Audio1": "https://voix.larousse.fr/francais/230140fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172180ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650545,"Header": {"Text": "coup de barre","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23701.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "j'ai le coup de barre","Text2": "I feel tired all of a sudden","Audio1": "https://voix.larousse.fr/francais/230141fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172181ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650546,"Header": {"Text": "coup de chapeau","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23702.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "praise","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "donner un coup de chapeau à quelqu'un","Text2": "to praise somebody","Audio1": "https://voix.larousse.fr/francais/230142fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172182ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "son livre mérite un coup de chapeau","Text2": "his book deserves some recognition","Audio1": "https://voix.larousse.fr/francais/230143fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172183ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650547,"Header": {"Text": "coup de cœur","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23703.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": ""},{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "(comme adjectif invariable)"}  ],"Phrases": [{"Text1": "avoir un ou le coup de cœur pour quelque chose","Text2": "to fall in love with something, to be really taken with something","Audio1": "https://voix.larousse.fr/francais/230144fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172184ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "voici nos coups de cœur dans la collection de printemps","Text2": "here are our favourite spring outfits","Audio1": "https://voix.larousse.fr/francais/230145fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172185ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "des prix coup de cœur","Text2": "special offers","Audio1": "https://voix.larousse.fr/francais/230146fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172186ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650548,"Header": {"Text": "coup de coude","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23704.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "donner un coup de coude à quelqu'un","Text2": "","Audio1": "https://voix.larousse.fr/francais/212918fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "to nudge somebody","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172187ang2.mp3","RedBrac": "[en signe]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "to dig one's elbow into somebody","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172188ang2.mp3","RedBrac": "[agressivement]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]}  ]},{"Code": 650549,"Header": {"Text": "coup d'éclat","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/2394fra2.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "feat","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "faire un coup d'éclat","Text2": "to pull off a coup","Audio1": "https://voix.larousse.fr/francais/230147fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/69199ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650550,"Header": {"Text": "coup d'État","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/2395fra2.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "coup (d'état)","RedBrac": "[putsch]","RedCaps": "","RedMeta": ""}  ],"Phrases": null}  ]}  ]},{"Code": 650551,"Header": {"Text": "coup de feu","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23705.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "shot","RedBrac": "[tir]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "tirer un coup de feu","Text2": "to fire a shot, to shoot","Audio1": "https://voix.larousse.fr/francais/103280fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172189ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "on a entendu des coups de feu","Text2": "we heard shots being fired ou gunshots","Audio1": "https://voix.larousse.fr/francais/230148fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172190ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "(figuré)"}  ],"Phrases": [{"Text1": "c'est le coup de feu","Text2": "there's a sudden rush on","Audio1": "https://voix.larousse.fr/francais/230149fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172191ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 153992,"Header": {"Text": "coup de fil","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23706.mp3","Type": ""  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "","CrossRef": "coup de téléphone"}  ],"Phrases": null}  ]}  ]},{"Code": 650552,"Header": {"Text": "coup de filet","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23707.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "draught, haul","RedBrac": "[poissons]","RedCaps": "","RedMeta": ""},{"Text": "haul","RedBrac": "[suspects]","RedCaps": "","RedMeta": ""}  ],"Phrases": null}  ]}  ]},{"Code": 650553,"Header": {"Text": "coup de foudre","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23708.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "flash of lightning","RedBrac": "","RedCaps": "MÉTÉOROLOGIE","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": "love at first sight","RedBrac": "","RedCaps": "","RedMeta": "(figuré)"}  ],"Phrases": [{"Text1": "ça a été le coup de foudre","Text2": "it was love at first sight","Audio1": "https://voix.larousse.fr/francais/230150fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/14622ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650554,"Header": {"Text": "coup de fouet","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23709.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "donner un coup de fouet à quelqu'un","Text2": "","Audio1": "https://voix.larousse.fr/francais/230151fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "to lash ou to whip somebody","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172193ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(sens propre)","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "to give somebody a boost","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/51017ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": false,"Subphrases": null}  ]}  ]}  ]}  ]},{"Code": 650555,"Header": {"Text": "coup fourré","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/2380fra2.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "low trick","RedBrac": "","RedCaps": "","RedMeta": "(figuré)"}  ],"Phrases": null}  ]}  ]},{"Code": 154041,"Header": {"Text": "coup franc","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/2363fra2.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "free kick","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": null}  ]}  ]},{"Code": 650556,"Header": {"Text": "coup de fusil","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23710.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "shot","RedBrac": "[acte]","RedCaps": "","RedMeta": ""},{"Text": "shot, gunshot","RedBrac": "[bruit]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "on entendait des coups de fusil","Text2": "you could hear shooting ou shots being fired","Audio1": "https://voix.larousse.fr/francais/230152fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172195ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "recevoir un coup de fusil","Text2": "to get shot","Audio1": "https://voix.larousse.fr/francais/230153fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172196ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "(figuré)"}  ],"Phrases": [{"Text1": "on y mange bien, mais après c'est le coup de fusil !","Text2": "it's a good restaurant, but the bill is a bit of a shock !","Audio1": "https://voix.larousse.fr/francais/230154fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172197ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650557,"Header": {"Text": "coup de grâce","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23711.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "coup de grâce, deathblow","RedBrac": "","RedCaps": "","RedMeta": "(sens propre \u0026 figuré)"}  ],"Phrases": null}  ]}  ]},{"Code": 650558,"Header": {"Text": "coup du lapin","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/216181fra2.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "rabbit punch","RedBrac": "[coup]","RedCaps": "","RedMeta": ""},{"Text": "whiplash","RedBrac": "[dans un accident de voiture]","RedCaps": "","RedMeta": ""}  ],"Phrases": null}  ]}  ]},{"Code": 650559,"Header": {"Text": "coup de main","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23712.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "smash-and-grab (attack)","RedBrac": "[raid]","RedCaps": "","RedMeta": ""},{"Text": "coup de main","RedBrac": "","RedCaps": "MILITAIRE","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": "","RedBrac": "[aide]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "donner un coup de main à quelqu'un","Text2": "to give ou to lend somebody a hand","Audio1": "https://voix.larousse.fr/francais/2385fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172201ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "","RedBrac": "[savoir-faire]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "avoir le coup de main","Text2": "to have the knack ou the touch","Audio1": "https://voix.larousse.fr/francais/230155fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172202ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650560,"Header": {"Text": "coup d'œil","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/230156fra2.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "look, glance","RedBrac": "[regard]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "elle s'en rendit compte au premier coup d'œil","Text2": "she noticed straight away ou immediately ou at a glance","Audio1": "https://voix.larousse.fr/francais/230157fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172204ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "donner ou jeter un petit coup d'œil à","Text2": "to have a quick look ou glance at","Audio1": "https://voix.larousse.fr/francais/230158fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172205ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "d'un coup d'œil, il embrassa le tableau","Text2": "he took in the situation at a glance","Audio1": "https://voix.larousse.fr/francais/230159fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172206ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "avoir le coup d'œil","Text2": "to have a good eye","Audio1": "https://voix.larousse.fr/francais/93554fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172207ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "valoir le coup d'œil","Text2": "to be (well) worth seeing","Audio1": "https://voix.larousse.fr/francais/230160fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172208ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}  ]},{"Meanings": [{"Text": "view","RedBrac": "[panorama]","RedCaps": "","RedMeta": ""}  ],"Phrases": null}  ]}  ]},{"Code": 650561,"Header": {"Text": "coup de pied","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23713.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "kick","RedBrac": "[d'une personne, d'un cheval]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "le coup de pied de l'âne","Text2": "the parting shot","Audio1": "https://voix.larousse.fr/francais/230161fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172209ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": false,"Subphrases": null},{"Text1": "donner un coup de pied à quelqu'un/dans quelque chose","Text2": "to kick somebody/something","Audio1": "https://voix.larousse.fr/francais/230162fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172210ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650562,"Header": {"Text": "coup de poing","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23714.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "punch","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "donner un coup de poing à quelqu'un","Text2": "to give somebody a punch, to punch somebody","Audio1": "https://voix.larousse.fr/francais/230163fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172211ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "faire le coup de poing","Text2": "to brawl, to fight","Audio1": "https://voix.larousse.fr/francais/230164fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172212ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 910190,"Header": {"Text": "coup de poing","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23714.mp3","Type": "adjectif invariable"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "‘opération coup de poing’","Text2": "‘prices slashed’","Audio1": "https://voix.larousse.fr/francais/230165fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172213ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650563,"Header": {"Text": "coup de poker","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23715.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": " (bit of a) gamble","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "on peut tenter la chose, mais c'est un coup de poker","Text2": "we can try it but it's a bit risky","Audio1": "https://voix.larousse.fr/francais/230166fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172215ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650564,"Header": {"Text": "coup de pompe","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23716.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "sudden feeling of exhaustion","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "j'ai un coup de pompe","Text2": "I suddenly feel completely shattered ou beat","Audio1": "https://voix.larousse.fr/francais/230167fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172217ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650565,"Header": {"Text": "coup de pouce","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23717.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "bit of help","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "donner un coup de pouce à quelqu'un","Text2": "to pull (a few) strings for somebody","Audio1": "https://voix.larousse.fr/francais/230168fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172219ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "donner un coup de pouce à quelque chose","Text2": "to give something a bit of a boost","Audio1": "https://voix.larousse.fr/francais/230169fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172220ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650566,"Header": {"Text": "coup de sang","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23718.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "stroke","RedBrac": "","RedCaps": "MÉDECINE","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": "angry outburst","RedBrac": "","RedCaps": "","RedMeta": "(figuré)"}  ],"Phrases": [{"Text1": "elle a eu un coup de sang","Text2": "she exploded (with rage)","Audio1": "https://voix.larousse.fr/francais/230170fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172222ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650567,"Header": {"Text": "coup de soleil","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23719.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "sunburn","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "prendre ou attraper un coup de soleil","Text2": "to get sunburnt","Audio1": "https://voix.larousse.fr/francais/230171fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172223ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650568,"Header": {"Text": "coup du sort","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/230172fra2.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "stroke of luck","RedBrac": "[favorable]","RedCaps": "","RedMeta": ""},{"Text": "stroke of bad luck","RedBrac": "[défavorable]","RedCaps": "","RedMeta": ""}  ],"Phrases": null}  ]}  ]},{"Code": 650569,"Header": {"Text": "coup de téléphone","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23720.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": " (phone) call","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "donner ou passer un coup de téléphone","Text2": "to make a call","Audio1": "https://voix.larousse.fr/francais/2397fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/65010ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "donner ou passer un coup de téléphone à quelqu'un","Text2": "to phone ou to call ou to ringsomebody","Audio1": "https://voix.larousse.fr/francais/230173fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172225ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "recevoir un coup de téléphone","Text2": "to receive ou to get a phone call","Audio1": "https://voix.larousse.fr/francais/230174fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172226ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650570,"Header": {"Text": "coup de tête","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23721.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "head butt","RedBrac": "[dans une bagarre]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "donner un coup de tête à quelqu'un","Text2": "to head-butt somebody","Audio1": "https://voix.larousse.fr/francais/230175fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172228ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "header","RedBrac": "","RedCaps": "SPORT","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": " (sudden) impulse","RedBrac": "","RedCaps": "","RedMeta": "(figuré)"}  ],"Phrases": [{"Text1": "sur un coup de tête","Text2": "on (a sudden) impulse","Audio1": "https://voix.larousse.fr/francais/170584fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172230ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650571,"Header": {"Text": "coup de théâtre","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23722.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "coup de théâtre, sudden twist in the action","RedBrac": "","RedCaps": "THÉÂTRE","RedMeta": ""},{"Text": "sudden turn of events","RedBrac": "","RedCaps": "","RedMeta": "(figuré)"}  ],"Phrases": [{"Text1": "et alors, coup de théâtre, on lui demande de démissionner","Text2": "and then, out of the blue, he was asked to resign","Audio1": "https://voix.larousse.fr/francais/230176fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172233ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650572,"Header": {"Text": "coup de torchon","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23723.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "fist-fight","RedBrac": "[bagarre]","RedCaps": "","RedMeta": ""},{"Text": "clear-out cleanup","RedBrac": "[nettoyage]","RedCaps": "","RedMeta": ""}  ],"Phrases": null}  ]}  ]},{"Code": 650573,"Header": {"Text": "coup de vent","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/23724.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "gust (of wind)","RedBrac": "[rafale]","RedCaps": "","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "(locution)"}  ],"Phrases": [{"Text1": "en coup de vent","Text2": "in a flash ou a whirl","Audio1": "https://voix.larousse.fr/francais/230177fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172237ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "entrer/partir en coup de vent","Text2": "to rush in/off","Audio1": "https://voix.larousse.fr/francais/230178fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172238ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "elle est passée par Lausanne en coup de vent","Text2": "she paid a flying visit to Lausanne","Audio1": "https://voix.larousse.fr/francais/230179fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172239ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "manger en coup de vent","Text2": "to grab something to eat","Audio1": "https://voix.larousse.fr/francais/230180fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172240ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]}  ],"SeeAlso": ["https://larousse.fr/dictionnaires/francais-anglais/coupable/19683","https://larousse.fr/dictionnaires/francais-anglais/coupant/19688","https://larousse.fr/dictionnaires/francais-anglais/coup-de-poing/19690","https://larousse.fr/dictionnaires/francais-anglais/coupe/19691","https://larousse.fr/dictionnaires/francais-anglais/coupé/19693","https://larousse.fr/dictionnaires/francais-anglais/couloir/19664","https://larousse.fr/dictionnaires/francais-anglais/coulommiers/19671","https://larousse.fr/dictionnaires/francais-anglais/coulpe/19673","https://larousse.fr/dictionnaires/francais-anglais/coulure/19674","https://larousse.fr/dictionnaires/francais-anglais/country/19681"  ]}`
 		case "couper":     str = `{"PageID": 19720,"Words": [{"Code": 19720,"Header": {"Text": "couper","TextAlt": "","Phonetic": "[kupe]","Audio": "https://voix.larousse.fr/francais/03289.mp3","Type": "verbe transitif"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "to cut","RedBrac": "[entailler - légèrement]","RedCaps": "","RedMeta": ""},{"Text": "to slash","RedBrac": "[ - gravement]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "le vent lui coupait le visage","Text2": "the wind stung her face","Audio1": "https://voix.larousse.fr/francais/230201fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172273ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": false,"Subphrases": null},{"Text1": "couper le souffle ou la respiration à quelqu'un","Text2": "to take somebody's breath away","Audio1": "https://voix.larousse.fr/francais/230202fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172274ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "beau à couper le souffle","Text2": "breathtakingly beautiful","Audio1": "https://voix.larousse.fr/francais/230203fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172275ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "il y avait un brouillard à couper au couteau","Text2": "the fog was so thick you couldn't see your hand in front of your face","Audio1": "https://voix.larousse.fr/francais/230204fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172276ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "un accent à couper au couteau","Text2": "a very strong accent","Audio1": "https://voix.larousse.fr/francais/230205fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172277ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "un silence à couper au couteau","Text2": "a silence you could cut with a knife","Audio1": "https://voix.larousse.fr/francais/230206fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172278ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}  ]},{"Meanings": [{"Text": "to cut off","RedBrac": "[membre]","RedCaps": "","RedMeta": ""},{"Text": "to cut off, to chop (off)","RedBrac": "[tête]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "couper la tête ou le cou à un canard","Text2": "to chop a duck's head off","Audio1": "https://voix.larousse.fr/francais/230207fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172280ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "couper bras et jambes à quelqu'un","Text2": "to amaze somebody","Audio1": "https://voix.larousse.fr/francais/230208fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172281ang2.mp3","RedBrac": "[surprise]","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "ça lui a coupé les jambes","Text2": "that's really tired him out","Audio1": "https://voix.larousse.fr/francais/230209fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172282ang2.mp3","RedBrac": "[de fatigue]","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}  ]},{"Meanings": [{"Text": "to cut","RedBrac": "[mettre en morceaux - ficelle]","RedCaps": "","RedMeta": ""},{"Text": "to cut up","RedBrac": "[ - gâteau]","RedCaps": "","RedMeta": ""},{"Text": "to cut up, to slice (up)","RedBrac": "[ - saucisson]","RedCaps": "","RedMeta": ""},{"Text": "to chop (up)","RedBrac": "[ - bois]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "couper en tranches","Text2": "to cut up, to cut into slices, to slice","Audio1": "https://voix.larousse.fr/francais/230210fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172285ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "couper quelque chose en tranches fines/épaisses","Text2": "to slice something thinly/thickly, to cut something into thin/thick slices","Audio1": "https://voix.larousse.fr/francais/230211fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172286ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "elle se ferait couper en morceaux plutôt que de...","Text2": "she'd rather die than...","Audio1": "https://voix.larousse.fr/francais/230212fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172287ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "couper la poire en deux","Text2": "to meet half-way, to come to a compromise","Audio1": "https://voix.larousse.fr/francais/8615fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172288ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "couper les ponts avec quelqu'un","Text2": "to break all ties ou to break off relations with somebody","Audio1": "https://voix.larousse.fr/francais/9845fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172289ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "couper les cheveux en quatre","Text2": "to split hairs","Audio1": "https://voix.larousse.fr/francais/12016fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/3816ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}  ]},{"Meanings": [{"Text": "to cut","RedBrac": "[tailler - fleurs]","RedCaps": "","RedMeta": ""},{"Text": "to cut off","RedBrac": "[ - bordure]","RedCaps": "","RedMeta": ""},{"Text": "to cut ou to chop down to fell","RedBrac": "[ - arbre]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "couper les cheveux à quelqu'un","Text2": "to cut ou to trim somebody's hair","Audio1": "https://voix.larousse.fr/francais/152454fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172291ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "se faire couper les cheveux","Text2": "to have one's hair cut","Audio1": "https://voix.larousse.fr/francais/4824fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/4118ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "couper le mal à la racine","Text2": "to strike at the root of the evil","Audio1": "https://voix.larousse.fr/francais/230213fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172292ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}  ]},{"Meanings": [{"Text": "to cut out","RedBrac": "[robe]","RedCaps": "COUTURE","RedMeta": ""},{"Text": "to cut","RedBrac": "[tissu]","RedCaps": "","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": "to cut","RedBrac": "[écourter - film, texte]","RedCaps": "","RedMeta": ""},{"Text": "to cut (out), to edit out","RedBrac": "[ôter - remarque, séquence]","RedCaps": "","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": "to cut","RedBrac": "[arrêter - crédit]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "couper l'eau","Text2": "","Audio1": "https://voix.larousse.fr/francais/230214fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "to cut off the water","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172294ang2.mp3","RedBrac": "[par accident]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "to turn ou to switch off the water","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172295ang2.mp3","RedBrac": "[volontairement]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Text1": "son père va lui couper les vivres","Text2": "his father will stop supporting him ou will cut off his means of subsistence","Audio1": "https://voix.larousse.fr/francais/230215fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172296ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "to break off","RedBrac": "[interrompre - relations diplomatiques, conversation]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "couper la parole à quelqu'un","Text2": "to cut somebody short","Audio1": "https://voix.larousse.fr/francais/9032fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/70157ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "couper l'appétit à quelqu'un","Text2": "to ruin ou spoil somebody's appetite","Audio1": "https://voix.larousse.fr/francais/6989fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172297ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "couper quelqu'un","Text2": "to interrupt somebody","Audio1": "https://voix.larousse.fr/francais/230216fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/156036ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(familier)","IsBlue": false,"Subphrases": null},{"Text1": "je vais à la gym à midi, ça (me) coupe la journée","Text2": "I go to the gym at lunchtime, it helps to break the day up","Audio1": "https://voix.larousse.fr/francais/230217fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172298ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "couper la chique ou le sifflet à quelqu'un","Text2": "to shut somebody up","Audio1": "https://voix.larousse.fr/francais/230218fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/167152ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(familier)","IsBlue": true,"Subphrases": null},{"Text1": "couper ses effets à quelqu'un","Text2": "to take the wind out of somebody's sails","Audio1": "https://voix.larousse.fr/francais/230219fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/128880ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}  ]},{"Meanings": [{"Text": "to cut off","RedBrac": "[barrer - route]","RedCaps": "","RedMeta": ""},{"Text": "to block off to cut off","RedBrac": "[ - retraite]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "l'arbre nous coupait la route","Text2": "the tree blocked our path","Audio1": "https://voix.larousse.fr/francais/230220fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172300ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "to cut","RedBrac": "[diviser - surface]","RedCaps": "","RedMeta": ""},{"Text": "to cut, to intersect","RedBrac": "[ - ligne]","RedCaps": "","RedMeta": ""},{"Text": "to cross, to cut across","RedBrac": "[ - voie]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "la voiture nous a coupé la route","Text2": "the car cut across in front of us","Audio1": "https://voix.larousse.fr/francais/230221fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172303ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "depuis, la famille est coupée en deux","Text2": "since then, the family has been split in two","Audio1": "https://voix.larousse.fr/francais/230222fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172304ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(figuré)","IsBlue": false,"Subphrases": null},{"Text1": "couper quelqu'un de quelque chose","Text2": "to cut somebody off from something","Audio1": "https://voix.larousse.fr/francais/230223fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172305ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "je me sens coupé de tout","Text2": "I feel cut off from everything ou totally isolated","Audio1": "https://voix.larousse.fr/francais/230224fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172306ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "to add water to, to thin ou to water down","RedBrac": "[diluer - lait]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "coupé d'eau","Text2": "diluted, watered down","Audio1": "https://voix.larousse.fr/francais/230225fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172308ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "couper du vin","Text2": "","Audio1": "https://voix.larousse.fr/francais/230226fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "to water wine down","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172309ang2.mp3","RedBrac": "[à l'eau]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "to blend wine","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172310ang2.mp3","RedBrac": "[avec d'autres vins]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "CINÉMA","RedMeta": ""}  ],"Phrases": [{"Text1": "coupez !","Text2": "cut !","Audio1": "https://voix.larousse.fr/francais/230227fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172311ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "to cut off","RedBrac": "","RedCaps": "TÉLÉCOMMUNICATIONS","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": "to cut","RedBrac": "[partager]","RedCaps": "JEUX","RedMeta": ""},{"Text": "to trump","RedBrac": "[jouer l'atout]","RedCaps": "","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": "to slice","RedBrac": "[balle]","RedCaps": "SPORT","RedMeta": ""}  ],"Phrases": null}  ]}  ]},{"Code": 910193,"Header": {"Text": "couper","TextAlt": "","Phonetic": "[kupe]","Audio": "https://voix.larousse.fr/francais/03289.mp3","Type": "verbe intransitif"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "to cut, to be sharp","RedBrac": "[être tranchant]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "attention, ça coupe !","Text2": "careful, it's sharp !","Audio1": "https://voix.larousse.fr/francais/230228fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172314ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "","RedBrac": "[prendre un raccourci]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "couper à travers champs","Text2": "to cut across country ou the fields","Audio1": "https://voix.larousse.fr/francais/82611fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172315ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "couper par une petite route","Text2": "to cut through by a minor road","Audio1": "https://voix.larousse.fr/francais/230229fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172316ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "couper au plus court","Text2": "to take the quickest way","Audio1": "https://voix.larousse.fr/francais/230230fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172317ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]},{"Meanings": [{"Text": "to cut in","RedBrac": "[interrompre]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "faux, coupa-t-elle","Text2": "not true, she cut in","Audio1": "https://voix.larousse.fr/francais/230231fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172319ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 650595,"Header": {"Text": "couper à","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/212936fra2.mp3","Type": "verbe plus préposition"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "couper court à quelque chose","Text2": "to cut something short, to curtail something","Audio1": "https://voix.larousse.fr/francais/2406fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172320ang2.mp3","RedBrac": "[mettre fin à]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "couper à quelque chose","Text2": "to get out of something","Audio1": "https://voix.larousse.fr/francais/230232fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/29231ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "on n'y a pas coupé, à son sermon !","Text2": "sure enough we got a lecture from him !","Audio1": "https://voix.larousse.fr/francais/230234fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172321ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "tu dois y aller, tu ne peux pas y couper !","Text2": "you've got to go, there's no way you can get out of it !","Audio1": "https://voix.larousse.fr/francais/230235fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172322ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}  ]}  ]}  ]},{"Code": 19726,"Header": {"Text": "se couper","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/2408fra2.mp3","Type": "verbe pronominal (emploi réfléchi)"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "to cut oneself","RedBrac": "","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "se couper les ongles","Text2": "to cut ou to trim one's nails","Audio1": "https://voix.larousse.fr/francais/230236fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172323ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "se couper le ou au front","Text2": "to cut one's forehead","Audio1": "https://voix.larousse.fr/francais/230237fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172324ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "se couper les veines","Text2": "to slit ou to slash one's wrists","Audio1": "https://voix.larousse.fr/francais/230238fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/172325ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "se couper en quatre pour quelqu'un","Text2": "","Audio1": "https://voix.larousse.fr/francais/230239fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": [{"Text1": "","Text2": "to bend over backwards to help somebody","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172326ang2.mp3","RedBrac": "[ponctuellement]","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "","Text2": "to devote oneself utterly to somebody","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/172327ang2.mp3","RedBrac": "[continuellement]","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}  ]}  ]}  ]}  ]},{"Code": 910194,"Header": {"Text": "se couper","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/2408fra2.mp3","Type": "verbe pronominal intransitif"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "to cut across one another, to intersect","RedBrac": "[lignes, routes]","RedCaps": "","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": "to contradict oneself","RedBrac": "[se contredire]","RedCaps": "","RedMeta": "(familier)"}  ],"Phrases": null}  ]}  ]}  ],"SeeAlso": ["https://larousse.fr/dictionnaires/francais-anglais/couper-coller/650597","https://larousse.fr/dictionnaires/francais-anglais/couperet/19728","https://larousse.fr/dictionnaires/francais-anglais/couperose/19730","https://larousse.fr/dictionnaires/francais-anglais/couperosé/19731","https://larousse.fr/dictionnaires/francais-anglais/coupeur/19733","https://larousse.fr/dictionnaires/francais-anglais/coupe-gorge/19706","https://larousse.fr/dictionnaires/francais-anglais/coupe-légumes/19709","https://larousse.fr/dictionnaires/francais-anglais/coupelle/19711","https://larousse.fr/dictionnaires/francais-anglais/coupe-ongles/19716","https://larousse.fr/dictionnaires/francais-anglais/coupe-papier/19717"  ]}`
 		case "roquette":   str = `{"PageID": 69102,"Words": [{"Code": 69102,"Header": {"Text": "roquette","TextAlt": "","Phonetic": "[rɔkεt]","Audio": "https://voix.larousse.fr/francais/18526A.mp3","Type": "nom féminin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "rocket","RedBrac": "[projectile]","RedCaps": "","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": "rocket","RedBrac": "","RedCaps": "BOTANIQUE","RedMeta": ""}  ],"Phrases": null}  ]}  ]}  ],"SeeAlso": ["https://larousse.fr/dictionnaires/francais-anglais/ROR/888832","https://larousse.fr/dictionnaires/francais-anglais/rosace/69106","https://larousse.fr/dictionnaires/francais-anglais/rosacée/69108","https://larousse.fr/dictionnaires/francais-anglais/rosaire/69110","https://larousse.fr/dictionnaires/francais-anglais/rosâtre/69116","https://larousse.fr/dictionnaires/francais-anglais/röntgen/69092","https://larousse.fr/dictionnaires/francais-anglais/roque/69096","https://larousse.fr/dictionnaires/francais-anglais/roquefort/69097","https://larousse.fr/dictionnaires/francais-anglais/roquer/69098","https://larousse.fr/dictionnaires/francais-anglais/roquet/69099"  ]}`
 		case "rosacée":    str = `{"PageID": 69108,"Words": [{"Code": 69108,"Header": {"Text": "rosacée","TextAlt": "","Phonetic": "[rozase]","Audio": "https://voix.larousse.fr/francais/274232fra2.mp3","Type": "nom féminin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "rosaceous plant, rosacean","RedBrac": "","RedCaps": "BOTANIQUE","RedMeta": ""}  ],"Phrases": [{"Text1": "les rosacées","Text2": "the Rosaceae","Audio1": "https://voix.larousse.fr/francais/274233fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/239222ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","Subphrases": null,"IsBlue": false}  ]},{"Meanings": [{"Text": "rosacea","RedBrac": "","RedCaps": "MÉDECINE","RedMeta": ""}  ],"Phrases": null}  ]}  ]}  ],"SeeAlso": ["https://larousse.fr/dictionnaires/francais-anglais/rosaire/69110","https://larousse.fr/dictionnaires/francais-anglais/rosâtre/69116","https://larousse.fr/dictionnaires/francais-anglais/rosbif/69117","https://larousse.fr/dictionnaires/francais-anglais/rose/69119","https://larousse.fr/dictionnaires/francais-anglais/rosé/69122","https://larousse.fr/dictionnaires/francais-anglais/roquer/69098","https://larousse.fr/dictionnaires/francais-anglais/roquet/69099","https://larousse.fr/dictionnaires/francais-anglais/roquette/69102","https://larousse.fr/dictionnaires/francais-anglais/ROR/888832","https://larousse.fr/dictionnaires/francais-anglais/rosace/69106"  ]}`
 		case "vert":       str = `{"PageID": 80698,"Words": [{"Code": 80698,"Header": {"Text": "vert","TextAlt": "(f verte)","Phonetic": "[vεr, vεrt]","Audio": "https://voix.larousse.fr/francais/18901fra2.mp3","Type": "adjectif"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "green","RedBrac": "[couleur]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "vert de rage","Text2": "livid","Audio1": "https://voix.larousse.fr/francais/284046fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/34708ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","Subphrases": null,"IsBlue": false},{"Text1": "être vert de peur","Text2": "to be white with fear","Audio1": "https://voix.larousse.fr/francais/284047fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/254303ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","Subphrases": null,"IsBlue": false}  ]},{"Meanings": [{"Text": "tart, acid","RedBrac": "[vin]","RedCaps": "","RedMeta": ""},{"Text": "green, unripe","RedBrac": "[fruit]","RedCaps": "","RedMeta": ""},{"Text": "inexperienced","RedBrac": "[débutant, apprenti]","RedCaps": "","RedMeta": "(figuré)"}  ],"Phrases": [{"Text1": "ils sont trop verts","Text2": "it's a case of sour grapes","Audio1": "https://voix.larousse.fr/francais/284048fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/254306ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(allusion à La Fontaine)","Subphrases": null,"IsBlue": false}  ]},{"Meanings": [{"Text": "green","RedBrac": "[bois]","RedCaps": "","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": "","RedBrac": "[à préparer]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "cuir vert","Text2": "untanned leather","Audio1": "https://voix.larousse.fr/francais/284049fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/254307ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","Subphrases": null,"IsBlue": false}  ]},{"Meanings": [{"Text": "sprightly","RedBrac": "[vigoureux]","RedCaps": "","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": "green, agricultural, rural","RedBrac": "[agricole, rural]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "l'Europe verte","Text2": "farming within the EC","Audio1": "https://voix.larousse.fr/francais/284050fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/254309ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","Subphrases": null,"IsBlue": false},{"Text1": "la livre verte","Text2": "the green pound","Audio1": "https://voix.larousse.fr/francais/284051fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/254310ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","Subphrases": null,"IsBlue": false},{"Text1": "station verte","Text2": "rural tourist centre","Audio1": "https://voix.larousse.fr/francais/284052fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/254311ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","Subphrases": null,"IsBlue": false}  ]},{"Meanings": [{"Text": "green","RedBrac": "[écologiste]","RedCaps": "","RedMeta": ""}  ],"Phrases": null},{"Meanings": [{"Text": "risqué, raunchy","RedBrac": "[osé]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "en dire/en avoir entendu des vertes et des pas mûres","Text2": "to tell/to have heard some pretty raunchy jokes","Audio1": "https://voix.larousse.fr/francais/284053fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/254313ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","Subphrases": null,"IsBlue": true},{"Text1": "en avoir vu des vertes et des pas mûres","Text2": "to have been through a lot","Audio1": "https://voix.larousse.fr/francais/284054fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/254314ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","Subphrases": null,"IsBlue": true},{"Text1": "il lui en a fait voir des vertes et des pas mûres !","Text2": "he's really put her through it !","Audio1": "https://voix.larousse.fr/francais/284055fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/254315ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","Subphrases": null,"IsBlue": true}  ]},{"Meanings": [{"Text": "","RedBrac": "[violent]","RedCaps": "","RedMeta": "(avant le nom)"}  ],"Phrases": [{"Text1": "une verte semonce","Text2": "a good dressing-down","Audio1": "https://voix.larousse.fr/francais/284056fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/254316ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","Subphrases": null,"IsBlue": false}  ]}  ]}  ]},{"Code": 80699,"Header": {"Text": "vert","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/18901fra2.mp3","Type": "nom masculin"  },"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "green","RedBrac": "[couleur]","RedCaps": "","RedMeta": ""}  ],"Phrases": [{"Text1": "peint ou teint en vert","Text2": "painted ou tinted green","Audio1": "https://voix.larousse.fr/francais/284057fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/254317ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","Subphrases": null,"IsBlue": false},{"Text1": "vert bouteille","Text2": "bottle green","Audio1": "https://voix.larousse.fr/francais/48697fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/63559ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","Subphrases": null,"IsBlue": true},{"Text1": "vert d'eau","Text2": "sea green","Audio1": "https://voix.larousse.fr/francais/48694fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/110769ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","Subphrases": null,"IsBlue": true},{"Text1": "vert pomme","Text2": "apple green","Audio1": "https://voix.larousse.fr/francais/48696fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/254318ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","Subphrases": null,"IsBlue": true}  ]},{"Meanings": [{"Text": "green light","RedBrac": "","RedCaps": "TRANSPORTS","RedMeta": ""}  ],"Phrases": [{"Text1": "les voitures doivent passer au vert","Text2": "motorists must wait for the light to turn green","Audio1": "https://voix.larousse.fr/francais/284058fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/254319ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","Subphrases": null,"IsBlue": false},{"Text1": "le feu est passé au vert","Text2": "the lights have turned (to) green","Audio1": "https://voix.larousse.fr/francais/284059fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/254320ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","Subphrases": null,"IsBlue": false}  ]},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "(locution)"}  ],"Phrases": [{"Text1": "mettre un cheval au vert","Text2": "to turn a horse out to grass","Audio1": "https://voix.larousse.fr/francais/284060fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/254321ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","Subphrases": null,"IsBlue": false},{"Text1": "se mettre au vert","Text2": "to go to the countryside","Audio1": "https://voix.larousse.fr/francais/18907fra2.mp3","Audio2": "https://voix.larousse.fr/anglais/254322ang2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","Subphrases": null,"IsBlue": false}  ]}  ]}  ]},{"Code": 669673,"Header": {"Text": "Verts","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/francais/18908fra2.mp3","Type": "nom masculin pluriel"  },"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "les Verts","Text2": "","Audio1": "https://voix.larousse.fr/francais/18909fra2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","Subphrases": [{"Text1": "","Text2": "the Saint-Étienne football team","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/254323ang2.mp3","RedBrac": "","RedCaps": "SPORT","RedMeta": "","Subphrases": null,"IsBlue": false},{"Text1": "","Text2": "the Green Party","Audio1": "","Audio2": "https://voix.larousse.fr/anglais/3597ang2.mp3","RedBrac": "","RedCaps": "POLITIQUE","RedMeta": "","Subphrases": null,"IsBlue": false}  ],"IsBlue": false}  ]}  ]}  ]}  ],"SeeAlso": ["https://larousse.fr/dictionnaires/francais-anglais/vert-de-gris/80702","https://larousse.fr/dictionnaires/francais-anglais/vertébral/80705","https://larousse.fr/dictionnaires/francais-anglais/vertèbre/80706","https://larousse.fr/dictionnaires/francais-anglais/vertébré/80707","https://larousse.fr/dictionnaires/francais-anglais/vertement/80711","https://larousse.fr/dictionnaires/francais-anglais/versification/80688","https://larousse.fr/dictionnaires/francais-anglais/versifier/80689","https://larousse.fr/dictionnaires/francais-anglais/version/80691","https://larousse.fr/dictionnaires/francais-anglais/verso/80694","https://larousse.fr/dictionnaires/francais-anglais/verste/80696"  ]}`
 	
 		case "motivate":   str = `{"PageID": 596253,"Words": [{"Code": 596253,"Header": {"Text": "motivate","TextAlt": "","Phonetic": "[ˈməʊtɪveɪt]","Audio": "https://voix.larousse.fr/anglais/10581.mp3","Type": "transitive verb"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "motiver","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "what motivated you to change your mind ?","Text2": "qu'est-ce qui vous a poussé à changer d'avis ?","Audio1": "https://voix.larousse.fr/anglais/95405ang2.mp3","Audio2": "https://voix.larousse.fr/francais/167193fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]}],"SeeAlso": ["https://larousse.fr/dictionnaires/anglais-francais/motivated/596254","https://larousse.fr/dictionnaires/anglais-francais/motivating/596255","https://larousse.fr/dictionnaires/anglais-francais/motivation/596256","https://larousse.fr/dictionnaires/anglais-francais/motivational/596257","https://larousse.fr/dictionnaires/anglais-francais/motive/596258","https://larousse.fr/dictionnaires/anglais-francais/motif/596245","https://larousse.fr/dictionnaires/anglais-francais/motion/596248","https://larousse.fr/dictionnaires/anglais-francais/motion_picture/596251","https://larousse.fr/dictionnaires/anglais-francais/motion_sickness/596252","https://larousse.fr/dictionnaires/anglais-francais/motionless/596250"]}`
-		case "blue":       str = `{"PageID": 566219,"Words": [{"Code": 566219,"Header": {"Text": "blue","TextAlt": "","Phonetic": "[blu:]","Audio": "https://voix.larousse.fr/anglais/20565A.mp3","Type": "noun"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "bleu m, azur m","RedBrac": "[colour]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": null,"Phrases": [{"Text1": "the blue","Text2": "le ciel, l'azur m","Audio1": "https://voix.larousse.fr/anglais/63149ang2.mp3","Audio2": "https://voix.larousse.fr/francais/125535fra2.mp3","RedBrac": "[sky]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "they set off into the blue","Text2": "ils sont partis à l'aventure","Audio1": "https://voix.larousse.fr/anglais/63150ang2.mp3","Audio2": "https://voix.larousse.fr/francais/125536fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "membre du parti conservateur britannique","RedBrac": "","RedCaps": "POLITICS","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "UNIVERSITY","RedMeta": "(UK)"}],"Phrases": [{"Text1": "the Dark/Light Blues","Text2": "l'équipe funiversitaire d'Oxford/de Cambridge","Audio1": "https://voix.larousse.fr/anglais/63151ang2.mp3","Audio2": "https://voix.larousse.fr/francais/125538fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he got a blue for cricket","Text2": "il a représenté son université au cricket","Audio1": "https://voix.larousse.fr/anglais/63152ang2.mp3","Audio2": "https://voix.larousse.fr/francais/125539fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "bleu m","RedBrac": "[for laundry]","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 973646,"Header": {"Text": "blue","TextAlt": "","Phonetic": "[blu:]","Audio": "https://voix.larousse.fr/anglais/20565A.mp3","Type": "adjective"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "bleu","RedBrac": "[colour]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "to be blue with cold","Text2": "être bleu de froid","Audio1": "https://voix.larousse.fr/anglais/63153ang2.mp3","Audio2": "https://voix.larousse.fr/francais/125540fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "you can argue until you're blue in the face but she still won't give in","Text2": "vous pouvez vous tuer à discuter, elle ne s'avouera pas vaincue pour autant","Audio1": "https://voix.larousse.fr/anglais/63154ang2.mp3","Audio2": "https://voix.larousse.fr/francais/125541fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}]},{"Meanings": [{"Text": "triste, cafardeux","RedBrac": "[depressed]","RedCaps": "","RedMeta": "(informal)"}],"Phrases": [{"Text1": "to feel blue","Text2": "avoir le cafard","Audio1": "https://voix.larousse.fr/anglais/63155ang2.mp3","Audio2": "https://voix.larousse.fr/francais/466fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "obscène, cochon","RedBrac": "[obscene - language]","RedCaps": "","RedMeta": ""},{"Text": "porno","RedBrac": "[ - book, movie]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "(phrase)"}],"Phrases": [{"Text1": "to have a blue fit","Text2": "piquer une crise","Audio1": "https://voix.larousse.fr/anglais/63156ang2.mp3","Audio2": "https://voix.larousse.fr/francais/2548fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(UK, informal)","IsBlue": false,"Subphrases": null},{"Text1": "to scream or to shout blue murder","Text2": "crier comme un putois","Audio1": "https://voix.larousse.fr/anglais/63157ang2.mp3","Audio2": "https://voix.larousse.fr/francais/10427fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(informal)","IsBlue": false,"Subphrases": null},{"Text1": "once in a blue moon","Text2": "tous les trente-six du mois","Audio1": "https://voix.larousse.fr/anglais/17074ang2.mp3","Audio2": "https://voix.larousse.fr/francais/94200fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(informal)","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 973647,"Header": {"Text": "blue","TextAlt": "","Phonetic": "[blu:]","Audio": "https://voix.larousse.fr/anglais/20565A.mp3","Type": "transitive verb"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "claquer","RedBrac": "[squander - money]","RedCaps": "","RedMeta": "(UK, informal)"}],"Phrases": null},{"Meanings": [{"Text": "passer au bleu","RedBrac": "[laundry]","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 566220,"Header": {"Text": "blues","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/16956ang2.mp3","Type": "noun"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "(informal)"}],"Phrases": [{"Text1": "the blues","Text2": "le cafard","Audio1": "https://voix.larousse.fr/anglais/16957ang2.mp3","Audio2": "https://voix.larousse.fr/francais/125545fra2.mp3","RedBrac": "[depression]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to get or to have the blues","Text2": "avoir le cafard","Audio1": "https://voix.larousse.fr/anglais/63158ang2.mp3","Audio2": "https://voix.larousse.fr/francais/466fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "MUSIC","RedMeta": ""}],"Phrases": [{"Text1": "the blues","Text2": "le blues","Audio1": "https://voix.larousse.fr/anglais/16957ang2.mp3","Audio2": "https://voix.larousse.fr/francais/125546fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 566221,"Header": {"Text": "Blues","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/63159ang2.mp3","Type": "plural proper noun"},"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "the Blues and Royals","Text2": "section de la Cavalerie de la Maison du Souverain britannique","Audio1": "https://voix.larousse.fr/anglais/63160ang2.mp3","Audio2": "https://voix.larousse.fr/francais/125547fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 566222,"Header": {"Text": "out of the blue","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/16955ang2.mp3","Type": "phrasal adverb"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "sans prévenir","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "the job offer came out of the blue","Text2": "la proposition de travail est tombée du ciel","Audio1": "https://voix.larousse.fr/anglais/63161ang2.mp3","Audio2": "https://voix.larousse.fr/francais/125548fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]}],"SeeAlso": ["https://larousse.fr/dictionnaires/anglais-francais/blue_baby/566223","https://larousse.fr/dictionnaires/anglais-francais/blue_berets/566227","https://larousse.fr/dictionnaires/anglais-francais/blue_blood/566232","https://larousse.fr/dictionnaires/anglais-francais/blue_chip/566237","https://larousse.fr/dictionnaires/anglais-francais/blue_jeans/566246","https://larousse.fr/dictionnaires/anglais-francais/blowy/566211","https://larousse.fr/dictionnaires/anglais-francais/blub/566215","https://larousse.fr/dictionnaires/anglais-francais/blubber/566216","https://larousse.fr/dictionnaires/anglais-francais/blubbery/566217","https://larousse.fr/dictionnaires/anglais-francais/bludgeon/566218"]}`
+		case "blue":       str = `{"PageID": 566219,"Words": [{"Code": 566219,"Header": {"Text": "blue","TextAlt": "","Phonetic": "[blu:]","Audio": "https://voix.larousse.fr/anglais/20565A.mp3","Type": "noun"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "bleu, azur","TargetGenre": ["m","m"],"RedBrac": "[colour]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": null,"Phrases": [{"Text1": "the blue","Text2": "le ciel, l'azur m","Audio1": "https://voix.larousse.fr/anglais/63149ang2.mp3","Audio2": "https://voix.larousse.fr/francais/125535fra2.mp3","RedBrac": "[sky]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "they set off into the blue","Text2": "ils sont partis à l'aventure","Audio1": "https://voix.larousse.fr/anglais/63150ang2.mp3","Audio2": "https://voix.larousse.fr/francais/125536fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "membre du parti conservateur britannique","RedBrac": "","RedCaps": "POLITICS","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "UNIVERSITY","RedMeta": "(UK)"}],"Phrases": [{"Text1": "the Dark/Light Blues","Text2": "l'équipe funiversitaire d'Oxford/de Cambridge","Audio1": "https://voix.larousse.fr/anglais/63151ang2.mp3","Audio2": "https://voix.larousse.fr/francais/125538fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he got a blue for cricket","Text2": "il a représenté son université au cricket","Audio1": "https://voix.larousse.fr/anglais/63152ang2.mp3","Audio2": "https://voix.larousse.fr/francais/125539fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "bleu","TargetGenre": ["m"],"RedBrac": "[for laundry]","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 973646,"Header": {"Text": "blue","TextAlt": "","Phonetic": "[blu:]","Audio": "https://voix.larousse.fr/anglais/20565A.mp3","Type": "adjective"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "bleu","RedBrac": "[colour]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "to be blue with cold","Text2": "être bleu de froid","Audio1": "https://voix.larousse.fr/anglais/63153ang2.mp3","Audio2": "https://voix.larousse.fr/francais/125540fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "you can argue until you're blue in the face but she still won't give in","Text2": "vous pouvez vous tuer à discuter, elle ne s'avouera pas vaincue pour autant","Audio1": "https://voix.larousse.fr/anglais/63154ang2.mp3","Audio2": "https://voix.larousse.fr/francais/125541fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}]},{"Meanings": [{"Text": "triste, cafardeux","RedBrac": "[depressed]","RedCaps": "","RedMeta": "(informal)"}],"Phrases": [{"Text1": "to feel blue","Text2": "avoir le cafard","Audio1": "https://voix.larousse.fr/anglais/63155ang2.mp3","Audio2": "https://voix.larousse.fr/francais/466fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "obscène, cochon","RedBrac": "[obscene - language]","RedCaps": "","RedMeta": ""},{"Text": "porno","RedBrac": "[ - book, movie]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "(phrase)"}],"Phrases": [{"Text1": "to have a blue fit","Text2": "piquer une crise","Audio1": "https://voix.larousse.fr/anglais/63156ang2.mp3","Audio2": "https://voix.larousse.fr/francais/2548fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(UK, informal)","IsBlue": false,"Subphrases": null},{"Text1": "to scream or to shout blue murder","Text2": "crier comme un putois","Audio1": "https://voix.larousse.fr/anglais/63157ang2.mp3","Audio2": "https://voix.larousse.fr/francais/10427fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(informal)","IsBlue": false,"Subphrases": null},{"Text1": "once in a blue moon","Text2": "tous les trente-six du mois","Audio1": "https://voix.larousse.fr/anglais/17074ang2.mp3","Audio2": "https://voix.larousse.fr/francais/94200fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(informal)","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 973647,"Header": {"Text": "blue","TextAlt": "","Phonetic": "[blu:]","Audio": "https://voix.larousse.fr/anglais/20565A.mp3","Type": "transitive verb"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "claquer","RedBrac": "[squander - money]","RedCaps": "","RedMeta": "(UK, informal)"}],"Phrases": null},{"Meanings": [{"Text": "passer au bleu","RedBrac": "[laundry]","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 566220,"Header": {"Text": "blues","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/16956ang2.mp3","Type": "noun"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "(informal)"}],"Phrases": [{"Text1": "the blues","Text2": "le cafard","Audio1": "https://voix.larousse.fr/anglais/16957ang2.mp3","Audio2": "https://voix.larousse.fr/francais/125545fra2.mp3","RedBrac": "[depression]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to get or to have the blues","Text2": "avoir le cafard","Audio1": "https://voix.larousse.fr/anglais/63158ang2.mp3","Audio2": "https://voix.larousse.fr/francais/466fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "MUSIC","RedMeta": ""}],"Phrases": [{"Text1": "the blues","Text2": "le blues","Audio1": "https://voix.larousse.fr/anglais/16957ang2.mp3","Audio2": "https://voix.larousse.fr/francais/125546fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 566221,"Header": {"Text": "Blues","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/63159ang2.mp3","Type": "plural proper noun"},"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "the Blues and Royals","Text2": "section de la Cavalerie de la Maison du Souverain britannique","Audio1": "https://voix.larousse.fr/anglais/63160ang2.mp3","Audio2": "https://voix.larousse.fr/francais/125547fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 566222,"Header": {"Text": "out of the blue","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/16955ang2.mp3","Type": "phrasal adverb"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "sans prévenir","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "the job offer came out of the blue","Text2": "la proposition de travail est tombée du ciel","Audio1": "https://voix.larousse.fr/anglais/63161ang2.mp3","Audio2": "https://voix.larousse.fr/francais/125548fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]}],"SeeAlso": ["https://larousse.fr/dictionnaires/anglais-francais/blue_baby/566223","https://larousse.fr/dictionnaires/anglais-francais/blue_berets/566227","https://larousse.fr/dictionnaires/anglais-francais/blue_blood/566232","https://larousse.fr/dictionnaires/anglais-francais/blue_chip/566237","https://larousse.fr/dictionnaires/anglais-francais/blue_jeans/566246","https://larousse.fr/dictionnaires/anglais-francais/blowy/566211","https://larousse.fr/dictionnaires/anglais-francais/blub/566215","https://larousse.fr/dictionnaires/anglais-francais/blubber/566216","https://larousse.fr/dictionnaires/anglais-francais/blubbery/566217","https://larousse.fr/dictionnaires/anglais-francais/bludgeon/566218"]}`
 		case "digital":    str = `{"PageID": 575525,"Words": [{"Code": 575525,"Header": {"Text": "digital","TextAlt": "","Phonetic": "[ˈdɪdʒɪtl]","Audio": "https://voix.larousse.fr/anglais/04507.mp3","Type": "adjective"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "digital","RedBrac": "","RedCaps": "ANATOMY","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "à affichage numérique","RedBrac": "[clock, watch]","RedCaps": "","RedMeta": ""},{"Text": "numérique","RedBrac": "[display]","RedCaps": "","RedMeta": ""},{"Text": "numérique","RedBrac": "","RedCaps": "COMPUTING","RedMeta": ""}],"Phrases": null}]}]}],"SeeAlso": ["https://larousse.fr/dictionnaires/anglais-francais/digital_audio_tape/575526","https://larousse.fr/dictionnaires/anglais-francais/digital_camera/575527","https://larousse.fr/dictionnaires/anglais-francais/digital_computer/575528","https://larousse.fr/dictionnaires/anglais-francais/digital_radio/827776","https://larousse.fr/dictionnaires/anglais-francais/digital_recording/575531","https://larousse.fr/dictionnaires/anglais-francais/digestion/575520","https://larousse.fr/dictionnaires/anglais-francais/digestive/575521","https://larousse.fr/dictionnaires/anglais-francais/digger/575522","https://larousse.fr/dictionnaires/anglais-francais/diggings/575523","https://larousse.fr/dictionnaires/anglais-francais/digit/575524"]}`
-		case "make":       str = `{"PageID": 593701,"Words": [{"Code": 593701,"Header": {"Text": "make","TextAlt": "","Phonetic": "[meɪk][meɪd]","Audio": "https://voix.larousse.fr/anglais/19333A.mp3","Type": "transitive verb"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "faire, fabriquer","RedBrac": "[construct, create, manufacture]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "to make one's own clothes","Text2": "faire ses vêtements soi-même","Audio1": "https://voix.larousse.fr/anglais/92833ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164117fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to make a meal","Text2": "préparer un repas","Audio1": "https://voix.larousse.fr/anglais/92834ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164118fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "‘made in Japan’","Text2": "‘fabriqué au Japon’","Audio1": "https://voix.larousse.fr/anglais/92835ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164119fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "a vase made of or from clay","Text2": "un vase en ou de terre cuite","Audio1": "https://voix.larousse.fr/anglais/92836ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164120fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "what's it made of ?","Text2": "en quoi est-ce que c'est fait ?","Audio1": "https://voix.larousse.fr/anglais/7377ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164121fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "what do you make aluminium from ?","Text2": "à partir de quoi est-ce qu'on fabrique l'aluminium ?","Audio1": "https://voix.larousse.fr/anglais/92837ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164122fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "they're made for each other","Text2": "ils sont faits l'un pour l'autre","Audio1": "https://voix.larousse.fr/anglais/92838ang2.mp3","Audio2": "https://voix.larousse.fr/francais/10755fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "we're not made of money !","Text2": "on n'a pas d'argent à jeter par les fenêtres !","Audio1": "https://voix.larousse.fr/anglais/92839ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164123fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "I'll show them what I'm made of !","Text2": "je leur montrerai de quel bois je me chauffe ou qui je suis !","Audio1": "https://voix.larousse.fr/anglais/92840ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164124fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}]},{"Meanings": [{"Text": "faire","RedBrac": "[cause to appear or happen - hole, tear, mess, mistake, noise]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "it made a dent in the bumper","Text2": "ça a cabossé le pare-chocs","Audio1": "https://voix.larousse.fr/anglais/92841ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164125fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he's always making trouble","Text2": "il faut toujours qu'il fasse des histoires","Audio1": "https://voix.larousse.fr/anglais/92842ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164126fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "établir, faire","RedBrac": "[establish - law, rule]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "I don't make the rules","Text2": "ce n'est pas moi qui fais les règlements","Audio1": "https://voix.larousse.fr/anglais/92843ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164127fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "former","RedBrac": "[form - circle, line]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "faire","RedBrac": "[direct]","RedCaps": "CINEMA \u0026 TELEVISION","RedMeta": ""},{"Text": "faire","RedBrac": "[act in]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "","RedBrac": "[indicating action performed]","RedCaps": "","RedMeta": "(delexical use)"}],"Phrases": [{"Text1": "to make an offer","Text2": "faire une offre","Audio1": "https://voix.larousse.fr/anglais/92844ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164128fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to make a request","Text2": "faire une demande","Audio1": "https://voix.larousse.fr/anglais/35222ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164129fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to make a note of something","Text2": "prendre note de quelque chose","Audio1": "https://voix.larousse.fr/anglais/8945ang2.mp3","Audio2": "https://voix.larousse.fr/francais/93315fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to make a phone call","Text2": "passer un coup de fil","Audio1": "https://voix.larousse.fr/anglais/10454ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164130fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "the police are making inquiries","Text2": "la police procède à une enquête","Audio1": "https://voix.larousse.fr/anglais/87758ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164131fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "","RedBrac": "[tidy]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "to make one's bed","Text2": "faire son lit","Audio1": "https://voix.larousse.fr/anglais/92845ang2.mp3","Audio2": "https://voix.larousse.fr/francais/7117fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]},{"Title": "","Items": [{"Meanings": [{"Text": "rendre","RedBrac": "[cause to be]","RedCaps": "","RedMeta": "(with adjective or pp complement)"}],"Phrases": [{"Text1": "to make somebody happy/mad","Text2": "rendre quelqu'un heureux/fou","Audio1": "https://voix.larousse.fr/anglais/92846ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164132fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "this will make things easier","Text2": "cela facilitera les choses","Audio1": "https://voix.larousse.fr/anglais/92847ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164133fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "it makes her tired","Text2": "ça la fatigue","Audio1": "https://voix.larousse.fr/anglais/92848ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164134fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "what makes the sky blue ?","Text2": "qu'est-ce qui fait que le ciel est bleu ?","Audio1": "https://voix.larousse.fr/anglais/92849ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164135fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "I'd like to make it clear that it wasn't my fault","Text2": "je voudrais qu'on comprenne bien que je n'y suis pour rien","Audio1": "https://voix.larousse.fr/anglais/92850ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164136fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "it was hard to make myself heard/understood","Text2": "j'ai eu du mal à me faire entendre/comprendre","Audio1": "https://voix.larousse.fr/anglais/92851ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164137fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "faire","RedBrac": "[change into]","RedCaps": "","RedMeta": "(with noun complement or with 'into')"}],"Phrases": [{"Text1": "the film made her (into) a star","Text2": "le film a fait d'elle une vedette","Audio1": "https://voix.larousse.fr/anglais/92852ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164138fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he was made president for life","Text2": "il a été nommé président à vie","Audio1": "https://voix.larousse.fr/anglais/92853ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164139fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "they made Bonn the capital","Text2": "ils ont choisi Bonn pour capitale","Audio1": "https://voix.larousse.fr/anglais/92854ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164140fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he makes a joke of everything","Text2": "il tourne tout en plaisanterie","Audio1": "https://voix.larousse.fr/anglais/92855ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164141fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "the building has been made into offices","Text2": "l'immeuble a été réaménagé ou converti en bureaux","Audio1": "https://voix.larousse.fr/anglais/92856ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164142fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "I can't come in the morning, shall we make it 2 pm ?","Text2": "je ne peux pas venir le matin, est-ce que 14 h vous conviendrait ?","Audio1": "https://voix.larousse.fr/anglais/92857ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164143fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "faire","RedBrac": "[cause]","RedCaps": "","RedMeta": "(with verb complement)"}],"Phrases": [{"Text1": "what makes you think they're wrong ?","Text2": "qu'est-ce qui te fait penser qu'ils ont tort ?","Audio1": "https://voix.larousse.fr/anglais/92858ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164144fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "you make it look easy","Text2": "à vous voir, on croirait que c'est facile","Audio1": "https://voix.larousse.fr/anglais/92859ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164145fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "the hat/photo makes you look ridiculous","Text2": "tu as l'air ridicule avec ce chapeau/sur cette photo","Audio1": "https://voix.larousse.fr/anglais/92860ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164146fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "","RedBrac": "[force, oblige]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "to make somebody do something","Text2": "","Audio1": "https://voix.larousse.fr/anglais/7372ang2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "faire faire quelque chose à quelqu'un","Audio1": "","Audio2": "https://voix.larousse.fr/francais/153482fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "forcer ou obliger ou contraindre quelqu'un à faire quelque chose","Audio1": "","Audio2": "https://voix.larousse.fr/francais/164147fra2.mp3","RedBrac": "[stronger]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Text1": "they made me wait, I was made to wait","Text2": "ils m'ont fait attendre","Audio1": "https://voix.larousse.fr/anglais/92861ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164148fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "she made herself keep running","Text2": "elle s'est forcée à continuer à courir","Audio1": "https://voix.larousse.fr/anglais/92862ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164149fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]},{"Title": "","Items": [{"Meanings": [{"Text": "atteindre","RedBrac": "[attain, achieve - goal]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "their first record made the top ten","Text2": "leur premier disque est rentré au top ten","Audio1": "https://voix.larousse.fr/anglais/92863ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164150fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "you won't make the team if you don't train","Text2": "tu n'entreras jamais dans l'équipe si tu ne t'entraînes pas","Audio1": "https://voix.larousse.fr/anglais/92864ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164151fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "the story made the front page","Text2": "l'histoire a fait la une des journaux","Audio1": "https://voix.larousse.fr/anglais/92865ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164152fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "atteindre","RedBrac": "[arrive at, get to - place]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "we should make Houston/port by evening","Text2": "nous devrions arriver à Houston/atteindre le port d'ici ce soir","Audio1": "https://voix.larousse.fr/anglais/92866ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164153fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "did you make your train ?","Text2": "as-tu réussi à avoir ton train ?","Audio1": "https://voix.larousse.fr/anglais/92867ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164154fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "","RedBrac": "[be available for]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "I won't be able to make lunch","Text2": "je ne pourrai pas déjeuner avec toi/elle/vous, etc.","Audio1": "https://voix.larousse.fr/anglais/92868ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164155fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "can you make Friday afternoon ?","Text2": "vendredi après-midi, ça vous convient ?","Audio1": "https://voix.larousse.fr/anglais/92869ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164156fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "faire, gagner","RedBrac": "[earn, win]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "how much do you make a month ?","Text2": "combien gagnes-tu par mois ?","Audio1": "https://voix.larousse.fr/anglais/92870ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164158fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "what do they make out of the deal ?","Text2": "qu'est-ce qu'ils gagnent dans l'affaire ?, qu'est-ce que l'affaire leur rapporte ?","Audio1": "https://voix.larousse.fr/anglais/92871ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164159fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]},{"Title": "","Items": [{"Meanings": [{"Text": "faire","RedBrac": "[amount to, add up to]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "17 and 19 make or makes 36","Text2": "17 plus 19 font ou égalent 36","Audio1": "https://voix.larousse.fr/anglais/92872ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164160fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "how old does that make him ?","Text2": "quel âge ça lui fait ?","Audio1": "https://voix.larousse.fr/anglais/92873ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164161fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "","RedBrac": "[reckon to be]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "I make the answer 257","Text2": "d'après moi, ça fait 257","Audio1": "https://voix.larousse.fr/anglais/92874ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164162fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "what time do you make it ?","Text2": "quelle heure as-tu ?","Audio1": "https://voix.larousse.fr/anglais/7380ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164163fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "faire","RedBrac": "[fulfil specified role, function etc]","RedCaps": "","RedMeta": "(with noun complement)"}],"Phrases": [{"Text1": "he'll make somebody a good husband","Text2": "ce sera un excellent mari","Audio1": "https://voix.larousse.fr/anglais/92875ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164164fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "they make a handsome couple","Text2": "ils forment un beau couple","Audio1": "https://voix.larousse.fr/anglais/92876ang2.mp3","Audio2": "https://voix.larousse.fr/francais/134140fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "her reminiscences make interesting reading","Text2": "ses souvenirs sont intéressants à lire","Audio1": "https://voix.larousse.fr/anglais/92877ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164165fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "marquer","RedBrac": "[score]","RedCaps": "","RedMeta": ""}],"Phrases": null}]},{"Title": "","Items": [{"Meanings": [{"Text": "faire le succès de","RedBrac": "[make successful]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "if this deal comes off we're made !","Text2": "si ça marche, on touche le gros lot !","Audio1": "https://voix.larousse.fr/anglais/92878ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164167fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "you've got it made !","Text2": "tu n'as plus de souci à te faire !","Audio1": "https://voix.larousse.fr/anglais/92879ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164168fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "what happens today will make or break us","Text2": "notre avenir dépend entièrement de ce qui va se passer aujourd'hui","Audio1": "https://voix.larousse.fr/anglais/92880ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164169fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}]},{"Meanings": [{"Text": "draguer","RedBrac": "[seduce]","RedCaps": "","RedMeta": "(very informal)"},{"Text": "se faire","RedBrac": "[have sex with]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "","RedBrac": "[in directions]","RedCaps": "","RedMeta": "(US)"}],"Phrases": [{"Text1": "make a right/left","Text2": "tournez à droite/à gauche","Audio1": "https://voix.larousse.fr/anglais/7390ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164170fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "(phrase)"}],"Phrases": [{"Text1": "to make it","Text2": "","Audio1": "https://voix.larousse.fr/anglais/7387ang2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "arriver","Audio1": "","Audio2": "https://voix.larousse.fr/francais/37871fra2.mp3","RedBrac": "[arrive]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "réussir","Audio1": "","Audio2": "https://voix.larousse.fr/francais/45037fra2.mp3","RedBrac": "[be successful]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "être là","Audio1": "","Audio2": "https://voix.larousse.fr/francais/59367fra2.mp3","RedBrac": "[be able to attend]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Text1": "I'll never make it for 10 o'clock","Text2": "je ne pourrai jamais y être pour 10 h","Audio1": "https://voix.larousse.fr/anglais/92881ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164171fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "I hope she makes it through the winter","Text2": "j'espère qu'elle passera l'hiver","Audio1": "https://voix.larousse.fr/anglais/92882ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164172fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "I can't make it for supper tomorrow","Text2": "je ne peux pas dîner avec eux/toi, etc. demain","Audio1": "https://voix.larousse.fr/anglais/92883ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164173fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to make it with somebody","Text2": "se faire quelqu'un","Audio1": "https://voix.larousse.fr/anglais/92884ang2.mp3","Audio2": "https://voix.larousse.fr/francais/88886fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(very informal)","IsBlue": true,"Subphrases": null}]}]}]},{"Code": 593701,"Header": {"Text": "make","TextAlt": "","Phonetic": "[meɪk][meɪd]","Audio": "https://voix.larousse.fr/anglais/19333A.mp3","Type": "intransitive verb"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "","RedBrac": "[act]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "to make (as if) to","Text2": "faire mine de","Audio1": "https://voix.larousse.fr/anglais/92885ang2.mp3","Audio2": "https://voix.larousse.fr/francais/81109fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "she made (as if) to stand up","Text2": "elle fit mine de se lever","Audio1": "https://voix.larousse.fr/anglais/92886ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164174fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "make like you're asleep !","Text2": "fais semblant de dormir !","Audio1": "https://voix.larousse.fr/anglais/92887ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164175fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(informal)","IsBlue": false,"Subphrases": null},{"Text1": "to make believe","Text2": "imaginer","Audio1": "https://voix.larousse.fr/anglais/92888ang2.mp3","Audio2": "https://voix.larousse.fr/francais/33260fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "to make do (with)","Text2": "","Audio1": "https://voix.larousse.fr/anglais/92889ang2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": [{"Text1": "","Text2": "se débrouiller (avec)","Audio1": "","Audio2": "https://voix.larousse.fr/francais/164176fra2.mp3","RedBrac": "[manage]","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "","Text2": "se contenter (de)","Audio1": "","Audio2": "https://voix.larousse.fr/francais/164177fra2.mp3","RedBrac": "[be satisfied]","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}]},{"Text1": "it's broken but we'll just have to make do","Text2": "c'est cassé mais il faudra faire avec ou nous débrouiller avec","Audio1": "https://voix.larousse.fr/anglais/92890ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164178fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}]}]}]},{"Code": 978373,"Header": {"Text": "make","TextAlt": "","Phonetic": "[meɪk]","Audio": "https://voix.larousse.fr/anglais/19333A.mp3","Type": "noun"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "marque f","RedBrac": "[brand]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "(phrase)"}],"Phrases": [{"Text1": "to be on the make","Text2": "","Audio1": "https://voix.larousse.fr/anglais/7392ang2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "avoir les dents longues","Audio1": "","Audio2": "https://voix.larousse.fr/francais/3044fra2.mp3","RedBrac": "[for power, profit]","RedCaps": "","RedMeta": "(informal)","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "draguer","Audio1": "","Audio2": "https://voix.larousse.fr/francais/28323fra2.mp3","RedBrac": "[looking for sexual partner]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]}]},{"Code": 593702,"Header": {"Text": "make away with","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/92891ang2.mp3","Type": ""},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "make off with","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 593703,"Header": {"Text": "make for","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7393ang2.mp3","Type": "transitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "se diriger vers","RedBrac": "[head towards]","RedCaps": "","RedMeta": ""},{"Text": "se précipiter vers","RedBrac": "[hastily]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "he made for his gun","Text2": "il fit un geste pour saisir son pistolet","Audio1": "https://voix.larousse.fr/anglais/92892ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164180fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "mener à","RedBrac": "[contribute to]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "the treaty should make for a more lasting peace","Text2": "le traité devrait mener ou aboutir à une paix plus durable","Audio1": "https://voix.larousse.fr/anglais/92893ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164181fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "this typeface makes for easier reading","Text2": "cette police permet une lecture plus facile","Audio1": "https://voix.larousse.fr/anglais/92894ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164182fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "a good diet makes for healthier babies","Text2": "un bon régime alimentaire donne des bébés en meilleure santé","Audio1": "https://voix.larousse.fr/anglais/92895ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164183fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 593704,"Header": {"Text": "make of","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7396ang2.mp3","Type": "transitive verb separable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "comprendre à","RedBrac": "[understand]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "can you make anything of these instructions ?","Text2": "est-ce que tu comprends quelque chose à ce mode d'emploi ?","Audio1": "https://voix.larousse.fr/anglais/92896ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164185fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "","RedBrac": "[give importance to]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "I think you're making too much of a very minor problem","Text2": "je pense que tu exagères l'importance de ce petit problème","Audio1": "https://voix.larousse.fr/anglais/92897ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164186fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "do you want to make something of it, then ?","Text2": "tu cherches des histoires ou quoi ?","Audio1": "https://voix.larousse.fr/anglais/92898ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164187fra2.mp3","RedBrac": "[threat]","RedCaps": "","RedMeta": "(informal)","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 978374,"Header": {"Text": "make of","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7396ang2.mp3","Type": "transitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "penser de","RedBrac": "[think of]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "what do you make of the Smiths ?","Text2": "qu'est-ce que tu penses des Smith ?","Audio1": "https://voix.larousse.fr/anglais/92899ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164189fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 593705,"Header": {"Text": "make off","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7398ang2.mp3","Type": "intransitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "partir","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 593706,"Header": {"Text": "make off with","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7399ang2.mp3","Type": "transitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "partir avec","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 593707,"Header": {"Text": "make out","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7400ang2.mp3","Type": "transitive verb separable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "distinguer","RedBrac": "[see]","RedCaps": "","RedMeta": ""},{"Text": "entendre, comprendre","RedBrac": "[hear]","RedCaps": "","RedMeta": ""},{"Text": "déchiffrer","RedBrac": "[read]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "comprendre","RedBrac": "[understand]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "I can't make her out at all","Text2": "je ne la comprends pas du tout","Audio1": "https://voix.larousse.fr/anglais/92900ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164191fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "prétendre","RedBrac": "[claim]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "she made out that she was busy","Text2": "elle a fait semblant d'être occupée","Audio1": "https://voix.larousse.fr/anglais/92901ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164192fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "don't make yourself out to be something you're not","Text2": "ne prétends pas être ce que tu n'es pas","Audio1": "https://voix.larousse.fr/anglais/92902ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164193fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "remplir","RedBrac": "[fill out - form, cheque]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "who shall I make the cheque out to ?","Text2": "je fais le chèque à quel ordre ?","Audio1": "https://voix.larousse.fr/anglais/92903ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164194fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "dresser, faire","RedBrac": "[draw up - list]","RedCaps": "","RedMeta": ""},{"Text": "faire, rédiger, établir","RedBrac": "[ - will, contract]","RedCaps": "","RedMeta": ""},{"Text": "faire","RedBrac": "[ - receipt]","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 978375,"Header": {"Text": "make out","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7400ang2.mp3","Type": "intransitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "se débrouiller","RedBrac": "[manage]","RedCaps": "","RedMeta": "(informal)"}],"Phrases": [{"Text1": "how did you make out at work today ?","Text2": "comment ça s'est passé au boulot aujourd'hui ?","Audio1": "https://voix.larousse.fr/anglais/92904ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164197fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "se peloter","RedBrac": "[neck, pet]","RedCaps": "","RedMeta": "(very informal)"}],"Phrases": [{"Text1": "to make out with somebody","Text2": "s'envoyer quelqu'un","Audio1": "https://voix.larousse.fr/anglais/92905ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164198fra2.mp3","RedBrac": "[have sex]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 593708,"Header": {"Text": "make over","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/92906ang2.mp3","Type": "transitive verb separable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "transférer, céder","RedBrac": "[transfer]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "réaménager","RedBrac": "[convert - room, house]","RedCaps": "","RedMeta": "(US)"}],"Phrases": [{"Text1": "the garage had been made over into a workshop","Text2": "le garage a été transformé en atelier","Audio1": "https://voix.larousse.fr/anglais/92907ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164201fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "transformer","RedBrac": "[change the appearance of]","RedCaps": "","RedMeta": "(US)"}],"Phrases": null}]}]},{"Code": 593709,"Header": {"Text": "make up","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7403ang2.mp3","Type": "intransitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "se maquiller","RedBrac": "[put on make-up]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "se réconcilier","RedBrac": "[become reconciled]","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 978376,"Header": {"Text": "make up","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7403ang2.mp3","Type": "transitive verb separable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "maquiller","RedBrac": "[put make-up on]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "to make oneself up","Text2": "se maquiller","Audio1": "https://voix.larousse.fr/anglais/7404ang2.mp3","Audio2": "https://voix.larousse.fr/francais/7466fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he was heavily made up","Text2": "il était très maquillé ou fardé","Audio1": "https://voix.larousse.fr/anglais/92908ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164202fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "faire, préparer","RedBrac": "[prepare]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "the chemist made up the prescription","Text2": "le pharmacien a préparé l'ordonnance","Audio1": "https://voix.larousse.fr/anglais/92909ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164204fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "the fire needs making up","Text2": "il faut remettre du charbon/du bois sur le feu","Audio1": "https://voix.larousse.fr/anglais/92910ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164205fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "inventer","RedBrac": "[invent]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "(phrase)"}],"Phrases": [{"Text1": "to make (it) up with somebody","Text2": "se réconcilier avec quelqu'un","Audio1": "https://voix.larousse.fr/anglais/92911ang2.mp3","Audio2": "https://voix.larousse.fr/francais/11086fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 978377,"Header": {"Text": "make up","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7403ang2.mp3","Type": "transitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "composer, constituer","RedBrac": "[constitute]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "the different ethnic groups that make up our organization","Text2": "les différents groupes ethniques qui constituent notre organisation","Audio1": "https://voix.larousse.fr/anglais/92912ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164207fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "the cabinet is made up of 11 ministers","Text2": "le cabinet est composé de 11 ministres","Audio1": "https://voix.larousse.fr/anglais/92913ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164208fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "it is made up of a mixture of different types of tobacco","Text2": "c'est un mélange de plusieurs tabacs différents","Audio1": "https://voix.larousse.fr/anglais/92914ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164209fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "compenser","RedBrac": "[compensate for - losses]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "to make up lost ground","Text2": "regagner le terrain perdu","Audio1": "https://voix.larousse.fr/anglais/92915ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164210fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he's making up time","Text2": "il rattrape son retard","Audio1": "https://voix.larousse.fr/anglais/92916ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164211fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "","RedBrac": "[complete]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "this cheque will help you make up the required sum","Text2": "ce chèque vous aidera à atteindre le montant requis","Audio1": "https://voix.larousse.fr/anglais/92917ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164212fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "we need two more players to make up the team","Text2": "nous avons besoin de deux joueurs de plus pour que l'équipe soit au complet","Audio1": "https://voix.larousse.fr/anglais/92918ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164213fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "I'll make up the difference","Text2": "je mettrai la différence","Audio1": "https://voix.larousse.fr/anglais/92919ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164214fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 593710,"Header": {"Text": "make up for","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7407ang2.mp3","Type": "transitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "compenser","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "how can I make up for all the trouble I've caused you ?","Text2": "que puis-je faire pour me faire pardonner tous les ennuis que je vous ai causés ?","Audio1": "https://voix.larousse.fr/anglais/92920ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164215fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "she's making up for lost time now !","Text2": "elle est en train de rattraper le temps perdu !","Audio1": "https://voix.larousse.fr/anglais/92921ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164216fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(literal \u0026 figurative)","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 593711,"Header": {"Text": "make up to","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7409ang2.mp3","Type": "transitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "to make up to somebody","Text2": "","Audio1": "https://voix.larousse.fr/anglais/92922ang2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "essayer de se faire bien voir par quelqu'un","Audio1": "","Audio2": "https://voix.larousse.fr/francais/164217fra2.mp3","RedBrac": "[try to win favour]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "faire du plat à quelqu'un","Audio1": "","Audio2": "https://voix.larousse.fr/francais/94758fra2.mp3","RedBrac": "[make advances]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]}]},{"Code": 978378,"Header": {"Text": "make up to","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7409ang2.mp3","Type": "transitive verb separable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "(phrase)"}],"Phrases": [{"Text1": "I promise I'll make it up to you someday","Text2": "tu peux être sûr que je te revaudrai ça (un jour)","Audio1": "https://voix.larousse.fr/anglais/92923ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164218fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 593712,"Header": {"Text": "make with","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/92924ang2.mp3","Type": "transitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "make with the drinks !","Text2": "à boire !","Audio1": "https://voix.larousse.fr/anglais/92925ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164219fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "make with the music !","Text2": "musique !","Audio1": "https://voix.larousse.fr/anglais/92926ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164220fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]}],"SeeAlso": ["https://larousse.fr/dictionnaires/anglais-francais/make-believe/593713","https://larousse.fr/dictionnaires/anglais-francais/makeover/593714","https://larousse.fr/dictionnaires/anglais-francais/-maker/593716","https://larousse.fr/dictionnaires/anglais-francais/maker/593715","https://larousse.fr/dictionnaires/anglais-francais/makeshift/593717","https://larousse.fr/dictionnaires/anglais-francais/Majorca/593694","https://larousse.fr/dictionnaires/anglais-francais/Majorcan/593695","https://larousse.fr/dictionnaires/anglais-francais/majordomo/593696","https://larousse.fr/dictionnaires/anglais-francais/majorette/593697","https://larousse.fr/dictionnaires/anglais-francais/majority/593699"]}`
-		case "drink":      str = `{"PageID": 577016,"Words": [{"Code": 577016,"Header": {"Text": "drink","TextAlt": "","Phonetic": "[drɪŋk][dræŋk],[drʌŋk]","Audio": "https://voix.larousse.fr/anglais/19108A.mp3","Type": "transitive verb"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "boire, prendre","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "would you like something to drink ?","Text2": "voulez-vous boire quelque chose ?","Audio1": "https://voix.larousse.fr/anglais/73566ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140105fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "the water is not fit to drink","Text2": "l'eau n'est pas potable","Audio1": "https://voix.larousse.fr/anglais/73567ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140106fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "this coffee isn't fit to drink","Text2": "ce café est imbuvable","Audio1": "https://voix.larousse.fr/anglais/73568ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140107fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "red Burgundy is best drunk at room temperature","Text2": "le bourgogne rouge est meilleur bu chambré","Audio1": "https://voix.larousse.fr/anglais/73569ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140108fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to drink one's fill","Text2": "boire à sa soif","Audio1": "https://voix.larousse.fr/anglais/73570ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140109fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to drink somebody's health, to drink a toast to somebody","Text2": "boire à la santé de quelqu'un","Audio1": "https://voix.larousse.fr/anglais/73571ang2.mp3","Audio2": "https://voix.larousse.fr/francais/15656fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he drank himself into a stupor","Text2": "il s'est soûlé jusqu'à l'hébétude","Audio1": "https://voix.larousse.fr/anglais/73572ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140110fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he's drinking himself to death","Text2": "l'alcool le tue peu à peu","Audio1": "https://voix.larousse.fr/anglais/73573ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140111fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to drink somebody under the table","Text2": "faire rouler quelqu'un sous la table","Audio1": "https://voix.larousse.fr/anglais/73574ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140112fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}]}]}]},{"Code": 975605,"Header": {"Text": "drink","TextAlt": "","Phonetic": "[drɪŋk][dræŋk],[drʌŋk]","Audio": "https://voix.larousse.fr/anglais/19108A.mp3","Type": "intransitive verb"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "boire","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "she drank out of or from the bottle","Text2": "elle a bu à la bouteille","Audio1": "https://voix.larousse.fr/anglais/73575ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140113fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "I only drink socially","Text2": "je ne bois jamais seul","Audio1": "https://voix.larousse.fr/anglais/73576ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140114fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "‘don't drink and drive’","Text2": "‘boire ou conduire, il faut choisir’","Audio1": "https://voix.larousse.fr/anglais/73577ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140115fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he drinks like a fish","Text2": "il boit comme un trou","Audio1": "https://voix.larousse.fr/anglais/73578ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140116fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}]}]}]},{"Code": 975606,"Header": {"Text": "drink","TextAlt": "","Phonetic": "[drɪŋk]","Audio": "https://voix.larousse.fr/anglais/19108A.mp3","Type": "noun"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "boisson f","RedBrac": "[nonalcoholic]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "may I have a drink ?","Text2": "puis-je boire quelque chose ?","Audio1": "https://voix.larousse.fr/anglais/73579ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140117fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "a drink of water","Text2": "un verre d'eau","Audio1": "https://voix.larousse.fr/anglais/462ang2.mp3","Audio2": "https://voix.larousse.fr/francais/2711fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "give the children a drink","Text2": "donnez à boire aux enfants","Audio1": "https://voix.larousse.fr/anglais/73580ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140118fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "there's plenty of food and drink","Text2": "il y a tout ce qu'on veut à boire et à manger","Audio1": "https://voix.larousse.fr/anglais/73581ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140119fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "verre m","RedBrac": "[alcoholic]","RedCaps": "","RedMeta": ""},{"Text": "apéritif m","RedBrac": "[before dinner]","RedCaps": "","RedMeta": ""},{"Text": "digestif m","RedBrac": "[after dinner]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "we invited them in for a drink","Text2": "nous les avons invités à prendre un verre","Audio1": "https://voix.larousse.fr/anglais/73582ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140120fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he likes or enjoys a drink","Text2": "il aime bien boire un verre","Audio1": "https://voix.larousse.fr/anglais/73583ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140121fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "drinks are on the house !","Text2": "la maison offre à boire !","Audio1": "https://voix.larousse.fr/anglais/73584ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140122fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he'd had one drink too many","Text2": "il avait bu un verre de trop, il avait un verre dans le nez","Audio1": "https://voix.larousse.fr/anglais/73585ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140123fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "gorgée f","RedBrac": "[mouthful]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "la boisson, l'alcool m","RedBrac": "[alcohol]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "she's taken to drink","Text2": "elle s'adonne à la boisson, elle boit","Audio1": "https://voix.larousse.fr/anglais/73586ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140125fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to be the worse for drink","Text2": "être en état d'ébriété","Audio1": "https://voix.larousse.fr/anglais/73587ang2.mp3","Audio2": "https://voix.larousse.fr/francais/55055fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to drive under the influence of drink","Text2": "conduire en état d'ivresse ou d'ébriété","Audio1": "https://voix.larousse.fr/anglais/73588ang2.mp3","Audio2": "https://voix.larousse.fr/francais/102867fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to smell of drink","Text2": "sentir l'alcool","Audio1": "https://voix.larousse.fr/anglais/73589ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140126fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "flotte f","RedBrac": "[sea]","RedCaps": "","RedMeta": "(UK, informal)"}],"Phrases": null}]}]},{"Code": 975607,"Header": {"Text": "drink","TextAlt": "","Phonetic": "[drɪŋk]","Audio": "https://voix.larousse.fr/anglais/19108A.mp3","Type": "compound"},"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "he has a drink problem","Text2": "il boit trop, il s'adonne à la boisson","Audio1": "https://voix.larousse.fr/anglais/73590ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140127fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 577017,"Header": {"Text": "drink away","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/73591ang2.mp3","Type": "transitive verb separable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "noyer","RedBrac": "[troubles]","RedCaps": "","RedMeta": ""},{"Text": "boire","RedBrac": "[fortune]","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 577018,"Header": {"Text": "drink down","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/73592ang2.mp3","Type": "transitive verb separable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "avaler ou boire d'un trait","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 577019,"Header": {"Text": "drink in","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/73593ang2.mp3","Type": "transitive verb separable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "boire","RedBrac": "[story, words]","RedCaps": "","RedMeta": ""},{"Text": "s'imprégner de","RedBrac": "[atmosphere, surroundings]","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 577020,"Header": {"Text": "drink to","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/73594ang2.mp3","Type": "transitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "boire à, porter un toast à","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "I'll drink to that !","Text2": "je suis pour !","Audio1": "https://voix.larousse.fr/anglais/73595ang2.mp3","Audio2": "https://voix.larousse.fr/francais/41284fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 577021,"Header": {"Text": "drink up","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/73596ang2.mp3","Type": "transitive verb separable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "boire (jusqu'à la dernière goutte), finir","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 975608,"Header": {"Text": "drink up","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/73596ang2.mp3","Type": "intransitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "vider son verre","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "drink up !","Text2": "finissez vos verres !","Audio1": "https://voix.larousse.fr/anglais/73597ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140132fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]}],"SeeAlso": ["https://larousse.fr/dictionnaires/anglais-francais/drinkable/577022","https://larousse.fr/dictionnaires/anglais-francais/drink-driving/577023","https://larousse.fr/dictionnaires/anglais-francais/drinker/577024","https://larousse.fr/dictionnaires/anglais-francais/drinking/577025","https://larousse.fr/dictionnaires/anglais-francais/drinking_chocolate/577026","https://larousse.fr/dictionnaires/anglais-francais/drill_sergeant/577014","https://larousse.fr/dictionnaires/anglais-francais/drilling/577010","https://larousse.fr/dictionnaires/anglais-francais/drilling_platform/577011","https://larousse.fr/dictionnaires/anglais-francais/drilling_rig/577012","https://larousse.fr/dictionnaires/anglais-francais/drily/577015"]}`
+		case "make":       str = `{"PageID": 593701,"Words": [{"Code": 593701,"Header": {"Text": "make","TextAlt": "","Phonetic": "[meɪk][meɪd]","Audio": "https://voix.larousse.fr/anglais/19333A.mp3","Type": "transitive verb"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "faire, fabriquer","RedBrac": "[construct, create, manufacture]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "to make one's own clothes","Text2": "faire ses vêtements soi-même","Audio1": "https://voix.larousse.fr/anglais/92833ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164117fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to make a meal","Text2": "préparer un repas","Audio1": "https://voix.larousse.fr/anglais/92834ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164118fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "‘made in Japan’","Text2": "‘fabriqué au Japon’","Audio1": "https://voix.larousse.fr/anglais/92835ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164119fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "a vase made of or from clay","Text2": "un vase en ou de terre cuite","Audio1": "https://voix.larousse.fr/anglais/92836ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164120fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "what's it made of ?","Text2": "en quoi est-ce que c'est fait ?","Audio1": "https://voix.larousse.fr/anglais/7377ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164121fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "what do you make aluminium from ?","Text2": "à partir de quoi est-ce qu'on fabrique l'aluminium ?","Audio1": "https://voix.larousse.fr/anglais/92837ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164122fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "they're made for each other","Text2": "ils sont faits l'un pour l'autre","Audio1": "https://voix.larousse.fr/anglais/92838ang2.mp3","Audio2": "https://voix.larousse.fr/francais/10755fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "we're not made of money !","Text2": "on n'a pas d'argent à jeter par les fenêtres !","Audio1": "https://voix.larousse.fr/anglais/92839ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164123fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "I'll show them what I'm made of !","Text2": "je leur montrerai de quel bois je me chauffe ou qui je suis !","Audio1": "https://voix.larousse.fr/anglais/92840ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164124fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}]},{"Meanings": [{"Text": "faire","RedBrac": "[cause to appear or happen - hole, tear, mess, mistake, noise]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "it made a dent in the bumper","Text2": "ça a cabossé le pare-chocs","Audio1": "https://voix.larousse.fr/anglais/92841ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164125fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he's always making trouble","Text2": "il faut toujours qu'il fasse des histoires","Audio1": "https://voix.larousse.fr/anglais/92842ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164126fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "établir, faire","RedBrac": "[establish - law, rule]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "I don't make the rules","Text2": "ce n'est pas moi qui fais les règlements","Audio1": "https://voix.larousse.fr/anglais/92843ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164127fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "former","RedBrac": "[form - circle, line]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "faire","RedBrac": "[direct]","RedCaps": "CINEMA \u0026 TELEVISION","RedMeta": ""},{"Text": "faire","RedBrac": "[act in]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "","RedBrac": "[indicating action performed]","RedCaps": "","RedMeta": "(delexical use)"}],"Phrases": [{"Text1": "to make an offer","Text2": "faire une offre","Audio1": "https://voix.larousse.fr/anglais/92844ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164128fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to make a request","Text2": "faire une demande","Audio1": "https://voix.larousse.fr/anglais/35222ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164129fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to make a note of something","Text2": "prendre note de quelque chose","Audio1": "https://voix.larousse.fr/anglais/8945ang2.mp3","Audio2": "https://voix.larousse.fr/francais/93315fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to make a phone call","Text2": "passer un coup de fil","Audio1": "https://voix.larousse.fr/anglais/10454ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164130fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "the police are making inquiries","Text2": "la police procède à une enquête","Audio1": "https://voix.larousse.fr/anglais/87758ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164131fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "","RedBrac": "[tidy]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "to make one's bed","Text2": "faire son lit","Audio1": "https://voix.larousse.fr/anglais/92845ang2.mp3","Audio2": "https://voix.larousse.fr/francais/7117fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]},{"Title": "","Items": [{"Meanings": [{"Text": "rendre","RedBrac": "[cause to be]","RedCaps": "","RedMeta": "(with adjective or pp complement)"}],"Phrases": [{"Text1": "to make somebody happy/mad","Text2": "rendre quelqu'un heureux/fou","Audio1": "https://voix.larousse.fr/anglais/92846ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164132fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "this will make things easier","Text2": "cela facilitera les choses","Audio1": "https://voix.larousse.fr/anglais/92847ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164133fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "it makes her tired","Text2": "ça la fatigue","Audio1": "https://voix.larousse.fr/anglais/92848ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164134fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "what makes the sky blue ?","Text2": "qu'est-ce qui fait que le ciel est bleu ?","Audio1": "https://voix.larousse.fr/anglais/92849ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164135fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "I'd like to make it clear that it wasn't my fault","Text2": "je voudrais qu'on comprenne bien que je n'y suis pour rien","Audio1": "https://voix.larousse.fr/anglais/92850ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164136fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "it was hard to make myself heard/understood","Text2": "j'ai eu du mal à me faire entendre/comprendre","Audio1": "https://voix.larousse.fr/anglais/92851ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164137fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "faire","RedBrac": "[change into]","RedCaps": "","RedMeta": "(with noun complement or with 'into')"}],"Phrases": [{"Text1": "the film made her (into) a star","Text2": "le film a fait d'elle une vedette","Audio1": "https://voix.larousse.fr/anglais/92852ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164138fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he was made president for life","Text2": "il a été nommé président à vie","Audio1": "https://voix.larousse.fr/anglais/92853ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164139fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "they made Bonn the capital","Text2": "ils ont choisi Bonn pour capitale","Audio1": "https://voix.larousse.fr/anglais/92854ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164140fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he makes a joke of everything","Text2": "il tourne tout en plaisanterie","Audio1": "https://voix.larousse.fr/anglais/92855ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164141fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "the building has been made into offices","Text2": "l'immeuble a été réaménagé ou converti en bureaux","Audio1": "https://voix.larousse.fr/anglais/92856ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164142fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "I can't come in the morning, shall we make it 2 pm ?","Text2": "je ne peux pas venir le matin, est-ce que 14 h vous conviendrait ?","Audio1": "https://voix.larousse.fr/anglais/92857ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164143fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "faire","RedBrac": "[cause]","RedCaps": "","RedMeta": "(with verb complement)"}],"Phrases": [{"Text1": "what makes you think they're wrong ?","Text2": "qu'est-ce qui te fait penser qu'ils ont tort ?","Audio1": "https://voix.larousse.fr/anglais/92858ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164144fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "you make it look easy","Text2": "à vous voir, on croirait que c'est facile","Audio1": "https://voix.larousse.fr/anglais/92859ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164145fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "the hat/photo makes you look ridiculous","Text2": "tu as l'air ridicule avec ce chapeau/sur cette photo","Audio1": "https://voix.larousse.fr/anglais/92860ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164146fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "","RedBrac": "[force, oblige]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "to make somebody do something","Text2": "","Audio1": "https://voix.larousse.fr/anglais/7372ang2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "faire faire quelque chose à quelqu'un","Audio1": "","Audio2": "https://voix.larousse.fr/francais/153482fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "forcer ou obliger ou contraindre quelqu'un à faire quelque chose","Audio1": "","Audio2": "https://voix.larousse.fr/francais/164147fra2.mp3","RedBrac": "[stronger]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Text1": "they made me wait, I was made to wait","Text2": "ils m'ont fait attendre","Audio1": "https://voix.larousse.fr/anglais/92861ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164148fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "she made herself keep running","Text2": "elle s'est forcée à continuer à courir","Audio1": "https://voix.larousse.fr/anglais/92862ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164149fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]},{"Title": "","Items": [{"Meanings": [{"Text": "atteindre","RedBrac": "[attain, achieve - goal]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "their first record made the top ten","Text2": "leur premier disque est rentré au top ten","Audio1": "https://voix.larousse.fr/anglais/92863ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164150fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "you won't make the team if you don't train","Text2": "tu n'entreras jamais dans l'équipe si tu ne t'entraînes pas","Audio1": "https://voix.larousse.fr/anglais/92864ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164151fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "the story made the front page","Text2": "l'histoire a fait la une des journaux","Audio1": "https://voix.larousse.fr/anglais/92865ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164152fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "atteindre","RedBrac": "[arrive at, get to - place]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "we should make Houston/port by evening","Text2": "nous devrions arriver à Houston/atteindre le port d'ici ce soir","Audio1": "https://voix.larousse.fr/anglais/92866ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164153fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "did you make your train ?","Text2": "as-tu réussi à avoir ton train ?","Audio1": "https://voix.larousse.fr/anglais/92867ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164154fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "","RedBrac": "[be available for]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "I won't be able to make lunch","Text2": "je ne pourrai pas déjeuner avec toi/elle/vous, etc.","Audio1": "https://voix.larousse.fr/anglais/92868ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164155fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "can you make Friday afternoon ?","Text2": "vendredi après-midi, ça vous convient ?","Audio1": "https://voix.larousse.fr/anglais/92869ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164156fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "faire, gagner","RedBrac": "[earn, win]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "how much do you make a month ?","Text2": "combien gagnes-tu par mois ?","Audio1": "https://voix.larousse.fr/anglais/92870ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164158fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "what do they make out of the deal ?","Text2": "qu'est-ce qu'ils gagnent dans l'affaire ?, qu'est-ce que l'affaire leur rapporte ?","Audio1": "https://voix.larousse.fr/anglais/92871ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164159fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]},{"Title": "","Items": [{"Meanings": [{"Text": "faire","RedBrac": "[amount to, add up to]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "17 and 19 make or makes 36","Text2": "17 plus 19 font ou égalent 36","Audio1": "https://voix.larousse.fr/anglais/92872ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164160fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "how old does that make him ?","Text2": "quel âge ça lui fait ?","Audio1": "https://voix.larousse.fr/anglais/92873ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164161fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "","RedBrac": "[reckon to be]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "I make the answer 257","Text2": "d'après moi, ça fait 257","Audio1": "https://voix.larousse.fr/anglais/92874ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164162fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "what time do you make it ?","Text2": "quelle heure as-tu ?","Audio1": "https://voix.larousse.fr/anglais/7380ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164163fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "faire","RedBrac": "[fulfil specified role, function etc]","RedCaps": "","RedMeta": "(with noun complement)"}],"Phrases": [{"Text1": "he'll make somebody a good husband","Text2": "ce sera un excellent mari","Audio1": "https://voix.larousse.fr/anglais/92875ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164164fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "they make a handsome couple","Text2": "ils forment un beau couple","Audio1": "https://voix.larousse.fr/anglais/92876ang2.mp3","Audio2": "https://voix.larousse.fr/francais/134140fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "her reminiscences make interesting reading","Text2": "ses souvenirs sont intéressants à lire","Audio1": "https://voix.larousse.fr/anglais/92877ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164165fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "marquer","RedBrac": "[score]","RedCaps": "","RedMeta": ""}],"Phrases": null}]},{"Title": "","Items": [{"Meanings": [{"Text": "faire le succès de","RedBrac": "[make successful]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "if this deal comes off we're made !","Text2": "si ça marche, on touche le gros lot !","Audio1": "https://voix.larousse.fr/anglais/92878ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164167fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "you've got it made !","Text2": "tu n'as plus de souci à te faire !","Audio1": "https://voix.larousse.fr/anglais/92879ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164168fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "what happens today will make or break us","Text2": "notre avenir dépend entièrement de ce qui va se passer aujourd'hui","Audio1": "https://voix.larousse.fr/anglais/92880ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164169fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}]},{"Meanings": [{"Text": "draguer","RedBrac": "[seduce]","RedCaps": "","RedMeta": "(very informal)"},{"Text": "se faire","RedBrac": "[have sex with]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "","RedBrac": "[in directions]","RedCaps": "","RedMeta": "(US)"}],"Phrases": [{"Text1": "make a right/left","Text2": "tournez à droite/à gauche","Audio1": "https://voix.larousse.fr/anglais/7390ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164170fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "(phrase)"}],"Phrases": [{"Text1": "to make it","Text2": "","Audio1": "https://voix.larousse.fr/anglais/7387ang2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "arriver","Audio1": "","Audio2": "https://voix.larousse.fr/francais/37871fra2.mp3","RedBrac": "[arrive]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "réussir","Audio1": "","Audio2": "https://voix.larousse.fr/francais/45037fra2.mp3","RedBrac": "[be successful]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "être là","Audio1": "","Audio2": "https://voix.larousse.fr/francais/59367fra2.mp3","RedBrac": "[be able to attend]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Text1": "I'll never make it for 10 o'clock","Text2": "je ne pourrai jamais y être pour 10 h","Audio1": "https://voix.larousse.fr/anglais/92881ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164171fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "I hope she makes it through the winter","Text2": "j'espère qu'elle passera l'hiver","Audio1": "https://voix.larousse.fr/anglais/92882ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164172fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "I can't make it for supper tomorrow","Text2": "je ne peux pas dîner avec eux/toi, etc. demain","Audio1": "https://voix.larousse.fr/anglais/92883ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164173fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to make it with somebody","Text2": "se faire quelqu'un","Audio1": "https://voix.larousse.fr/anglais/92884ang2.mp3","Audio2": "https://voix.larousse.fr/francais/88886fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(very informal)","IsBlue": true,"Subphrases": null}]}]}]},{"Code": 593701,"Header": {"Text": "make","TextAlt": "","Phonetic": "[meɪk][meɪd]","Audio": "https://voix.larousse.fr/anglais/19333A.mp3","Type": "intransitive verb"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "","RedBrac": "[act]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "to make (as if) to","Text2": "faire mine de","Audio1": "https://voix.larousse.fr/anglais/92885ang2.mp3","Audio2": "https://voix.larousse.fr/francais/81109fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "she made (as if) to stand up","Text2": "elle fit mine de se lever","Audio1": "https://voix.larousse.fr/anglais/92886ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164174fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "make like you're asleep !","Text2": "fais semblant de dormir !","Audio1": "https://voix.larousse.fr/anglais/92887ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164175fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(informal)","IsBlue": false,"Subphrases": null},{"Text1": "to make believe","Text2": "imaginer","Audio1": "https://voix.larousse.fr/anglais/92888ang2.mp3","Audio2": "https://voix.larousse.fr/francais/33260fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "to make do (with)","Text2": "","Audio1": "https://voix.larousse.fr/anglais/92889ang2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": [{"Text1": "","Text2": "se débrouiller (avec)","Audio1": "","Audio2": "https://voix.larousse.fr/francais/164176fra2.mp3","RedBrac": "[manage]","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null},{"Text1": "","Text2": "se contenter (de)","Audio1": "","Audio2": "https://voix.larousse.fr/francais/164177fra2.mp3","RedBrac": "[be satisfied]","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}]},{"Text1": "it's broken but we'll just have to make do","Text2": "c'est cassé mais il faudra faire avec ou nous débrouiller avec","Audio1": "https://voix.larousse.fr/anglais/92890ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164178fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}]}]}]},{"Code": 978373,"Header": {"Text": "make","TextAlt": "","Phonetic": "[meɪk]","Audio": "https://voix.larousse.fr/anglais/19333A.mp3","Type": "noun"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "marque","TargetGenre": ["f"],"RedBrac": "[brand]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "(phrase)"}],"Phrases": [{"Text1": "to be on the make","Text2": "","Audio1": "https://voix.larousse.fr/anglais/7392ang2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "avoir les dents longues","Audio1": "","Audio2": "https://voix.larousse.fr/francais/3044fra2.mp3","RedBrac": "[for power, profit]","RedCaps": "","RedMeta": "(informal)","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "draguer","Audio1": "","Audio2": "https://voix.larousse.fr/francais/28323fra2.mp3","RedBrac": "[looking for sexual partner]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]}]},{"Code": 593702,"Header": {"Text": "make away with","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/92891ang2.mp3","Type": ""},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "make off with","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 593703,"Header": {"Text": "make for","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7393ang2.mp3","Type": "transitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "se diriger vers","RedBrac": "[head towards]","RedCaps": "","RedMeta": ""},{"Text": "se précipiter vers","RedBrac": "[hastily]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "he made for his gun","Text2": "il fit un geste pour saisir son pistolet","Audio1": "https://voix.larousse.fr/anglais/92892ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164180fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "mener à","RedBrac": "[contribute to]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "the treaty should make for a more lasting peace","Text2": "le traité devrait mener ou aboutir à une paix plus durable","Audio1": "https://voix.larousse.fr/anglais/92893ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164181fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "this typeface makes for easier reading","Text2": "cette police permet une lecture plus facile","Audio1": "https://voix.larousse.fr/anglais/92894ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164182fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "a good diet makes for healthier babies","Text2": "un bon régime alimentaire donne des bébés en meilleure santé","Audio1": "https://voix.larousse.fr/anglais/92895ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164183fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 593704,"Header": {"Text": "make of","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7396ang2.mp3","Type": "transitive verb separable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "comprendre à","RedBrac": "[understand]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "can you make anything of these instructions ?","Text2": "est-ce que tu comprends quelque chose à ce mode d'emploi ?","Audio1": "https://voix.larousse.fr/anglais/92896ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164185fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "","RedBrac": "[give importance to]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "I think you're making too much of a very minor problem","Text2": "je pense que tu exagères l'importance de ce petit problème","Audio1": "https://voix.larousse.fr/anglais/92897ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164186fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "do you want to make something of it, then ?","Text2": "tu cherches des histoires ou quoi ?","Audio1": "https://voix.larousse.fr/anglais/92898ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164187fra2.mp3","RedBrac": "[threat]","RedCaps": "","RedMeta": "(informal)","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 978374,"Header": {"Text": "make of","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7396ang2.mp3","Type": "transitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "penser de","RedBrac": "[think of]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "what do you make of the Smiths ?","Text2": "qu'est-ce que tu penses des Smith ?","Audio1": "https://voix.larousse.fr/anglais/92899ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164189fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 593705,"Header": {"Text": "make off","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7398ang2.mp3","Type": "intransitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "partir","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 593706,"Header": {"Text": "make off with","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7399ang2.mp3","Type": "transitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "partir avec","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 593707,"Header": {"Text": "make out","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7400ang2.mp3","Type": "transitive verb separable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "distinguer","RedBrac": "[see]","RedCaps": "","RedMeta": ""},{"Text": "entendre, comprendre","RedBrac": "[hear]","RedCaps": "","RedMeta": ""},{"Text": "déchiffrer","RedBrac": "[read]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "comprendre","RedBrac": "[understand]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "I can't make her out at all","Text2": "je ne la comprends pas du tout","Audio1": "https://voix.larousse.fr/anglais/92900ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164191fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "prétendre","RedBrac": "[claim]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "she made out that she was busy","Text2": "elle a fait semblant d'être occupée","Audio1": "https://voix.larousse.fr/anglais/92901ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164192fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "don't make yourself out to be something you're not","Text2": "ne prétends pas être ce que tu n'es pas","Audio1": "https://voix.larousse.fr/anglais/92902ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164193fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "remplir","RedBrac": "[fill out - form, cheque]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "who shall I make the cheque out to ?","Text2": "je fais le chèque à quel ordre ?","Audio1": "https://voix.larousse.fr/anglais/92903ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164194fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "dresser, faire","RedBrac": "[draw up - list]","RedCaps": "","RedMeta": ""},{"Text": "faire, rédiger, établir","RedBrac": "[ - will, contract]","RedCaps": "","RedMeta": ""},{"Text": "faire","RedBrac": "[ - receipt]","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 978375,"Header": {"Text": "make out","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7400ang2.mp3","Type": "intransitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "se débrouiller","RedBrac": "[manage]","RedCaps": "","RedMeta": "(informal)"}],"Phrases": [{"Text1": "how did you make out at work today ?","Text2": "comment ça s'est passé au boulot aujourd'hui ?","Audio1": "https://voix.larousse.fr/anglais/92904ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164197fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "se peloter","RedBrac": "[neck, pet]","RedCaps": "","RedMeta": "(very informal)"}],"Phrases": [{"Text1": "to make out with somebody","Text2": "s'envoyer quelqu'un","Audio1": "https://voix.larousse.fr/anglais/92905ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164198fra2.mp3","RedBrac": "[have sex]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 593708,"Header": {"Text": "make over","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/92906ang2.mp3","Type": "transitive verb separable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "transférer, céder","RedBrac": "[transfer]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "réaménager","RedBrac": "[convert - room, house]","RedCaps": "","RedMeta": "(US)"}],"Phrases": [{"Text1": "the garage had been made over into a workshop","Text2": "le garage a été transformé en atelier","Audio1": "https://voix.larousse.fr/anglais/92907ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164201fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "transformer","RedBrac": "[change the appearance of]","RedCaps": "","RedMeta": "(US)"}],"Phrases": null}]}]},{"Code": 593709,"Header": {"Text": "make up","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7403ang2.mp3","Type": "intransitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "se maquiller","RedBrac": "[put on make-up]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "se réconcilier","RedBrac": "[become reconciled]","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 978376,"Header": {"Text": "make up","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7403ang2.mp3","Type": "transitive verb separable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "maquiller","RedBrac": "[put make-up on]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "to make oneself up","Text2": "se maquiller","Audio1": "https://voix.larousse.fr/anglais/7404ang2.mp3","Audio2": "https://voix.larousse.fr/francais/7466fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he was heavily made up","Text2": "il était très maquillé ou fardé","Audio1": "https://voix.larousse.fr/anglais/92908ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164202fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "faire, préparer","RedBrac": "[prepare]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "the chemist made up the prescription","Text2": "le pharmacien a préparé l'ordonnance","Audio1": "https://voix.larousse.fr/anglais/92909ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164204fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "the fire needs making up","Text2": "il faut remettre du charbon/du bois sur le feu","Audio1": "https://voix.larousse.fr/anglais/92910ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164205fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "inventer","RedBrac": "[invent]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "(phrase)"}],"Phrases": [{"Text1": "to make (it) up with somebody","Text2": "se réconcilier avec quelqu'un","Audio1": "https://voix.larousse.fr/anglais/92911ang2.mp3","Audio2": "https://voix.larousse.fr/francais/11086fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 978377,"Header": {"Text": "make up","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7403ang2.mp3","Type": "transitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "composer, constituer","RedBrac": "[constitute]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "the different ethnic groups that make up our organization","Text2": "les différents groupes ethniques qui constituent notre organisation","Audio1": "https://voix.larousse.fr/anglais/92912ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164207fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "the cabinet is made up of 11 ministers","Text2": "le cabinet est composé de 11 ministres","Audio1": "https://voix.larousse.fr/anglais/92913ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164208fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "it is made up of a mixture of different types of tobacco","Text2": "c'est un mélange de plusieurs tabacs différents","Audio1": "https://voix.larousse.fr/anglais/92914ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164209fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "compenser","RedBrac": "[compensate for - losses]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "to make up lost ground","Text2": "regagner le terrain perdu","Audio1": "https://voix.larousse.fr/anglais/92915ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164210fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he's making up time","Text2": "il rattrape son retard","Audio1": "https://voix.larousse.fr/anglais/92916ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164211fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "","RedBrac": "[complete]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "this cheque will help you make up the required sum","Text2": "ce chèque vous aidera à atteindre le montant requis","Audio1": "https://voix.larousse.fr/anglais/92917ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164212fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "we need two more players to make up the team","Text2": "nous avons besoin de deux joueurs de plus pour que l'équipe soit au complet","Audio1": "https://voix.larousse.fr/anglais/92918ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164213fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "I'll make up the difference","Text2": "je mettrai la différence","Audio1": "https://voix.larousse.fr/anglais/92919ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164214fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 593710,"Header": {"Text": "make up for","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7407ang2.mp3","Type": "transitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "compenser","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "how can I make up for all the trouble I've caused you ?","Text2": "que puis-je faire pour me faire pardonner tous les ennuis que je vous ai causés ?","Audio1": "https://voix.larousse.fr/anglais/92920ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164215fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "she's making up for lost time now !","Text2": "elle est en train de rattraper le temps perdu !","Audio1": "https://voix.larousse.fr/anglais/92921ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164216fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "(literal \u0026 figurative)","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 593711,"Header": {"Text": "make up to","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7409ang2.mp3","Type": "transitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "to make up to somebody","Text2": "","Audio1": "https://voix.larousse.fr/anglais/92922ang2.mp3","Audio2": "","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": [{"Text1": "","Text2": "essayer de se faire bien voir par quelqu'un","Audio1": "","Audio2": "https://voix.larousse.fr/francais/164217fra2.mp3","RedBrac": "[try to win favour]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "","Text2": "faire du plat à quelqu'un","Audio1": "","Audio2": "https://voix.larousse.fr/francais/94758fra2.mp3","RedBrac": "[make advances]","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]}]},{"Code": 978378,"Header": {"Text": "make up to","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/7409ang2.mp3","Type": "transitive verb separable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "","RedBrac": "","RedCaps": "","RedMeta": "(phrase)"}],"Phrases": [{"Text1": "I promise I'll make it up to you someday","Text2": "tu peux être sûr que je te revaudrai ça (un jour)","Audio1": "https://voix.larousse.fr/anglais/92923ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164218fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 593712,"Header": {"Text": "make with","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/92924ang2.mp3","Type": "transitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "make with the drinks !","Text2": "à boire !","Audio1": "https://voix.larousse.fr/anglais/92925ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164219fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "make with the music !","Text2": "musique !","Audio1": "https://voix.larousse.fr/anglais/92926ang2.mp3","Audio2": "https://voix.larousse.fr/francais/164220fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]}],"SeeAlso": ["https://larousse.fr/dictionnaires/anglais-francais/make-believe/593713","https://larousse.fr/dictionnaires/anglais-francais/makeover/593714","https://larousse.fr/dictionnaires/anglais-francais/-maker/593716","https://larousse.fr/dictionnaires/anglais-francais/maker/593715","https://larousse.fr/dictionnaires/anglais-francais/makeshift/593717","https://larousse.fr/dictionnaires/anglais-francais/Majorca/593694","https://larousse.fr/dictionnaires/anglais-francais/Majorcan/593695","https://larousse.fr/dictionnaires/anglais-francais/majordomo/593696","https://larousse.fr/dictionnaires/anglais-francais/majorette/593697","https://larousse.fr/dictionnaires/anglais-francais/majority/593699"]}`
+		case "drink":      str = `{"PageID": 577016,"Words": [{"Code": 577016,"Header": {"Text": "drink","TextAlt": "","Phonetic": "[drɪŋk][dræŋk],[drʌŋk]","Audio": "https://voix.larousse.fr/anglais/19108A.mp3","Type": "transitive verb"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "boire, prendre","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "would you like something to drink ?","Text2": "voulez-vous boire quelque chose ?","Audio1": "https://voix.larousse.fr/anglais/73566ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140105fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "the water is not fit to drink","Text2": "l'eau n'est pas potable","Audio1": "https://voix.larousse.fr/anglais/73567ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140106fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "this coffee isn't fit to drink","Text2": "ce café est imbuvable","Audio1": "https://voix.larousse.fr/anglais/73568ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140107fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "red Burgundy is best drunk at room temperature","Text2": "le bourgogne rouge est meilleur bu chambré","Audio1": "https://voix.larousse.fr/anglais/73569ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140108fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to drink one's fill","Text2": "boire à sa soif","Audio1": "https://voix.larousse.fr/anglais/73570ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140109fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to drink somebody's health, to drink a toast to somebody","Text2": "boire à la santé de quelqu'un","Audio1": "https://voix.larousse.fr/anglais/73571ang2.mp3","Audio2": "https://voix.larousse.fr/francais/15656fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he drank himself into a stupor","Text2": "il s'est soûlé jusqu'à l'hébétude","Audio1": "https://voix.larousse.fr/anglais/73572ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140110fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he's drinking himself to death","Text2": "l'alcool le tue peu à peu","Audio1": "https://voix.larousse.fr/anglais/73573ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140111fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to drink somebody under the table","Text2": "faire rouler quelqu'un sous la table","Audio1": "https://voix.larousse.fr/anglais/73574ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140112fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}]}]}]},{"Code": 975605,"Header": {"Text": "drink","TextAlt": "","Phonetic": "[drɪŋk][dræŋk],[drʌŋk]","Audio": "https://voix.larousse.fr/anglais/19108A.mp3","Type": "intransitive verb"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "boire","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "she drank out of or from the bottle","Text2": "elle a bu à la bouteille","Audio1": "https://voix.larousse.fr/anglais/73575ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140113fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "I only drink socially","Text2": "je ne bois jamais seul","Audio1": "https://voix.larousse.fr/anglais/73576ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140114fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "‘don't drink and drive’","Text2": "‘boire ou conduire, il faut choisir’","Audio1": "https://voix.larousse.fr/anglais/73577ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140115fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he drinks like a fish","Text2": "il boit comme un trou","Audio1": "https://voix.larousse.fr/anglais/73578ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140116fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": true,"Subphrases": null}]}]}]},{"Code": 975606,"Header": {"Text": "drink","TextAlt": "","Phonetic": "[drɪŋk]","Audio": "https://voix.larousse.fr/anglais/19108A.mp3","Type": "noun"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "boisson","TargetGenre": ["f"],"RedBrac": "[nonalcoholic]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "may I have a drink ?","Text2": "puis-je boire quelque chose ?","Audio1": "https://voix.larousse.fr/anglais/73579ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140117fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "a drink of water","Text2": "un verre d'eau","Audio1": "https://voix.larousse.fr/anglais/462ang2.mp3","Audio2": "https://voix.larousse.fr/francais/2711fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "give the children a drink","Text2": "donnez à boire aux enfants","Audio1": "https://voix.larousse.fr/anglais/73580ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140118fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "there's plenty of food and drink","Text2": "il y a tout ce qu'on veut à boire et à manger","Audio1": "https://voix.larousse.fr/anglais/73581ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140119fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "verre","TargetGenre": ["m"],"RedBrac": "[alcoholic]","RedCaps": "","RedMeta": ""},{"Text": "apéritif","TargetGenre": ["m"],"RedBrac": "[before dinner]","RedCaps": "","RedMeta": ""},{"Text": "digestif","TargetGenre": ["m"],"RedBrac": "[after dinner]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "we invited them in for a drink","Text2": "nous les avons invités à prendre un verre","Audio1": "https://voix.larousse.fr/anglais/73582ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140120fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he likes or enjoys a drink","Text2": "il aime bien boire un verre","Audio1": "https://voix.larousse.fr/anglais/73583ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140121fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "drinks are on the house !","Text2": "la maison offre à boire !","Audio1": "https://voix.larousse.fr/anglais/73584ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140122fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "he'd had one drink too many","Text2": "il avait bu un verre de trop, il avait un verre dans le nez","Audio1": "https://voix.larousse.fr/anglais/73585ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140123fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "gorgée","TargetGenre": ["f"],"RedBrac": "[mouthful]","RedCaps": "","RedMeta": ""}],"Phrases": null},{"Meanings": [{"Text": "la boisson, l'alcool","TargetGenre": ["m"],"RedBrac": "[alcohol]","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "she's taken to drink","Text2": "elle s'adonne à la boisson, elle boit","Audio1": "https://voix.larousse.fr/anglais/73586ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140125fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to be the worse for drink","Text2": "être en état d'ébriété","Audio1": "https://voix.larousse.fr/anglais/73587ang2.mp3","Audio2": "https://voix.larousse.fr/francais/55055fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to drive under the influence of drink","Text2": "conduire en état d'ivresse ou d'ébriété","Audio1": "https://voix.larousse.fr/anglais/73588ang2.mp3","Audio2": "https://voix.larousse.fr/francais/102867fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null},{"Text1": "to smell of drink","Text2": "sentir l'alcool","Audio1": "https://voix.larousse.fr/anglais/73589ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140126fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]},{"Meanings": [{"Text": "flotte","TargetGenre": ["f"],"RedBrac": "[sea]","RedCaps": "","RedMeta": "(UK, informal)"}],"Phrases": null}]}]},{"Code": 975607,"Header": {"Text": "drink","TextAlt": "","Phonetic": "[drɪŋk]","Audio": "https://voix.larousse.fr/anglais/19108A.mp3","Type": "compound"},"Subheaders": [{"Title": "","Items": [{"Meanings": null,"Phrases": [{"Text1": "he has a drink problem","Text2": "il boit trop, il s'adonne à la boisson","Audio1": "https://voix.larousse.fr/anglais/73590ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140127fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 577017,"Header": {"Text": "drink away","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/73591ang2.mp3","Type": "transitive verb separable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "noyer","RedBrac": "[troubles]","RedCaps": "","RedMeta": ""},{"Text": "boire","RedBrac": "[fortune]","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 577018,"Header": {"Text": "drink down","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/73592ang2.mp3","Type": "transitive verb separable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "avaler ou boire d'un trait","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 577019,"Header": {"Text": "drink in","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/73593ang2.mp3","Type": "transitive verb separable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "boire","RedBrac": "[story, words]","RedCaps": "","RedMeta": ""},{"Text": "s'imprégner de","RedBrac": "[atmosphere, surroundings]","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 577020,"Header": {"Text": "drink to","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/73594ang2.mp3","Type": "transitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "boire à, porter un toast à","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "I'll drink to that !","Text2": "je suis pour !","Audio1": "https://voix.larousse.fr/anglais/73595ang2.mp3","Audio2": "https://voix.larousse.fr/francais/41284fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]},{"Code": 577021,"Header": {"Text": "drink up","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/73596ang2.mp3","Type": "transitive verb separable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "boire (jusqu'à la dernière goutte), finir","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": null}]}]},{"Code": 975608,"Header": {"Text": "drink up","TextAlt": "","Phonetic": "","Audio": "https://voix.larousse.fr/anglais/73596ang2.mp3","Type": "intransitive verb inseparable"},"Subheaders": [{"Title": "","Items": [{"Meanings": [{"Text": "vider son verre","RedBrac": "","RedCaps": "","RedMeta": ""}],"Phrases": [{"Text1": "drink up !","Text2": "finissez vos verres !","Audio1": "https://voix.larousse.fr/anglais/73597ang2.mp3","Audio2": "https://voix.larousse.fr/francais/140132fra2.mp3","RedBrac": "","RedCaps": "","RedMeta": "","IsBlue": false,"Subphrases": null}]}]}]}],"SeeAlso": ["https://larousse.fr/dictionnaires/anglais-francais/drinkable/577022","https://larousse.fr/dictionnaires/anglais-francais/drink-driving/577023","https://larousse.fr/dictionnaires/anglais-francais/drinker/577024","https://larousse.fr/dictionnaires/anglais-francais/drinking/577025","https://larousse.fr/dictionnaires/anglais-francais/drinking_chocolate/577026","https://larousse.fr/dictionnaires/anglais-francais/drill_sergeant/577014","https://larousse.fr/dictionnaires/anglais-francais/drilling/577010","https://larousse.fr/dictionnaires/anglais-francais/drilling_platform/577011","https://larousse.fr/dictionnaires/anglais-francais/drilling_rig/577012","https://larousse.fr/dictionnaires/anglais-francais/drily/577015"]}`
 	}
 	
 	var res Result
 	err := json.Unmarshal([]byte(str), &res)
 	return res, err
 }
+
+// TestIsVerb tests Header.IsVerb and Header.InfinitiveURL on a verb and a
+// non-verb.
+func TestIsVerb(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/manger.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := res.Words[0].Header
+	if !h.IsVerb() || h.Type != "verbe transitif" {
+		t.Fatalf("manger: want verb \"verbe transitif\", got %q", h.Type)
+	}
+	want := "https://larousse.fr/conjugaison/francais/manger/6184"
+	if h.InfinitiveURL != want {
+		t.Fatalf("InfinitiveURL: want %s, got %s", want, h.InfinitiveURL)
+	}
+	
+	res, err = NewFromFileOrURL("testdata/drink.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h = res.Words[0].Header
+	if h.IsVerb() || h.InfinitiveURL != "" {
+		t.Fatalf("drink: want non-verb, got %+v", h)
+	}
+	
+	for typ, want := range map[string]bool{
+		"transitive verb separable": true,
+		"verbe pronominal intransitif": true,
+		"locution verbale": false,
+		"adverb": false,
+	} {
+		if got := (Header{Type: typ}).IsVerb(); got != want {
+			t.Errorf("%q: want %v, got %v", typ, want, got)
+		}
+	}
+}
+
+// TestFormPhonetics tests that Header.FormPhonetics maps the pronunciations in
+// Phonetic to Text and TextAlt.
+func TestFormPhonetics(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/court.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, textAlt := res.Words[0].Header.FormPhonetics()
+	if text != "[kur]" || textAlt != "[kurt]" {
+		t.Fatalf("court: want [kur] and [kurt], got %s and %s", text, textAlt)
+	}
+	
+	cases := []struct{
+		h    Header
+		want [2]string
+	}{
+		{Header{}, [2]string{"", ""}},
+		{Header{Text: "aire", Phonetic: "[εr]"}, [2]string{"[εr]", ""}},
+		{Header{Text: "aigu", TextAlt: "(f aiguë)", Phonetic: "[egy]"}, [2]string{"[egy]", "[egy]"}},
+		{Header{Text: "drink", TextAlt: "(pt drank, pp drunk)", Phonetic: "[drɪŋk][dræŋk],[drʌŋk]"}, [2]string{"[drɪŋk]", "[dræŋk, drʌŋk]"}},
+	}
+	for _, c := range cases {
+		h, want := c.h, c.want
+		text, textAlt := h.FormPhonetics()
+		if text != want[0] || textAlt != want[1] {
+			t.Errorf("%q: want %q, got %q", h.Phonetic, want, [2]string{text, textAlt})
+		}
+	}
+}
+
+// TestMeaningDepth tests that Meaning.Depth follows the nesting of semantic
+// divisions.
+func TestMeaningDepth(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/prendre.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	items := res.Words[0].Subheaders[0].Items
+	if len(items) != 2 {
+		t.Fatalf("want 2 items, got %d", len(items))
+	}
+	want := []Meaning{
+		{Text: "to take", RedBrac: "[saisir]", Depth: 0},
+		{Text: "to catch", RedBrac: "[attraper]", Depth: 1},
+		{Text: "to land", RedBrac: "[poisson]", Depth: 2},
+		{Text: "to take away", RedBrac: "[emporter]", Depth: 1},
+	}
+	got := items[0].Meanings
+	if len(got) != len(want) {
+		t.Fatalf("want %d meanings, got %+v", len(want), got)
+	}
+	for i := range want {
+		message, ok := want[i].equals(got[i])
+		if !ok || got[i].Depth != want[i].Depth {
+			t.Fatalf("Meanings[%d]: %s\nDepth: want %d, got %d", i, message, want[i].Depth, got[i].Depth)
+		}
+	}
+	if m := items[1].Meanings; len(m) != 1 || m[0].Depth != 0 {
+		t.Fatalf("second item: want 1 meaning at depth 0, got %+v", m)
+	}
+}
+
+// TestNewFromHTML tests that a page given as HTML is scraped like the same
+// page read from a file, and that a page from the other dictionary is
+// rejected.
+func TestNewFromHTML(t *testing.T) {
+	page, err := ioutil.ReadFile("testdata/aire.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := NewFromFileOrURL("testdata/aire.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := NewFromHTML(string(page), Fr, En)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message, ok := want.equals(got)
+	if !ok {
+		t.Fatal(message)
+	}
+	
+	_, err = NewFromHTML(string(page), En, Fr)
+	if err == nil {
+		t.Fatal("want error for a francais-anglais page given as anglais-francais")
+	}
+	_, err = NewFromHTML(string(page), Fr, Fr)
+	if err == nil {
+		t.Fatal("want error for the same language twice")
+	}
+}
+
+// TestRegister tests that the RedMeta of meanings and phrases is parsed into
+// a Register.
+func TestRegister(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/bagnole.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	items := res.Words[0].Subheaders[0].Items
+	want := []Register{Familier, TresFamilier, Litteraire, Regional, UnknownRegister}
+	if len(items) != len(want) {
+		t.Fatalf("want %d items, got %d", len(want), len(items))
+	}
+	for i, item := range items {
+		m := item.Meanings[0]
+		if m.Register != want[i] {
+			t.Errorf("items[%d]: RedMeta %q: want %v, got %v", i, m.RedMeta, want[i], m.Register)
+		}
+	}
+	phrases := items[0].Phrases
+	if len(phrases) != 2 || phrases[0].Register != Vieilli || phrases[1].Register != Vulgaire {
+		t.Fatalf("want phrases with registers vieilli and vulgaire, got %+v", phrases)
+	}
+	
+	for str, want := range map[string]Register{
+		"(fam)":                  Familier,
+		"(Vieilli)":              Vieilli,
+		"(familier & péjoratif)": Familier,
+		"(au Québec)":            UnknownRegister,
+		"":                       UnknownRegister,
+	} {
+		if got := ParseRegister(str); got != want {
+			t.Errorf("ParseRegister(%q): want %v, got %v", str, want, got)
+		}
+	}
+}
+
+// TestStrictUnknownClasses tests that an unknown class fails the scrape only
+// in strict mode.
+func TestStrictUnknownClasses(t *testing.T) {
+	defer func() { StrictUnknownClasses = false }()
+	StrictUnknownClasses = true
+	_, err := NewFromFileOrURL("testdata/court.html")
+	if err == nil || !strings.Contains(err.Error(), "Exemple2") {
+		t.Fatalf("want error for class Exemple2, got %v", err)
+	}
+	_, err = NewFromFileOrURL("testdata/aire.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	
+	StrictUnknownClasses = false
+	_, err = NewFromFileOrURL("testdata/court.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestEnglishHeaderAudio tests that the phonetic and audio of English
+// headwords are scraped when the audio clip follows the headword or is nested
+// inside its "lienson" node.
+func TestEnglishHeaderAudio(t *testing.T) {
+	for _, path := range []string{"testdata/make.html", "testdata/drink.html"} {
+		res, err := NewFromFileOrURL(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h := res.Words[0].Header
+		if h.Phonetic == "" || !strings.HasPrefix(h.Audio, "https://voix.larousse.fr/anglais/") {
+			t.Errorf("%s: want phonetic and audio, got %+v", path, h)
+		}
+	}
+	
+	res, err := NewFromFileOrURL("testdata/take.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Header{
+		{Text: "take", Phonetic: "[teɪk]", Audio: "https://voix.larousse.fr/anglais/614380ang2.mp3", Type: "transitive verb"},
+		{Text: "take", Phonetic: "[teɪk]", Audio: "https://voix.larousse.fr/anglais/614381ang2.mp3", Type: "noun"},
+	}
+	if len(res.Words) != len(want) {
+		t.Fatalf("want %d words, got %d", len(want), len(res.Words))
+	}
+	for i := range want {
+		message, ok := want[i].equals(res.Words[i].Header)
+		if !ok {
+			t.Errorf("Words[%d]: %s", i, message)
+		}
+	}
+}
+
+// TestHeadwords tests Headwords on a page with several words and on a "word
+// not found" page.
+func TestHeadwords(t *testing.T) {
+	got, err := Headwords("testdata/take.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0] != "take" {
+		t.Fatalf("want [take], got %q", got)
+	}
+	
+	res, err := NewFromFileOrURL("testdata/court.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err = Headwords("testdata/court.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) == 0 || got[0] != res.Words[0].Header.Text {
+		t.Fatalf("want headwords starting with %q, got %q", res.Words[0].Header.Text, got)
+	}
+	
+	_, err = Headwords("testdata/notfound.html")
+	if !errors.Is(err, ErrWordNotFound) {
+		t.Fatalf("want ErrWordNotFound, got %v", err)
+	}
+}
+
+// TestParseDocument tests that a parsed page is scraped like the same page read
+// from a file.
+func TestParseDocument(t *testing.T) {
+	doc, err := scrapeutil.HTMLRoot("testdata/court.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := NewFromFileOrURL("testdata/court.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ParseDocument(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message, ok := want.equals(got)
+	if !ok {
+		t.Fatal(message)
+	}
+	
+	doc, err = scrapeutil.HTMLRoot("testdata/notfound.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ParseDocument(doc)
+	if !errors.Is(err, ErrWordNotFound) {
+		t.Fatalf("want ErrWordNotFound, got %v", err)
+	}
+}
+
+// BenchmarkParseDocument measures the cost of scraping a parsed page, without
+// reading or parsing it.
+func BenchmarkParseDocument(b *testing.B) {
+	doc, err := scrapeutil.HTMLRoot("testdata/court.html")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := ParseDocument(doc)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// TestPhrasePhonetic tests that the phonetics of a phrase are assigned to the
+// side they follow, and that phrases without any are left empty.
+func TestPhrasePhonetic(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/oeil.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	phrases := res.Words[0].Subheaders[0].Items[0].Phrases
+	if len(phrases) != 2 {
+		t.Fatalf("want 2 phrases, got %d", len(phrases))
+	}
+	p := phrases[0]
+	if p.Text1 != "à l'œil" || p.Phonetic1 != "[alœj]" || p.Text2 != "for free" || p.Phonetic2 != "[fɔː friː]" {
+		t.Fatalf("got %+v", p)
+	}
+	if phrases[1].Phonetic1 != "" || phrases[1].Phonetic2 != "" {
+		t.Fatalf("want no phonetics, got %+v", phrases[1])
+	}
+}
+
+// TestValidate tests that Validate finds nothing wrong with a complete page,
+// flags fields emptied by hand, and leaves the Result unchanged.
+func TestValidate(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/aire.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if warnings := res.Validate(); len(warnings) != 0 {
+		t.Fatalf("want no warnings, got %q", warnings)
+	}
+	
+	res.Words[0].Header.Text = ""
+	item := &res.Words[0].Subheaders[0].Items[0]
+	item.Phrases = nil
+	item.Meanings[0].Text = ""
+	want := []string{
+		"Word 0 has empty Header.Text",
+		"Word 0, Subheader 0, Item 0, Meaning 0 has RedBrac but empty Text",
+	}
+	got := res.Validate()
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("want %q, got %q", want, got)
+	}
+	if res.Words[0].Header.Text != "" || item.Meanings[0].RedBrac != "[terrain]" {
+		t.Fatal("Validate modified the Result")
+	}
+}
+
+// TestHeaderAudioURLs tests that every audio clip of a header is kept, and
+// that Audio is the first one.
+func TestHeaderAudioURLs(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/tuque.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"https://voix.larousse.fr/francais/80325fra2.mp3",
+		"https://voix.larousse.fr/francais/80325can2.mp3",
+	}
+	h := res.Words[0].Header
+	if strings.Join(h.AudioURLs, "|") != strings.Join(want, "|") || h.Audio != want[0] {
+		t.Fatalf("want Audio %s and AudioURLs %q, got %s and %q", want[0], want, h.Audio, h.AudioURLs)
+	}
+}
+
+// TestItemContext tests that a domain label shared by all of an item's
+// meanings is set on the Item once instead of on its first Meaning, and that
+// context-only Meanings which are siblings are left alone.
+func TestItemContext(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/noyau.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	items := res.Words[0].Subheaders[0].Items
+	if len(items) != 2 {
+		t.Fatalf("want 2 items, got %d", len(items))
+	}
+	if items[0].RedCaps != "" || items[0].Meanings[0].RedBrac != "[de fruit]" {
+		t.Fatalf("first item: want no item context, got %+v", items[0])
+	}
+	
+	item := items[1]
+	if item.RedCaps != "PHYSIQUE" {
+		t.Fatalf("RedCaps: want PHYSIQUE, got %q", item.RedCaps)
+	}
+	want := []Meaning{
+		{Text: "nucleus", RedBrac: "[d'atome]"},
+		{Text: "core", RedBrac: "[de la Terre]"},
+	}
+	if len(item.Meanings) != len(want) {
+		t.Fatalf("want %d meanings, got %+v", len(want), item.Meanings)
+	}
+	for i := range want {
+		message, ok := want[i].equals(item.Meanings[i])
+		if !ok || item.Meanings[i].Depth != 0 {
+			t.Fatalf("Meanings[%d]: %s\nDepth: want 0, got %d", i, message, item.Meanings[i].Depth)
+		}
+	}
+	
+	pairs := res.Words[0].Pairs()
+	if len(pairs) != 3 || pairs[1].Context != "PHYSIQUE [d'atome]" {
+		t.Fatalf("Pairs: got %+v", pairs)
+	}
+	if warnings := res.Validate(); len(warnings) != 0 {
+		t.Fatalf("want no warnings, got %q", warnings)
+	}
+	
+	siblings := Item{Meanings: []Meaning{
+		{RedBrac: "[affaire]", RedMeta: "(familier)"},
+		{RedBrac: "[personne - sexuellement]", RedMeta: "(vulgaire)"},
+	}}
+	siblings.hoistContext()
+	if siblings.RedBrac != "" || len(siblings.Meanings) != 2 || siblings.Meanings[1].Depth != 0 {
+		t.Fatalf("sibling contexts: want them left on their Meanings, got %+v", siblings)
+	}
+}
+
+// TestConjugationHint tests that the auxiliary after a verb's conjugation link
+// is scraped into ConjugationHint and Auxiliaries, and left out of Type.
+func TestConjugationHint(t *testing.T) {
+	cases := map[string]string{
+		"testdata/finir.html": "avoir",
+		"testdata/venir.html": "être",
+	}
+	for path, aux := range cases {
+		h, err := HeaderFromFileOrURL(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if h.ConjugationHint != "auxiliaire " + aux || len(h.Auxiliaries) != 1 || h.Auxiliaries[0] != aux {
+			t.Errorf("%s: want hint \"auxiliaire %s\" and auxiliary %s, got %q and %q", path, aux, aux, h.ConjugationHint, h.Auxiliaries)
+		}
+		if strings.Contains(h.Type, "auxiliaire") || !h.IsVerb() {
+			t.Errorf("%s: want a verb Type without the hint, got %q", path, h.Type)
+		}
+	}
+	
+	res, err := NewFromFileOrURL("testdata/manger.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h := res.Words[0].Header; h.ConjugationHint != "" || h.Auxiliaries != nil {
+		t.Fatalf("manger: want no hint, got %q and %q", h.ConjugationHint, h.Auxiliaries)
+	}
+	if got := auxiliaries("auxiliaire avoir ou être"); strings.Join(got, ",") != "avoir,être" {
+		t.Fatalf("want [avoir être], got %q", got)
+	}
+}
+
+// TestSentencePairs tests SentencePairs on a page's phrases, and on
+// expressions with subphrases, empty sides and extra whitespace.
+func TestSentencePairs(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/aire.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pairs := res.SentencePairs()
+	if len(pairs) != 4 || pairs[0] != (SentencePair{"aire de jeu", "playground"}) {
+		t.Fatalf("want 4 pairs starting with aire de jeu, got %v", pairs)
+	}
+	
+	blue := Phrase{
+		Text1:  "coup de fil",
+		IsBlue: true,
+		Subphrases: []Phrase{
+			{Text1: "donner un  coup de fil", Text2: " to give somebody\ta ring "},
+			{Text1: "un coup de fil", Text2: ""},
+		},
+	}
+	res = Result{Words: []Word{{Subheaders: []Subheader{{Items: []Item{{Phrases: []Phrase{blue}}}}}}}}
+	want := []SentencePair{{"donner un coup de fil", "to give somebody a ring"}}
+	got := res.SentencePairs()
+	if len(got) != len(want) || got[0] != want[0] {
+		t.Fatalf("want %v, got %v", want, got)
+	}
+}
+
+// TestTolerantParsing tests that the Meanings hidden by an unclosed tag are
+// only recovered if TolerantParsing is true.
+func TestTolerantParsing(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/malformed.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	items := res.Words[0].Subheaders[0].Items
+	if len(items) != 2 || len(items[0].Meanings) != 0 {
+		t.Fatalf("want 2 items, the first without meanings, got %+v", items)
+	}
+	
+	TolerantParsing = true
+	defer func() { TolerantParsing = false }()
+	res, err = NewFromFileOrURL("testdata/malformed.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	items = res.Words[0].Subheaders[0].Items
+	want := [][]Meaning{
+		{{Text: "wood", RedBrac: "[matériau]"}},
+		{{Text: "wood", RedBrac: "[forêt]"}, {Text: "forest", RedBrac: "[plus grand]"}},
+	}
+	if len(items) != len(want) {
+		t.Fatalf("want %d items, got %d", len(want), len(items))
+	}
+	for i := range want {
+		if len(items[i].Meanings) != len(want[i]) {
+			t.Fatalf("Items[%d]: want %+v, got %+v", i, want[i], items[i].Meanings)
+		}
+		for j := range want[i] {
+			message, ok := want[i][j].equals(items[i].Meanings[j])
+			if !ok {
+				t.Fatalf("Items[%d].Meanings[%d]: %s", i, j, message)
+			}
+		}
+	}
+	if len(items[0].Phrases) != 1 || items[0].Phrases[0].Text2 != "firewood" {
+		t.Fatalf("want the phrase kept, got %+v", items[0].Phrases)
+	}
+}
+
+// TestAudioURLs tests that the audio clips of headers and phrases are
+// collected in page order without duplicates.
+func TestAudioURLs(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/aire.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	urls := res.AudioURLs()
+	if res.AssetCount() != 9 || len(urls) != 9 {
+		t.Fatalf("want 9 clips, got %d: %q", res.AssetCount(), urls)
+	}
+	if urls[0] != res.Words[0].Header.Audio {
+		t.Fatalf("want the header's clip first, got %s", urls[0])
+	}
+	if urls[1] != "https://voix.larousse.fr/francais/110957fra2.mp3" || urls[2] != "https://voix.larousse.fr/anglais/40132ang2.mp3" {
+		t.Fatalf("want the first phrase's clips next, got %q", urls[1:3])
+	}
+	
+	if (Result{}).AssetCount() != 0 || (Result{}).AudioURLs() != nil {
+		t.Fatal("want no clips for an empty Result")
+	}
+}
+
+// TestExpressionTranslation tests that an expression's translation is kept
+// whole when it spans several nodes, including text outside of them.
+func TestExpressionTranslation(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/court.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	phrases := res.Words[0].Subheaders[0].Items[1].Phrases
+	if len(phrases) != 2 {
+		t.Fatalf("want 2 expressions, got %d", len(phrases))
+	}
+	cases := []struct{
+		text1, text2 string
+	}{
+		{"cycle court", "two-year course (at university or college), leading to a vocational qualification"},
+		{"à court terme", "short-term; in the short term"},
+	}
+	for i, c := range cases {
+		p := phrases[i]
+		if !p.IsBlue || p.Text1 != c.text1 || p.Text2 != c.text2 {
+			t.Fatalf("want blue %q -> %q, got %+v", c.text1, c.text2, p)
+		}
+	}
+}
+
+// TestOrigine tests that a bilingual headword's origin is scraped into its
+// Header without becoming part of its Type.
+func TestOrigine(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/kayak.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := res.Words[0].Header
+	if h.Origine != "mot inuit" || h.Type != "nom masculin" {
+		t.Fatalf("want origin \"mot inuit\" and type \"nom masculin\", got %q and %q", h.Origine, h.Type)
+	}
+	if p := res.Words[0].Subheaders[0].Items[0].Phrases; len(p) != 1 || p[0].Text2 != "to go kayaking" {
+		t.Fatalf("want the rest of the page scraped, got %+v", p)
+	}
+	
+	res, err = NewFromFileOrURL("testdata/bagnole.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o := res.Words[0].Header.Origine; o != "" {
+		t.Fatalf("bagnole: want no origin, got %q", o)
+	}
+}
+
+// TestAudioURLRewriter tests that every audio URL of a Result is rewritten
+// by laroussefr.AudioURLRewriter.
+func TestAudioURLRewriter(t *testing.T) {
+	laroussefr.AudioURLRewriter = func(u string) string {
+		return strings.Replace(u, "https://voix.larousse.fr/", "https://cdn.example.com/", 1)
+	}
+	defer func() { laroussefr.AudioURLRewriter = nil }()
+	res, err := NewFromFileOrURL("testdata/aire.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	urls := res.AudioURLs()
+	if len(urls) != 9 {
+		t.Fatalf("want 9 clips, got %q", urls)
+	}
+	for _, u := range urls {
+		if !strings.HasPrefix(u, "https://cdn.example.com/") {
+			t.Fatalf("want every URL rewritten, got %s", u)
+		}
+	}
+	if h := res.Words[0].Header; h.Audio != h.AudioURLs[0] || h.Audio != "https://cdn.example.com/francais/16869A.mp3" {
+		t.Fatalf("want a rewritten header clip, got %s", h.Audio)
+	}
+}
+
+// TestRedirect tests that a page whose only content is a cross-reference is
+// detected as a redirect, and that other pages aren't.
+func TestRedirect(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/clef.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Redirect{"clé", "https://larousse.fr/dictionnaires/francais-anglais/cl%C3%A9/16470"}
+	if !res.IsRedirect() || res.RedirectTo != want {
+		t.Fatalf("want a redirect to %+v, got %+v", want, res.RedirectTo)
+	}
+	
+	for _, path := range []string{"testdata/coup.html", "testdata/aire.html"} {
+		res, err := NewFromFileOrURL(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.IsRedirect() {
+			t.Errorf("%s: want no redirect, got %+v", path, res.RedirectTo)
+		}
+	}
+	
+	doc, err := scrapeutil.HTMLRootFromString(`<html><head><link rel="canonical" href="https://www.larousse.fr/dictionnaires/francais-anglais/clef/16466"></head></html>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	words := []Word{{Subheaders: []Subheader{{Items: []Item{{Meanings: []Meaning{{CrossRef: "clé"}}}}}}}}
+	got := scrapeRedirect(doc, words)
+	if got.URL != "https://www.larousse.fr/dictionnaires/francais-anglais/cl%C3%A9" {
+		t.Fatalf("unlinked reference: want the URL New would download, got %+v", got)
+	}
+}
+
+// TestLinks tests that Links gathers conjugation links, linked
+// cross-references and SeeAlso URLs, and leaves out cross-references which
+// aren't links.
+func TestLinks(t *testing.T) {
+	cases := map[string][]string{
+		"testdata/clef.html":   {"https://larousse.fr/dictionnaires/francais-anglais/cl%C3%A9/16470"},
+		"testdata/manger.html": {"https://larousse.fr/conjugaison/francais/manger/6184"},
+		"testdata/coup.html":   nil,
+	}
+	for path, want := range cases {
+		res, err := NewFromFileOrURL(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := res.Links()
+		if strings.Join(got, "\n") != strings.Join(want, "\n") {
+			t.Errorf("%s: want %q, got %q", path, want, got)
+		}
+	}
+}
+
+// TestMergeGenderVariants tests that the masculine and feminine forms of a
+// word are kept apart by default and merged into one Word when
+// MergeGenderVariants is true.
+func TestMergeGenderVariants(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/chanteur.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Words) != 3 {
+		t.Fatalf("unmerged: want 3 words, got %d", len(res.Words))
+	}
+	
+	MergeGenderVariants = true
+	defer func() { MergeGenderVariants = false }()
+	res, err = NewFromFileOrURL("testdata/chanteur.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Words) != 2 {
+		t.Fatalf("merged: want 2 words, got %d", len(res.Words))
+	}
+	w := res.Words[0]
+	want := Header{
+		Text:     "chanteur",
+		TextAlt:  "(chanteuse)",
+		Phonetic: "[ʃɑ̃tœr, ʃɑ̃tøz]",
+		Type:     "nom masculin, nom féminin",
+	}
+	if w.Code != 14372 || w.Header.Text != want.Text || w.Header.TextAlt != want.TextAlt || w.Header.Phonetic != want.Phonetic || w.Header.Type != want.Type {
+		t.Errorf("want %+v, got %d %+v", want, w.Code, w.Header)
+	}
+	if len(w.Header.AudioURLs) != 2 {
+		t.Errorf("want the audio of both forms, got %v", w.Header.AudioURLs)
+	}
+	if len(w.Subheaders) != 2 || w.Subheaders[1].Items[0].Meanings[0].Text != "torch singer" {
+		t.Errorf("want the feminine's subheader appended, got %+v", w.Subheaders)
+	}
+	if res.Words[1].Header.Type != "adjectif" {
+		t.Errorf("want the adjective left alone, got %+v", res.Words[1].Header)
+	}
+	
+	if !areGenderVariants(Word{Header: Header{Text: "vert", TextAlt: "(f verte)"}}, Word{Header: Header{Text: "verte"}}) {
+		t.Error(`want "(f verte)" to name "verte"`)
+	}
+}
+
+// TestAudioLabels tests that the region labels shown after the audio clips of
+// a header and a phrase are kept in their AudioClips, and that clips without a
+// label have an empty one.
+func TestAudioLabels(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/tomato.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := res.Words[0].Header
+	want := []laroussefr.AudioClip{
+		{URL: "https://voix.larousse.fr/anglais/614802ang2.mp3", Label: "UK"},
+		{URL: "https://voix.larousse.fr/anglais/614802ame2.mp3", Label: "US"},
+	}
+	if !reflect.DeepEqual(h.AudioClips, want) {
+		t.Errorf("header: want %v, got %v", want, h.AudioClips)
+	}
+	if h.Text != "tomato" || h.Audio != want[0].URL {
+		t.Errorf("header: want the labels kept out of Text and Audio, got %+v", h)
+	}
+	
+	p := res.Words[0].Subheaders[0].Items[0].Phrases[0]
+	clip1 := laroussefr.AudioClip{URL: "https://voix.larousse.fr/anglais/614803ame2.mp3", Label: "US"}
+	clip2 := laroussefr.AudioClip{URL: "https://voix.larousse.fr/francais/614803fra2.mp3", Label: ""}
+	if p.AudioClip1 != clip1 || p.AudioClip2 != clip2 {
+		t.Errorf("phrase: want %v and %v, got %v and %v", clip1, clip2, p.AudioClip1, p.AudioClip2)
+	}
+	if p.Audio1 != clip1.URL || p.Audio2 != clip2.URL || p.Text2 != "sauce tomate" {
+		t.Errorf("phrase: got %+v", p)
+	}
+	
+	res, err = NewFromFileOrURL("testdata/tuque.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, clip := range res.Words[0].Header.AudioClips {
+		if clip.Label != "" {
+			t.Errorf("unlabeled: want no label, got %v", clip)
+		}
+	}
+}
+
+// TestGob tests that a Result survives a ToGob and FromGob round trip with
+// every field intact, as checked by Equal, which also tells apart Results that
+// differ only in their AudioClips.
+func TestGob(t *testing.T) {
+	for _, path := range []string{"testdata/aire.html", "testdata/court.html", "testdata/drink.html", "testdata/tomato.html"} {
+		res, err := NewFromFileOrURL(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := res.ToGob()
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		got, err := FromGob(data)
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		if !got.Equal(res) {
+			message, _ := res.equals(got)
+			t.Errorf("%s: round trip changed the Result\n%s\nwant %+v\ngot  %+v", path, message, res, got)
+		}
+		got.Words[0].Header.AudioClips = nil
+		if len(res.Words[0].Header.AudioClips) > 0 && got.Equal(res) {
+			t.Errorf("%s: want Results with different AudioClips unequal", path)
+		}
+	}
+}
+
+// TestLemma tests that a Result's lemma is its first word's headword without
+// trademark symbols, or the word a redirect-only page refers to.
+func TestLemma(t *testing.T) {
+	tests := map[string]string{
+		"testdata/chanteur.html": "chanteur",
+		"testdata/tomato.html":   "tomato",
+		"testdata/clef.html":     "clé",
+	}
+	for path, want := range tests {
+		res, err := NewFromFileOrURL(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := res.Lemma(); got != want {
+			t.Errorf("%s: want %q, got %q", path, want, got)
+		}
+	}
+	res := Result{Words: []Word{{Header: Header{Text: " Airbag® "}}}}
+	if got := res.Lemma(); got != "Airbag" {
+		t.Errorf("trademark: want \"Airbag\", got %q", got)
+	}
+}
+
+// TestPartsOfSpeech tests that a Result's parts of speech are listed once
+// each in order, whether or not MergeGenderVariants joins its words' types.
+func TestPartsOfSpeech(t *testing.T) {
+	res, err := NewFromFileOrURL("testdata/chanteur.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"nom masculin", "nom féminin", "adjectif"}
+	if got := res.PartsOfSpeech(); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+	
+	MergeGenderVariants = true
+	defer func() { MergeGenderVariants = false }()
+	res, err = NewFromFileOrURL("testdata/chanteur.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := res.PartsOfSpeech(); !reflect.DeepEqual(got, want) {
+		t.Errorf("merged: want %v, got %v", want, got)
+	}
+}
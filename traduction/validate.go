@@ -0,0 +1,108 @@
+package traduction
+
+import (
+	"fmt"
+	
+	"github.com/serope/laroussefr"
+)
+
+// Validate returns a human-readable warning for each field of r which looks
+// wrong, e.g. "Word 0 has empty Header.Text" or "Word 1, Subheader 0, Item 2,
+// Meaning 0 has RedCaps but empty Text", or nil if there's nothing
+// suspicious. It's meant for auditing scraped data in bulk, since a warning
+// usually means that the scrapers missed a change in Larousse's markup. r
+// isn't modified.
+// 
+// A "word not found" Result has no warnings.
+func (r Result) Validate() []string {
+	if r.NotFound() {
+		return nil
+	}
+	var out []string
+	warn := func(format string, a ...interface{}) {
+		out = append(out, fmt.Sprintf(format, a...))
+	}
+	
+	if r.PageID <= 0 {
+		warn("PageID %d isn't positive", r.PageID)
+	}
+	if len(r.Words) == 0 {
+		warn("Result has no Words")
+	}
+	for i, w := range r.Words {
+		if w.Header.Text == "" {
+			warn("Word %d has empty Header.Text", i)
+		}
+		if w.Header.Type == "" {
+			warn("Word %d has empty Header.Type", i)
+		}
+		if len(w.Subheaders) == 0 {
+			warn("Word %d has no Subheaders", i)
+		}
+		for j, s := range w.Subheaders {
+			if len(s.Items) == 0 {
+				warn("Word %d, Subheader %d has no Items", i, j)
+			}
+			for k, item := range s.Items {
+				where := fmt.Sprintf("Word %d, Subheader %d, Item %d", i, j, k)
+				if len(item.Meanings) == 0 && len(item.Phrases) == 0 {
+					warn("%s has no Meanings or Phrases", where)
+				}
+				for l, m := range item.Meanings {
+					// a context alone may head the item's phrases, as in "aire"
+					if m.Text == "" && m.CrossRef == "" && len(item.Phrases) == 0 {
+						warn("%s, Meaning %d has %s but empty Text", where, l, m.contextFields())
+					}
+				}
+				for l, p := range item.Phrases {
+					p.validate(fmt.Sprintf("%s, Phrase %d", where, l), warn)
+				}
+			}
+		}
+	}
+	for i, u := range r.SeeAlso {
+		if ok, message := laroussefr.IsURL(u); !ok {
+			warn("SeeAlso %d isn't a dictionary URL: %s", i, message)
+		}
+	}
+	return out
+}
+
+// contextFields returns the names of m's non-empty red contexts, e.g.
+// "RedCaps", or "no context" if there are none.
+func (m Meaning) contextFields() string {
+	var names string
+	for _, f := range []struct{ name, value string }{
+		{"RedBrac", m.RedBrac},
+		{"RedCaps", m.RedCaps},
+		{"RedMeta", m.RedMeta},
+	} {
+		if f.value == "" {
+			continue
+		}
+		if names != "" {
+			names += " and "
+		}
+		names += f.name
+	}
+	if names == "" {
+		return "no context"
+	}
+	return names
+}
+
+// validate passes a warning for each suspicious field of p and its Subphrases
+// to warn, as in Result.Validate. where names p.
+func (p Phrase) validate(where string, warn func(string, ...interface{})) {
+	switch {
+		case p.Text1 == "" && p.Text2 == "":
+			warn("%s has empty Text1 and Text2", where)
+		case p.Text1 == "":
+			warn("%s has empty Text1", where)
+		case p.Text2 == "" && len(p.Subphrases) == 0:
+			warn("%s has empty Text2", where)
+	}
+	for i, sub := range p.Subphrases {
+		sub.validate(fmt.Sprintf("%s, Subphrase %d", where, i), warn)
+	}
+}
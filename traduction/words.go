@@ -36,14 +36,15 @@ type bigWord Word
 // An example of a smallWord is every word but the first on the same page
 // linked above.
 type smallWord struct {
-	Code   int
-	Header Header
-	Items  []Item
+	Code    int
+	Header  Header
+	Items   []Item
+	RawHTML string
 }
 
 func (sw smallWord) toWord() Word {
 	sh := Subheader{"", sw.Items}
-	return Word{sw.Code, sw.Header, []Subheader{sh}}
+	return Word{sw.Code, sw.Header, []Subheader{sh}, sw.RawHTML}
 }
 
 
@@ -61,14 +62,16 @@ func scrapeSmallWords(doc *html.Node) ([]smallWord, error) {
 		code := getWordCode(i, doc, zoneEntreeNode)
 		
 		// Entree
-		arr, err := parse.ZoneEntree(zoneEntreeNode)
+		header, err := scrapeHeader(zoneEntreeNode)
 		if err != nil {
 			return nil, laroussefr.NewError("scrapeSmallWords", "", err.Error())
 		}
-		header := Header{arr[0], arr[1], arr[2], arr[3], arr[4]}
 		
 		// ZoneTexte
-		zoneTexteNode := zoneEntreeNode.NextSibling
+		zoneTexteNode, err := getZoneTexteNode(zoneEntreeNode)
+		if err != nil {
+			return nil, laroussefr.NewError("scrapeSmallWords", "", err.Error())
+		}
 		itemNodes := scrape.FindAll(zoneTexteNode, scrape.ByClass("itemZONESEM"))
 		if len(itemNodes) == 0 {
 			itemNodes = []*html.Node{zoneTexteNode}
@@ -78,7 +81,7 @@ func scrapeSmallWords(doc *html.Node) ([]smallWord, error) {
 			return nil, laroussefr.NewError("scrapeSmallWords", "", err.Error())
 		}
 		
-		sw := smallWord{code, header, items}
+		sw := smallWord{code, header, items, rawHTML(zoneEntreeNode, zoneTexteNode)}
 		out = append(out, sw)
 	}
 	
@@ -102,34 +105,66 @@ func scrapeBigWords(doc *html.Node) ([]bigWord, error) {
 		code := getWordCode(i, doc, zoneEntreeNode)
 		
 		// Entree
-		arr, err := parse.ZoneEntree(zoneEntreeNode)
+		header, err := scrapeHeader(zoneEntreeNode)
 		if err != nil {
 			return nil, laroussefr.NewError("scrapeBigWords", "", err.Error())
 		}
-		header := Header{arr[0], arr[1], arr[2], arr[3], arr[4]}
 		
 		// ZoneTexte
-		zoneTexteNode := zoneEntreeNode.NextSibling
+		zoneTexteNode, err := getZoneTexteNode(zoneEntreeNode)
+		if err != nil {
+			return nil, laroussefr.NewError("scrapeBigWords", "", err.Error())
+		}
 		blackNodes := getBlackNodes(zoneTexteNode)
 		blacks, err := scrapeBlackNodes(blackNodes)
 		if err != nil {
 			return nil, laroussefr.NewError("scrapeBigWords", "", err.Error())
 		}
 		
-		bw := bigWord{code, header, blacks}
+		bw := bigWord{code, header, blacks, rawHTML(zoneEntreeNode, zoneTexteNode)}
 		out = append(out, bw)
 	}
 	
 	return out, nil
 }
 
-// hasBigWords returns true of this page contains bigWords.
-func hasBigWords(doc *html.Node) bool {
-	itemBLSEMnodes := scrape.FindAll(doc, scrape.ByClass("itemBLSEM1"))
-	if len(itemBLSEMnodes) > 0 {
-		return true
+// scrapeHeader takes a "ZoneEntree" node and returns a Header.
+func scrapeHeader(zoneEntreeNode *html.Node) (Header, error) {
+	arr, err := parse.ZoneEntree(zoneEntreeNode)
+	if err != nil {
+		return Header{}, err
 	}
-	return false
+	hint := parse.EntreeConjugaisonHint(zoneEntreeNode)
+	clips := parse.EntreeAudioClips(zoneEntreeNode)
+	return Header{
+		Text:            arr[0],
+		TextAlt:         arr[1],
+		Phonetic:        arr[2],
+		Audio:           arr[3],
+		Type:            arr[4],
+		InfinitiveURL:   laroussefr.GetConjugaisonURL(zoneEntreeNode),
+		AudioURLs:       laroussefr.AudioClipURLs(clips),
+		AudioClips:      clips,
+		ConjugationHint: hint,
+		Auxiliaries:     auxiliaries(hint),
+		Origine:         parse.EntreeOrigine(zoneEntreeNode),
+	}, nil
+}
+
+// rawHTML returns the HTML of a word's "ZoneEntree" and "ZoneTexte" nodes if
+// IncludeRawHTML is true. Otherwise, it returns an empty string.
+func rawHTML(zoneEntreeNode, zoneTexteNode *html.Node) string {
+	if !IncludeRawHTML {
+		return ""
+	}
+	return laroussefr.RenderHTML(zoneEntreeNode, zoneTexteNode)
+}
+
+// hasBigWords returns true of this page contains bigWords, i.e. if it has any
+// of the black nodes returned by getBlackNodes. Some words (e.g. en->fr "make")
+// have subheaders which are only styled with "itemBLSEM", without the digit.
+func hasBigWords(doc *html.Node) bool {
+	return len(getBlackNodes(doc)) > 0
 }
 
 // getBlackNodes returns all "itemBLSEM1" and "itemBLSEM" nodes, which are
@@ -187,9 +222,9 @@ func getBigWordZoneEntreeNodes(doc *html.Node) ([]*html.Node, error) {
 	zoneEntreeNodes := scrape.FindAll(doc, scrape.ByClass("ZoneEntree"))
 	var out []*html.Node
 	for _, zoneEntreeNode := range zoneEntreeNodes {
-		zoneTexteNode := zoneEntreeNode.NextSibling
-		if zoneTexteNode == nil {
-			return nil, laroussefr.NewError("getBigWordZoneEntreeNodes", "", "nil sibling node after ZoneEntree")
+		zoneTexteNode, err := getZoneTexteNode(zoneEntreeNode)
+		if err != nil {
+			return nil, laroussefr.NewError("getBigWordZoneEntreeNodes", "", err.Error())
 		}
 		if hasBigWords(zoneTexteNode) {
 			out = append(out, zoneEntreeNode)
@@ -205,9 +240,9 @@ func getSmallWordZoneEntreeNodes(doc *html.Node) ([]*html.Node, error) {
 	zoneEntreeNodes := scrape.FindAll(doc, scrape.ByClass("ZoneEntree"))
 	var out []*html.Node
 	for _, zoneEntreeNode := range zoneEntreeNodes {
-		zoneTexteNode := zoneEntreeNode.NextSibling
-		if zoneTexteNode == nil {
-			return nil, laroussefr.NewError("getSmallWordZoneEntreeNodes", "", "nil sibling node after ZoneEntree")
+		zoneTexteNode, err := getZoneTexteNode(zoneEntreeNode)
+		if err != nil {
+			return nil, laroussefr.NewError("getSmallWordZoneEntreeNodes", "", err.Error())
 		}
 		if hasBigWords(zoneTexteNode) {
 			continue
@@ -218,25 +253,90 @@ func getSmallWordZoneEntreeNodes(doc *html.Node) ([]*html.Node, error) {
 	return out, nil
 }
 
+// getZoneTexteNode returns the "ZoneTexte" node following a "ZoneEntree" node,
+// which is the first of its following siblings whose class is "ZoneTexte".
+// Other nodes in between, e.g. whitespace or a stray element, are skipped. The
+// search stops at the next "ZoneEntree" node, so that a word without a
+// "ZoneTexte" node doesn't take the next word's.
+func getZoneTexteNode(zoneEntreeNode *html.Node) (*html.Node, error) {
+	n := zoneEntreeNode.NextSibling
+	for n != nil && scrape.Attr(n, "class") != "ZoneEntree" {
+		if scrape.Attr(n, "class") == "ZoneTexte" {
+			return n, nil
+		}
+		n = n.NextSibling
+	}
+	return nil, laroussefr.NewError("getZoneTexteNode", "", "no ZoneTexte node after ZoneEntree")
+}
+
 // scrapeItems takes a slice of "itemZONESEM" nodes and returns an Item slice.
 func scrapeItems(itemNodes []*html.Node) ([]Item, error) {
 	var out []Item
 	for _, itemNode := range itemNodes {
-		item := scrapeItem(itemNode)
+		item, err := scrapeItem(itemNode)
+		if err != nil {
+			return nil, laroussefr.NewError("scrapeItems", "", err.Error())
+		}
 		out = append(out, item)
 	}
 	return out, nil
 }
 
 // scrapeItem takes an "itemZONESEM" node and returns an Item.
-func scrapeItem(itemNode *html.Node) Item {
-	meanings := scrapeMeanings(itemNode)
-	phrases := scrapePhrases(itemNode)
-	return Item{meanings, phrases}
+func scrapeItem(itemNode *html.Node) (Item, error) {
+	meanings, err := scrapeMeanings(itemNode)
+	if err != nil {
+		return Item{}, err
+	}
+	if TolerantParsing && !hasTranslation(meanings) {
+		meanings, err = recoverMeanings(itemNode, meanings)
+		if err != nil {
+			return Item{}, err
+		}
+	}
+	phrases, err := scrapePhrases(itemNode)
+	if err != nil {
+		return Item{}, err
+	}
+	item := Item{Meanings: meanings, Phrases: phrases}
+	item.hoistContext()
+	return item, nil
+}
+
+// hoistContext moves the red contexts of i's first Meaning onto i if that
+// Meaning has nothing else and every other Meaning is one of its sub-senses,
+// i.e. if the contexts precede the item's semantic divisions and so apply to
+// all of them. The Meaning is removed and the Depth of the others is
+// decreased, since they're no longer its sub-senses.
+// 
+// An item whose only Meaning is such a context, which usually precedes its
+// Phrases, is left alone, as is one whose context-only Meanings are siblings,
+// e.g. "[affaire] (familier)" and "[personne - sexuellement] (vulgaire)" on
+// the page for "coup".
+func (i *Item) hoistContext() {
+	if len(i.Meanings) < 2 {
+		return
+	}
+	m := i.Meanings[0]
+	if m.Text != "" || m.CrossRef != "" || !m.hasContext() {
+		return
+	}
+	for _, sub := range i.Meanings[1:] {
+		if sub.Depth <= m.Depth {
+			return
+		}
+	}
+	i.RedBrac, i.RedCaps, i.RedMeta, i.Register = m.RedBrac, m.RedCaps, m.RedMeta, m.Register
+	i.Meanings = i.Meanings[1:]
+	for j := range i.Meanings {
+		if i.Meanings[j].Depth > 0 {
+			i.Meanings[j].Depth--
+		}
+	}
 }
 
 // scrapePhrases takes an "itemZONESEM" node and returns a Phrase slice.
-func scrapePhrases(n *html.Node) []Phrase {
+func scrapePhrases(n *html.Node) ([]Phrase, error) {
 	a := scrape.FindAll(n, scrape.ByClass("ZoneExpression1"))
 	b := scrape.FindAll(n, scrape.ByClass("ZoneExpression"))
 	if len(b) > 0 {
@@ -246,40 +346,50 @@ func scrapePhrases(n *html.Node) []Phrase {
 	
 	var out []Phrase
 	for _, e := range exprNodes {
-		phrase := getPhraseFromZoneExpression(e)
+		phrase, err := getPhraseFromZoneExpression(e, false)
+		if err != nil {
+			return nil, err
+		}
 		out = append(out, phrase)
 	}
 	
 	// blues
-	blues := scrapeExpressions(n)
+	blues, err := scrapeExpressions(n)
+	if err != nil {
+		return nil, err
+	}
 	out = append(out, blues...)
-	return out
+	return out, nil
 }
 
 // scrapeExpressions takes an "itemZONESEM" node and returns a Phrase slice of
 // expressions, if any exist.
-func scrapeExpressions(n *html.Node) []Phrase {
+func scrapeExpressions(n *html.Node) ([]Phrase, error) {
 	blocExpressionNode, ok := scrape.Find(n, scrape.ByClass("BlocExpression"))
 	if !ok {
-		return nil
+		return nil, nil
 	}
 	
-	firstPhrase := getPhraseFromZoneExpression(blocExpressionNode)
-	firstPhrase.setBlue(true)
+	firstPhrase, err := getPhraseFromZoneExpression(blocExpressionNode, true)
+	if err != nil {
+		return nil, err
+	}
 	out := []Phrase{firstPhrase}
 	
 	exprNodes := scrape.FindAll(n, scrape.ByClass("ZoneExpression2"))
 	for _, e := range exprNodes {
-		phrase := getPhraseFromZoneExpression(e)
-		phrase.setBlue(true)
+		phrase, err := getPhraseFromZoneExpression(e, true)
+		if err != nil {
+			return nil, err
+		}
 		out = append(out, phrase)
 	}
-	return out
+	return out, nil
 }
 
 // scrapeMeanings takes an item node ("itemZONESEM") and returns a list of
 // Meanings in this node.
-func scrapeMeanings(itemNode *html.Node) []Meaning {
+func scrapeMeanings(itemNode *html.Node) ([]Meaning, error) {
 	// 1st genre/meaning strings
 	n := itemNode.FirstChild
 	if n.Type == html.TextNode && isWhitespace(n.Data) {
@@ -288,30 +398,101 @@ func scrapeMeanings(itemNode *html.Node) []Meaning {
 	
 	var m Meaning
 	for stillOnFirstMeaningStrings(n) {
-		m.update(n)
+		err := m.update(n)
+		if err != nil {
+			return nil, err
+		}
 		n = n.NextSibling
 	}
 	
 	// 1st done
 	out := []Meaning{m}
 	
-	// other genres/meanings
-	semantiqueNodes := scrape.FindAll(itemNode, scrape.ByClass("division-semantique"))
-	for _, s := range semantiqueNodes {
-		if s == itemNode {
-			continue
+	// other genres/meanings, which are sub-senses of the first one and may
+	// have sub-senses of their own
+	for _, s := range semantiqueNodes(itemNode) {
+		meanings, err := scrapeMeanings(s)
+		if err != nil {
+			return nil, err
 		}
-		// meaning := scrapeMeanings(s)[0]
-		meanings := scrapeMeanings(s)
-		if len(meanings) > 0 {
-			out = append(out, meanings[0])
+		for i := range meanings {
+			meanings[i].Depth++
 		}
+		out = append(out, meanings...)
 	}
 	
 	// end
 	if len(out) == 1 && out[0].isEmpty() {
+		diagnose("Meaning: dropped empty meaning in %q", scrape.Attr(itemNode, "class"))
 		out = nil
 	}
+	return out, nil
+}
+
+// hasTranslation returns true if any of meanings has a Text or a CrossRef.
+func hasTranslation(meanings []Meaning) bool {
+	for _, m := range meanings {
+		if m.Text != "" || m.CrossRef != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// recoverMeanings scrapes the Meanings of an item node ("itemZONESEM") for
+// TolerantParsing, by finding the nodes of every Meaning field within it in
+// document order, outside of its phrases. A new Meaning begins at each red
+// context which follows a translation. If nothing is found, meanings, the
+// result of scrapeMeanings, is returned instead.
+func recoverMeanings(itemNode *html.Node, meanings []Meaning) ([]Meaning, error) {
+	var out []Meaning
+	var m Meaning
+	for _, n := range meaningFieldNodes(itemNode) {
+		class := scrape.Attr(n, "class")
+		isContext := class == "Indicateur" || class == "IndicateurDomaine" || class == "Metalangue"
+		if isContext && (m.Text != "" || m.CrossRef != "") {
+			out = append(out, m)
+			m = Meaning{}
+		}
+		err := m.update(n)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !m.isEmpty() {
+		out = append(out, m)
+	}
+	if !hasTranslation(out) {
+		return meanings, nil
+	}
+	diagnose("Meaning: recovered %d meanings with TolerantParsing in %q", len(out), scrape.Text(itemNode))
+	return out, nil
+}
+
+// meaningFieldNodes returns the nodes below n whose class is that of a Meaning
+// field, in document order, skipping phrases and expressions.
+func meaningFieldNodes(n *html.Node) []*html.Node {
+	var out []*html.Node
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		switch scrape.Attr(c, "class") {
+			case "ZoneExpression", "ZoneExpression1", "ZoneExpression2", "BlocExpression":
+				continue
+			case "Traduction", "Glose2", "Renvois", "Indicateur", "IndicateurDomaine", "Metalangue":
+				out = append(out, c)
+				continue
+		}
+		out = append(out, meaningFieldNodes(c)...)
+	}
+	return out
+}
+
+// semantiqueNodes returns the outermost "division-semantique" nodes below n,
+// not including n itself.
+func semantiqueNodes(n *html.Node) []*html.Node {
+	var out []*html.Node
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		out = append(out, scrape.FindAll(c, scrape.ByClass("division-semantique"))...)
+	}
 	return out
 }
 
@@ -369,22 +550,28 @@ func getWordCodeFromZoneEntreeNode(n *html.Node) (int, error) {
 }
 
 // getPhraseFromZoneExpression takes a "ZoneExpression" or "ZoneExpression1"
-// node and returns a Phrase.
-func getPhraseFromZoneExpression(zoneExpressionNode *html.Node) Phrase {
-	var p Phrase
+// node and returns a Phrase, whose IsBlue value and its Subphrases' are blue.
+func getPhraseFromZoneExpression(zoneExpressionNode *html.Node, blue bool) (Phrase, error) {
+	p := Phrase{IsBlue: blue}
 	n := zoneExpressionNode.FirstChild
 	for n != nil {
-		p.update(n)
+		err := p.update(n)
+		if err != nil {
+			return Phrase{}, err
+		}
 		if scrape.Attr(n, "class") == "DivisionExpression" {
 			liNodes := scrape.FindAll(n, scrape.ByTag(atom.Li))
 			for _, li := range liNodes {
-				subphrase := getPhraseFromZoneExpression(li)
+				subphrase, err := getPhraseFromZoneExpression(li, blue)
+				if err != nil {
+					return Phrase{}, err
+				}
 				p.Subphrases = append(p.Subphrases, subphrase)
 			}
 		}
 		n = n.NextSibling
 	}
-	return p
+	return p, nil
 }
 
 // stillOnFirstMeaningStrings returns true if n is a node containing data
@@ -417,3 +604,105 @@ func isWhitespace(str string) bool {
 	}
 	return true
 }
+
+// mergeGenderVariants returns words with each run of adjacent gender variants
+// merged into its first Word, as described by MergeGenderVariants.
+func mergeGenderVariants(words []Word) []Word {
+	var out []Word
+	for _, w := range words {
+		if len(out) > 0 && areGenderVariants(out[len(out)-1], w) {
+			out[len(out)-1] = mergeWords(out[len(out)-1], w)
+			continue
+		}
+		out = append(out, w)
+	}
+	return out
+}
+
+// areGenderVariants returns true if w and u are two forms of the same entry,
+// i.e. they have the same non-zero Code or one's TextAlt names the other's
+// Text.
+func areGenderVariants(w, u Word) bool {
+	if w.Code != 0 && w.Code == u.Code {
+		return true
+	}
+	return namesForm(w.Header.TextAlt, u.Header.Text) || namesForm(u.Header.TextAlt, w.Header.Text)
+}
+
+// namesForm returns true if textAlt, a Header's TextAlt such as "(chanteuse)"
+// or "(f verte)", lists the form text.
+func namesForm(textAlt, text string) bool {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return false
+	}
+	for _, form := range strings.Split(strings.Trim(textAlt, "() "), ",") {
+		fields := strings.Fields(form)
+		if len(fields) > 1 && (fields[0] == "f" || fields[0] == "m") {
+			fields = fields[1:]
+		}
+		if strings.Join(fields, " ") == text {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeWords returns w with the form, pronunciation, type, audio and
+// subheaders of u added to it.
+func mergeWords(w, u Word) Word {
+	h := w.Header
+	if h.TextAlt == "" && u.Header.Text != h.Text {
+		h.TextAlt = "(" + u.Header.Text + ")"
+	}
+	phonetics := laroussefr.SplitPhonetic(h.Phonetic)
+	for _, p := range laroussefr.SplitPhonetic(u.Header.Phonetic) {
+		if !containsString(phonetics, p) {
+			phonetics = append(phonetics, p)
+		}
+	}
+	if len(phonetics) > 1 {
+		for i, p := range phonetics {
+			phonetics[i] = strings.Trim(p, "[]")
+		}
+		h.Phonetic = "[" + strings.Join(phonetics, ", ") + "]"
+	}
+	if u.Header.Type != "" && !containsString(strings.Split(h.Type, ", "), u.Header.Type) {
+		if h.Type == "" {
+			h.Type = u.Header.Type
+		} else {
+			h.Type += ", " + u.Header.Type
+		}
+	}
+	h.AudioURLs = laroussefr.UniqueLinks(append(append([]string{}, h.AudioURLs...), u.Header.AudioURLs...))
+	h.AudioClips = append([]laroussefr.AudioClip{}, h.AudioClips...)
+	for _, clip := range u.Header.AudioClips {
+		if !containsClip(h.AudioClips, clip.URL) {
+			h.AudioClips = append(h.AudioClips, clip)
+		}
+	}
+	
+	merged := Word{Code: w.Code, Header: h, RawHTML: w.RawHTML + u.RawHTML}
+	merged.Subheaders = append(append([]Subheader{}, w.Subheaders...), u.Subheaders...)
+	return merged
+}
+
+// containsString returns true if strs contains str.
+func containsString(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
+// containsClip returns true if clips contains a clip whose URL is url.
+func containsClip(clips []laroussefr.AudioClip, url string) bool {
+	for _, c := range clips {
+		if c.URL == url {
+			return true
+		}
+	}
+	return false
+}